@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
+	"strings"
+)
+
+// ErrorHandler renders the errors returned by handlers. Errors from the error_handling package are shown
+// with their code and safe message, and any other error as an internal error, whose cause is only logged.
+func ErrorHandler(ctx *fiber.Ctx, err error) error {
+	appErr := HTTPError(err)
+	if appErr.Status >= http.StatusInternalServerError {
+		LogRequestError(ctx, err)
+	}
+	return ctx.Status(appErr.Status).JSON(models.ErrorResponse{
+		Code:      appErr.Code,
+		Errors:    []string{appErr.SafeMessage()},
+		RequestId: common.GetRequestId(ctx.Context()),
+	})
+}
+
+// HTTPError returns the error to show for err. Errors raised by fiber itself, such as unknown routes, keep their status.
+func HTTPError(err error) *error_handling.Error {
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		code := strings.ToLower(strings.ReplaceAll(http.StatusText(fiberErr.Code), " ", "_"))
+		return error_handling.New(code, fiberErr.Code, fiberErr.Message)
+	}
+	return error_handling.From(err)
+}
+
+// LogRequestError logs the full error, internal cause included, with the request id.
+// The route is logged rather than the path, which may hold a token.
+func LogRequestError(ctx *fiber.Ctx, err error) {
+	common.GetLogger(ctx.Context()).WithFields(logrus.Fields{
+		"err":    err,
+		"method": ctx.Method(),
+		"route":  ctx.Route().Path,
+	}).Error("error_request")
+}
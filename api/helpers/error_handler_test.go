@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"testing"
+)
+
+func errorResponse(t *testing.T, returned error, path string) (*http.Response, models.ErrorResponse) {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(requestid.New())
+	app.Get("/fails", func(ctx *fiber.Ctx) error {
+		return returned
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil), 1)
+	require.NoError(t, err)
+	var body models.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp, body
+}
+
+func TestErrorHandler_TypedError(t *testing.T) {
+	errMock := error_handling.BadRequest("mock_invalid", "error, mock invalid")
+	resp, body := errorResponse(t, errMock.Detail("field x").Wrap(errors.New("internal detail")), "/fails")
+
+	t.Run("Test ErrorHandler - Typed Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "mock_invalid", body.Code)
+		assert.Equal(t, []string{"error, mock invalid: field x"}, body.Errors)
+		assert.Equal(t, resp.Header.Get(fiber.HeaderXRequestID), body.RequestId)
+	})
+}
+
+func TestErrorHandler_InternalError(t *testing.T) {
+	resp, body := errorResponse(t, errors.New("error fetching token by key: sql: connection refused"), "/fails")
+
+	t.Run("Test ErrorHandler - Internal Error Hides The Cause", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, error_handling.CodeInternalError, body.Code)
+		assert.Equal(t, []string{error_handling.ErrInternal.Message}, body.Errors)
+		assert.NotEmpty(t, body.RequestId)
+	})
+}
+
+func TestErrorHandler_FiberError(t *testing.T) {
+	resp, body := errorResponse(t, nil, "/missing")
+
+	t.Run("Test ErrorHandler - Fiber Error Keeps Its Status", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "not_found", body.Code)
+		assert.Equal(t, []string{"Cannot GET /missing"}, body.Errors)
+	})
+}
@@ -7,18 +7,6 @@ import (
 	"io/ioutil"
 )
 
-func WrapStrInErrMap(str string) map[string][]string {
-	w := make(map[string][]string)
-	w["errors"] = []string{str}
-	return w
-}
-
-func WrapErrInErrMap(err error) map[string][]string {
-	w := make(map[string][]string)
-	w["errors"] = []string{err.Error()}
-	return w
-}
-
 func ResponseBodyToString(io io.ReadCloser) (string, error) {
 	var str string
 	readBody, err := ioutil.ReadAll(io)
@@ -11,11 +11,44 @@ func GetRouter(app *fiber.App, ctn *dic.Container) {
 	v0 := api.Group("/v0")
 
 	apiToken := ctn.GetApiToken()
+	apiWebhook := ctn.GetApiWebhook()
+	apiStream := ctn.GetApiStream()
 	authMiddlewares := ctn.GetApiMiddlewares()
+	idempotency := ctn.GetApiIdempotency()
+	apiCampaign := ctn.GetApiCampaign()
 
 	v0token := v0.Group("/token")
 	v0token.Get("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.GetAll)
-	v0token.Post("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.GetToken)
+	v0token.Post("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, idempotency.Idempotent, apiToken.GetToken)
+	v0token.Get("/export", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.Export)
+	v0token.Get("/stream", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiStream.Stream)
+	v0token.Post("/batch", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, idempotency.Idempotent, apiToken.GenerateBatch)
 	v0token.Get("/:token/validate", middlewares.Throttle(), apiToken.ValidateToken)
+	v0token.Post("/:token/redeem", middlewares.Throttle(), apiToken.RedeemToken)
+	v0token.Get("/:token/events", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.GetEvents)
+	v0token.Patch("/:token", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.Update)
 	v0token.Delete("/:token/revoke", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.Revoke)
+	v0token.Get("/id/:id/events", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.GetEventsById)
+	v0token.Patch("/id/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.UpdateById)
+	v0token.Delete("/id/:id/revoke", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.RevokeById)
+
+	v0me := v0.Group("/me")
+	v0me.Get("/quota", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiToken.GetQuota)
+
+	v0webhooks := v0.Group("/webhooks")
+	v0webhooks.Get("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiWebhook.GetAll)
+	v0webhooks.Post("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, idempotency.Idempotent, apiWebhook.Create)
+	v0webhooks.Patch("/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiWebhook.Update)
+	v0webhooks.Delete("/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiWebhook.Delete)
+	v0webhooks.Get("/:id/deliveries", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiWebhook.GetDeliveries)
+
+	v0campaigns := v0.Group("/campaigns")
+	v0campaigns.Get("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.GetAll)
+	v0campaigns.Post("/", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.Create)
+	v0campaigns.Get("/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.Get)
+	v0campaigns.Patch("/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.Update)
+	v0campaigns.Delete("/:id", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.Delete)
+	v0campaigns.Post("/:id/tokens", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, idempotency.Idempotent, apiCampaign.GenerateTokens)
+	v0campaigns.Delete("/:id/revoke", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.Revoke)
+	v0campaigns.Get("/:id/stats", authMiddlewares.ProtectedRoute(), authMiddlewares.AttachUserMeta, apiCampaign.GetStats)
 }
@@ -0,0 +1,284 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . bizFunctions
+type bizFunctions interface {
+	Create(ctx context.Context, user *models.User, params *models.CreateCampaign) (*models.Campaign, error)
+	GetAll(ctx context.Context) ([]models.Campaign, error)
+	Get(ctx context.Context, id int) (*models.Campaign, error)
+	Update(ctx context.Context, id int, params *models.UpdateCampaign) (*models.Campaign, error)
+	Delete(ctx context.Context, id int) error
+	GenerateTokens(ctx context.Context, user *models.User, id int, params *models.CreateTokenBatch) ([]string, error)
+	Revoke(ctx context.Context, id int) (*models.CampaignRevocation, error)
+	GetStats(ctx context.Context, id int) (*models.CampaignStats, error)
+}
+
+var (
+	errInvalidCampaignId  = error_handling.BadRequest("invalid_campaign_id", "error, campaign id must be a positive integer")
+	errInvalidBody        = error_handling.BadRequest("invalid_body", "error, invalid request body")
+	errUserMetaConversion = errors.New("error, userMeta conversion fails")
+)
+
+type APICampaign struct {
+	bizLayer bizFunctions
+}
+
+func NewAPICampaign(bizLayer bizFunctions) *APICampaign {
+	return &APICampaign{bizLayer}
+}
+
+// campaignId parses the campaign id from the path
+func campaignId(ctx *fiber.Ctx) (int, error) {
+	id, err := ctx.ParamsInt("id")
+	if err != nil || id < 1 {
+		return 0, errInvalidCampaignId
+	}
+	return id, nil
+}
+
+// Create
+// @Id CreateCampaign
+// @Summary Create
+// @Description Creates a campaign, a named group of tokens minted with its defaults.
+// @Description "default_ttl" and "default_max_uses" apply to tokens minted without their own expiry or max uses.
+// @Description Tokens minted into the campaign don't activate before "starts_at", and don't outlive "ends_at".
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.CreateCampaign true "name, and the defaults of the campaign's tokens"
+// @Success 201 {object} models.Campaign
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns [post]
+func (c *APICampaign) Create(ctx *fiber.Ctx) error {
+	userMeta, ok := ctx.Locals("userMeta").(*models.User)
+	if !ok {
+		return errUserMetaConversion
+	}
+
+	var params models.CreateCampaign
+	if err := ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	campaign, err := c.bizLayer.Create(ctx.Context(), userMeta, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusCreated).JSON(campaign)
+}
+
+// GetAll
+// @Id GetCampaigns
+// @Summary Fetch all
+// @Description Fetches every campaign, oldest first
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} []models.Campaign
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns [get]
+func (c *APICampaign) GetAll(ctx *fiber.Ctx) error {
+	campaigns, err := c.bizLayer.GetAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(campaigns)
+}
+
+// Get
+// @Id GetCampaign
+// @Summary Fetch
+// @Description Fetches a campaign
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "campaign id"
+// @Success 200 {object} models.Campaign
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id} [get]
+func (c *APICampaign) Get(ctx *fiber.Ctx) error {
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	campaign, err := c.bizLayer.Get(ctx.Context(), id)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(campaign)
+}
+
+// Update
+// @Id UpdateCampaign
+// @Summary Update
+// @Description Changes a campaign's name, description, defaults or dates.
+// @Description Tokens already minted into the campaign keep the values they were minted with.
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "campaign id"
+// @Param body body models.UpdateCampaign true "campaign changes"
+// @Success 200 {object} models.Campaign
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id} [patch]
+func (c *APICampaign) Update(ctx *fiber.Ctx) error {
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	var params models.UpdateCampaign
+	if err = ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	updated, err := c.bizLayer.Update(ctx.Context(), id, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(updated)
+}
+
+// Delete
+// @Id DeleteCampaign
+// @Summary Delete
+// @Description Deletes a campaign. Its tokens are kept as they are, and no longer belong to a campaign.
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "campaign id"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id} [delete]
+func (c *APICampaign) Delete(ctx *fiber.Ctx) error {
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = c.bizLayer.Delete(ctx.Context(), id); err != nil {
+		return err
+	}
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// GenerateTokens
+// @Id GenerateCampaignTokens
+// @Summary Create tokens
+// @Description Creates "count" invite tokens in the campaign, or a single one when "count" is omitted.
+// @Description Options left out fall back to the campaign's defaults, and tokens expire by the campaign's end.
+// @Description Tokens created before the campaign starts only activate once it does, and their ttl counts from then.
+// @Description Either every token is created, or none are. Revoked and ended campaigns are a 410.
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param Idempotency-Key header string false "key of your choosing, to replay the original response to retries"
+// @Param id path int true "campaign id"
+// @Param body body models.CreateTokenBatch true "count, and the options shared by every token"
+// @Success 201 {object} []string
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 410 {object} models.ErrorResponse
+// @Failure 429 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id}/tokens [post]
+func (c *APICampaign) GenerateTokens(ctx *fiber.Ctx) error {
+	userMeta, ok := ctx.Locals("userMeta").(*models.User)
+	if !ok {
+		return errUserMetaConversion
+	}
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	var params models.CreateTokenBatch
+	if err = ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	generatedTokens, err := c.bizLayer.GenerateTokens(ctx.Context(), userMeta, id, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusCreated).JSON(generatedTokens)
+}
+
+// Revoke
+// @Id RevokeCampaign
+// @Summary Revoke
+// @Description Revokes a campaign, so no more tokens can be created in it, along with every token in it.
+// @Description Returns how many tokens were revoked, leaving out those already revoked.
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "campaign id"
+// @Success 200 {object} models.CampaignRevocation
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id}/revoke [delete]
+func (c *APICampaign) Revoke(ctx *fiber.Ctx) error {
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	revocation, err := c.bizLayer.Revoke(ctx.Context(), id)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(revocation)
+}
+
+// GetStats
+// @Id GetCampaignStats
+// @Summary Stats
+// @Description Counts a campaign's tokens. "issued" counts every token, "active" those neither revoked nor expired,
+// @Description "validated" those validated successfully at least once, and "expired" those expired but not revoked.
+// @Tags Campaign
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "campaign id"
+// @Success 200 {object} models.CampaignStats
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/campaigns/{id}/stats [get]
+func (c *APICampaign) GetStats(ctx *fiber.Ctx) error {
+	id, err := campaignId(ctx)
+	if err != nil {
+		return err
+	}
+
+	stats, err := c.bizLayer.GetStats(ctx.Context(), id)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(stats)
+}
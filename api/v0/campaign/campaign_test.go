@@ -0,0 +1,168 @@
+package campaign
+
+import (
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/api/v0/campaign/campaignfakes"
+	BusinessCampaign "platform_engineer_clone/business/v0/campaign"
+	"platform_engineer_clone/models"
+	"strings"
+	"testing"
+)
+
+func newTestApp(apiCampaign *APICampaign) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	withUser := func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}
+	app.Post("/campaigns", withUser, apiCampaign.Create)
+	app.Get("/campaigns", apiCampaign.GetAll)
+	app.Get("/campaigns/:id", apiCampaign.Get)
+	app.Patch("/campaigns/:id", apiCampaign.Update)
+	app.Delete("/campaigns/:id", apiCampaign.Delete)
+	app.Post("/campaigns/:id/tokens", withUser, apiCampaign.GenerateTokens)
+	app.Delete("/campaigns/:id/revoke", apiCampaign.Revoke)
+	app.Get("/campaigns/:id/stats", apiCampaign.GetStats)
+	return app
+}
+
+func TestCreate_StatusCreated(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.CreateReturns(&models.Campaign{Id: 4, Name: "Spring launch"}, nil)
+
+	req := httptest.NewRequest("POST", "/campaigns", strings.NewReader(`{"name":"Spring launch","default_ttl":"72h"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Create - StatusCreated", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created models.Campaign
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		assert.Equal(t, 4, created.Id)
+
+		_, user, params := fakeBizFunctions.CreateArgsForCall(0)
+		assert.Equal(t, 1, user.Id)
+		assert.Equal(t, "72h", params.DefaultTTL)
+	})
+}
+
+func TestCreate_BadRequest_InvalidParams(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.CreateReturns(nil, BusinessCampaign.ErrInvalidDefaultTTL)
+
+	req := httptest.NewRequest("POST", "/campaigns", strings.NewReader(`{"name":"Spring launch","default_ttl":"3 days"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Create - BadRequest Invalid Params", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var errResp models.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		assert.Equal(t, "invalid_default_ttl", errResp.Code)
+	})
+}
+
+func TestGet_NotFound(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetReturns(nil, models.ErrNotFound)
+
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(httptest.NewRequest("GET", "/campaigns/9", nil), 1)
+	t.Run("Test Get - NotFound", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestUpdate_BadRequest_InvalidId(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+
+	req := httptest.NewRequest("PATCH", "/campaigns/abc", strings.NewReader(`{"name":"Beta"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Update - BadRequest Invalid Id", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.UpdateCallCount())
+	})
+}
+
+func TestDelete_NoContent(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(httptest.NewRequest("DELETE", "/campaigns/4", nil), 1)
+	t.Run("Test Delete - NoContent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		_, id := fakeBizFunctions.DeleteArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestGenerateTokens_StatusCreated(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateTokensReturns([]string{"1234", "5678"}, nil)
+
+	req := httptest.NewRequest("POST", "/campaigns/4/tokens", strings.NewReader(`{"count":2,"label":"wave 1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test GenerateTokens - StatusCreated", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var keys []string
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&keys))
+		assert.Equal(t, []string{"1234", "5678"}, keys)
+
+		_, user, id, params := fakeBizFunctions.GenerateTokensArgsForCall(0)
+		assert.Equal(t, 1, user.Id)
+		assert.Equal(t, 4, id)
+		assert.Equal(t, 2, params.Count)
+		assert.Equal(t, "wave 1", params.Label)
+	})
+}
+
+func TestGenerateTokens_Gone_CampaignRevoked(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateTokensReturns(nil, models.ErrCampaignRevoked)
+
+	req := httptest.NewRequest("POST", "/campaigns/4/tokens", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test GenerateTokens - Gone Campaign Revoked", func(t *testing.T) {
+		assert.Equal(t, http.StatusGone, resp.StatusCode)
+
+		var errResp models.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		assert.Equal(t, "campaign_revoked", errResp.Code)
+	})
+}
+
+func TestRevoke_StatusOK(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.RevokeReturns(&models.CampaignRevocation{Revoked: 150}, nil)
+
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(httptest.NewRequest("DELETE", "/campaigns/4/revoke", nil), 1)
+	t.Run("Test Revoke - StatusOK", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var revocation models.CampaignRevocation
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&revocation))
+		assert.Equal(t, 150, revocation.Revoked)
+	})
+}
+
+func TestGetStats_StatusOK(t *testing.T) {
+	fakeBizFunctions := &campaignfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetStatsReturns(&models.CampaignStats{Issued: 200, Active: 150, Validated: 80, Expired: 30, Revoked: 20}, nil)
+
+	resp, _ := newTestApp(NewAPICampaign(fakeBizFunctions)).Test(httptest.NewRequest("GET", "/campaigns/4/stats", nil), 1)
+	t.Run("Test GetStats - StatusOK", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var stats models.CampaignStats
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+		assert.Equal(t, models.CampaignStats{Issued: 200, Active: 150, Validated: 80, Expired: 30, Revoked: 20}, stats)
+	})
+}
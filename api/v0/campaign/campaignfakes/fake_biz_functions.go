@@ -0,0 +1,685 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package campaignfakes
+
+import (
+	"context"
+	"sync"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeBizFunctions struct {
+	CreateStub        func(context.Context, *models.User, *models.CreateCampaign) (*models.Campaign, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateCampaign
+	}
+	createReturns struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	DeleteStub        func(context.Context, int) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GenerateTokensStub        func(context.Context, *models.User, int, *models.CreateTokenBatch) ([]string, error)
+	generateTokensMutex       sync.RWMutex
+	generateTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 int
+		arg4 *models.CreateTokenBatch
+	}
+	generateTokensReturns struct {
+		result1 []string
+		result2 error
+	}
+	generateTokensReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetStub        func(context.Context, int) (*models.Campaign, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getReturns struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	GetAllStub        func(context.Context) ([]models.Campaign, error)
+	getAllMutex       sync.RWMutex
+	getAllArgsForCall []struct {
+		arg1 context.Context
+	}
+	getAllReturns struct {
+		result1 []models.Campaign
+		result2 error
+	}
+	getAllReturnsOnCall map[int]struct {
+		result1 []models.Campaign
+		result2 error
+	}
+	GetStatsStub        func(context.Context, int) (*models.CampaignStats, error)
+	getStatsMutex       sync.RWMutex
+	getStatsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getStatsReturns struct {
+		result1 *models.CampaignStats
+		result2 error
+	}
+	getStatsReturnsOnCall map[int]struct {
+		result1 *models.CampaignStats
+		result2 error
+	}
+	RevokeStub        func(context.Context, int) (*models.CampaignRevocation, error)
+	revokeMutex       sync.RWMutex
+	revokeArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	revokeReturns struct {
+		result1 *models.CampaignRevocation
+		result2 error
+	}
+	revokeReturnsOnCall map[int]struct {
+		result1 *models.CampaignRevocation
+		result2 error
+	}
+	UpdateStub        func(context.Context, int, *models.UpdateCampaign) (*models.Campaign, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateCampaign
+	}
+	updateReturns struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBizFunctions) Create(arg1 context.Context, arg2 *models.User, arg3 *models.CreateCampaign) (*models.Campaign, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateCampaign
+	}{arg1, arg2, arg3})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeBizFunctions) CreateCalls(stub func(context.Context, *models.User, *models.CreateCampaign) (*models.Campaign, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeBizFunctions) CreateArgsForCall(i int) (context.Context, *models.User, *models.CreateCampaign) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) CreateReturns(result1 *models.Campaign, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) CreateReturnsOnCall(i int, result1 *models.Campaign, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 *models.Campaign
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Delete(arg1 context.Context, arg2 int) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBizFunctions) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeBizFunctions) DeleteCalls(stub func(context.Context, int) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeBizFunctions) DeleteArgsForCall(i int) (context.Context, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) GenerateTokens(arg1 context.Context, arg2 *models.User, arg3 int, arg4 *models.CreateTokenBatch) ([]string, error) {
+	fake.generateTokensMutex.Lock()
+	ret, specificReturn := fake.generateTokensReturnsOnCall[len(fake.generateTokensArgsForCall)]
+	fake.generateTokensArgsForCall = append(fake.generateTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 int
+		arg4 *models.CreateTokenBatch
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GenerateTokensStub
+	fakeReturns := fake.generateTokensReturns
+	fake.recordInvocation("GenerateTokens", []interface{}{arg1, arg2, arg3, arg4})
+	fake.generateTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GenerateTokensCallCount() int {
+	fake.generateTokensMutex.RLock()
+	defer fake.generateTokensMutex.RUnlock()
+	return len(fake.generateTokensArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GenerateTokensCalls(stub func(context.Context, *models.User, int, *models.CreateTokenBatch) ([]string, error)) {
+	fake.generateTokensMutex.Lock()
+	defer fake.generateTokensMutex.Unlock()
+	fake.GenerateTokensStub = stub
+}
+
+func (fake *FakeBizFunctions) GenerateTokensArgsForCall(i int) (context.Context, *models.User, int, *models.CreateTokenBatch) {
+	fake.generateTokensMutex.RLock()
+	defer fake.generateTokensMutex.RUnlock()
+	argsForCall := fake.generateTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBizFunctions) GenerateTokensReturns(result1 []string, result2 error) {
+	fake.generateTokensMutex.Lock()
+	defer fake.generateTokensMutex.Unlock()
+	fake.GenerateTokensStub = nil
+	fake.generateTokensReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GenerateTokensReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.generateTokensMutex.Lock()
+	defer fake.generateTokensMutex.Unlock()
+	fake.GenerateTokensStub = nil
+	if fake.generateTokensReturnsOnCall == nil {
+		fake.generateTokensReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.generateTokensReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Get(arg1 context.Context, arg2 int) (*models.Campaign, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetCalls(stub func(context.Context, int) (*models.Campaign, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeBizFunctions) GetArgsForCall(i int) (context.Context, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetReturns(result1 *models.Campaign, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetReturnsOnCall(i int, result1 *models.Campaign, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *models.Campaign
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetAll(arg1 context.Context) ([]models.Campaign, error) {
+	fake.getAllMutex.Lock()
+	ret, specificReturn := fake.getAllReturnsOnCall[len(fake.getAllArgsForCall)]
+	fake.getAllArgsForCall = append(fake.getAllArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAllStub
+	fakeReturns := fake.getAllReturns
+	fake.recordInvocation("GetAll", []interface{}{arg1})
+	fake.getAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetAllCallCount() int {
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	return len(fake.getAllArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetAllCalls(stub func(context.Context) ([]models.Campaign, error)) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = stub
+}
+
+func (fake *FakeBizFunctions) GetAllArgsForCall(i int) context.Context {
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	argsForCall := fake.getAllArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBizFunctions) GetAllReturns(result1 []models.Campaign, result2 error) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = nil
+	fake.getAllReturns = struct {
+		result1 []models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetAllReturnsOnCall(i int, result1 []models.Campaign, result2 error) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = nil
+	if fake.getAllReturnsOnCall == nil {
+		fake.getAllReturnsOnCall = make(map[int]struct {
+			result1 []models.Campaign
+			result2 error
+		})
+	}
+	fake.getAllReturnsOnCall[i] = struct {
+		result1 []models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetStats(arg1 context.Context, arg2 int) (*models.CampaignStats, error) {
+	fake.getStatsMutex.Lock()
+	ret, specificReturn := fake.getStatsReturnsOnCall[len(fake.getStatsArgsForCall)]
+	fake.getStatsArgsForCall = append(fake.getStatsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStatsStub
+	fakeReturns := fake.getStatsReturns
+	fake.recordInvocation("GetStats", []interface{}{arg1, arg2})
+	fake.getStatsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetStatsCallCount() int {
+	fake.getStatsMutex.RLock()
+	defer fake.getStatsMutex.RUnlock()
+	return len(fake.getStatsArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetStatsCalls(stub func(context.Context, int) (*models.CampaignStats, error)) {
+	fake.getStatsMutex.Lock()
+	defer fake.getStatsMutex.Unlock()
+	fake.GetStatsStub = stub
+}
+
+func (fake *FakeBizFunctions) GetStatsArgsForCall(i int) (context.Context, int) {
+	fake.getStatsMutex.RLock()
+	defer fake.getStatsMutex.RUnlock()
+	argsForCall := fake.getStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetStatsReturns(result1 *models.CampaignStats, result2 error) {
+	fake.getStatsMutex.Lock()
+	defer fake.getStatsMutex.Unlock()
+	fake.GetStatsStub = nil
+	fake.getStatsReturns = struct {
+		result1 *models.CampaignStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetStatsReturnsOnCall(i int, result1 *models.CampaignStats, result2 error) {
+	fake.getStatsMutex.Lock()
+	defer fake.getStatsMutex.Unlock()
+	fake.GetStatsStub = nil
+	if fake.getStatsReturnsOnCall == nil {
+		fake.getStatsReturnsOnCall = make(map[int]struct {
+			result1 *models.CampaignStats
+			result2 error
+		})
+	}
+	fake.getStatsReturnsOnCall[i] = struct {
+		result1 *models.CampaignStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Revoke(arg1 context.Context, arg2 int) (*models.CampaignRevocation, error) {
+	fake.revokeMutex.Lock()
+	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
+	fake.revokeArgsForCall = append(fake.revokeArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RevokeStub
+	fakeReturns := fake.revokeReturns
+	fake.recordInvocation("Revoke", []interface{}{arg1, arg2})
+	fake.revokeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) RevokeCallCount() int {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	return len(fake.revokeArgsForCall)
+}
+
+func (fake *FakeBizFunctions) RevokeCalls(stub func(context.Context, int) (*models.CampaignRevocation, error)) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = stub
+}
+
+func (fake *FakeBizFunctions) RevokeArgsForCall(i int) (context.Context, int) {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	argsForCall := fake.revokeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) RevokeReturns(result1 *models.CampaignRevocation, result2 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	fake.revokeReturns = struct {
+		result1 *models.CampaignRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) RevokeReturnsOnCall(i int, result1 *models.CampaignRevocation, result2 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	if fake.revokeReturnsOnCall == nil {
+		fake.revokeReturnsOnCall = make(map[int]struct {
+			result1 *models.CampaignRevocation
+			result2 error
+		})
+	}
+	fake.revokeReturnsOnCall[i] = struct {
+		result1 *models.CampaignRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Update(arg1 context.Context, arg2 int, arg3 *models.UpdateCampaign) (*models.Campaign, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateCampaign
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeBizFunctions) UpdateCalls(stub func(context.Context, int, *models.UpdateCampaign) (*models.Campaign, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeBizFunctions) UpdateArgsForCall(i int) (context.Context, int, *models.UpdateCampaign) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) UpdateReturns(result1 *models.Campaign, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) UpdateReturnsOnCall(i int, result1 *models.Campaign, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *models.Campaign
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.generateTokensMutex.RLock()
+	defer fake.generateTokensMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	fake.getStatsMutex.RLock()
+	defer fake.getStatsMutex.RUnlock()
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBizFunctions) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
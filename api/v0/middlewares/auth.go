@@ -1,15 +1,12 @@
 package middlewares
 
 import (
-	"fmt"
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/basicauth"
 	"github.com/sirupsen/logrus"
-	"platform_engineer_clone/api/helpers"
 	"platform_engineer_clone/models"
 	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
 )
 
 const (
@@ -18,6 +15,8 @@ const (
 	UserMetaKey = "userMeta"
 )
 
+var ErrUnauthorized = error_handling.Unauthorized("unauthorized", "error, unauthorized")
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . authFunctions
 type authFunctions interface {
 	BasicAuth(user, pass string) (bool, *models.User, error)
@@ -46,15 +45,11 @@ func (a *AuthRoutes) ProtectedRoute() func(ctx *fiber.Ctx) error {
 			return true
 		},
 		Unauthorized: func(ctx *fiber.Ctx) error {
-
-			reqHeaders := ctx.GetReqHeaders()
-			fmt.Println("reqHeaders reqHeaders reqHeaders", reqHeaders)
-
 			logger := common.GetLogger(ctx.Context())
 			logger.WithFields(logrus.Fields{
 				"msg": "Unauthorized",
 			}).Error("error_protected_route")
-			return ctx.Status(http.StatusUnauthorized).JSON(helpers.WrapStrInErrMap("Unauthorized"))
+			return ErrUnauthorized
 		},
 		ContextUsername: userKey,
 		ContextPassword: passKey,
@@ -71,7 +66,7 @@ func (a *AuthRoutes) AttachUserMeta(ctx *fiber.Ctx) error {
 		logger.WithFields(logrus.Fields{
 			"err": err,
 		}).Error("error_extract_authed_user_meta")
-		return ctx.Status(http.StatusUnauthorized).JSON(helpers.WrapErrInErrMap(err))
+		return ErrUnauthorized.Wrap(err)
 	}
 	ctx.Locals(UserMetaKey, &models.User{
 		Id: userMeta.Id,
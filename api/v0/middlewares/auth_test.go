@@ -1,9 +1,11 @@
 package middlewares
 
 import (
+	"encoding/json"
 	"github.com/friendsofgo/errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
 	"platform_engineer_clone/api/helpers"
@@ -18,7 +20,7 @@ func TestProtectedRoute_HappyPath(t *testing.T) {
 
 	authRoutes := NewAuthRoutes(&fakeAuthFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", authRoutes.ProtectedRoute())
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -36,7 +38,7 @@ func TestProtectedRoute_FailPath_Error(t *testing.T) {
 
 	authRoutes := NewAuthRoutes(&fakeAuthFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", authRoutes.ProtectedRoute())
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -54,7 +56,7 @@ func TestProtectedRoute_FailPath_NoMatch(t *testing.T) {
 
 	authRoutes := NewAuthRoutes(&fakeAuthFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", authRoutes.ProtectedRoute())
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -72,7 +74,7 @@ func TestAuthRoutes_AttachUserMeta_HappyPath(t *testing.T) {
 
 	authRoutes := NewAuthRoutes(&fakeAuthFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", func(ctx *fiber.Ctx) error {
 		ctx.Locals(userKey, "abc")
 		ctx.Locals(passKey, "abc")
@@ -93,7 +95,7 @@ func TestAuthRoutes_AttachUserMeta_Fail_ErrorBasicAuth(t *testing.T) {
 
 	authRoutes := NewAuthRoutes(&fakeAuthFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", func(ctx *fiber.Ctx) error {
 		ctx.Locals(userKey, "abc")
 		ctx.Locals(passKey, "abc")
@@ -105,5 +107,10 @@ func TestAuthRoutes_AttachUserMeta_Fail_ErrorBasicAuth(t *testing.T) {
 	resp, _ := app.Test(req, 1)
 	t.Run("Test AuthRoutes - Fail, Error Basic Auth", func(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		var errResp models.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		assert.Equal(t, ErrUnauthorized.Code, errResp.Code)
+		assert.Equal(t, []string{ErrUnauthorized.Message}, errResp.Errors)
 	})
 }
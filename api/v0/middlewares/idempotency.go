@@ -0,0 +1,134 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients send a key of their choosing in, to retry a request safely
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed to a retry
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+var ErrInvalidIdempotencyKey = error_handling.BadRequest("invalid_idempotency_key",
+	"error, Idempotency-Key must be 1 to 255 printable ASCII characters")
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . idempotencyFunctions
+type idempotencyFunctions interface {
+	Begin(ctx context.Context, request *models.IdempotencyRequest) (*models.IdempotencyRecord, error)
+	Complete(ctx context.Context, id int, response *models.IdempotentResponse) error
+	Release(ctx context.Context, id int) error
+}
+
+type Idempotency struct {
+	idempotencyData idempotencyFunctions
+}
+
+func NewIdempotency(idempotencyData idempotencyFunctions) *Idempotency {
+	return &Idempotency{idempotencyData}
+}
+
+// Idempotent makes a mutating route safe to retry. A request sent with an Idempotency-Key header is handled once,
+// and its response replayed to every retry with the same key and body, while a different body is rejected.
+// Keys are scoped to the user and the route, so it must come after AttachUserMeta.
+// Server errors aren't stored, so the request can be retried with the same key.
+func (i *Idempotency) Idempotent(ctx *fiber.Ctx) error {
+	key := ctx.Get(IdempotencyKeyHeader)
+	user, ok := ctx.Locals(UserMetaKey).(*models.User)
+	if key == "" || !ok {
+		return ctx.Next()
+	}
+	if !validIdempotencyKey(key) {
+		return ErrInvalidIdempotencyKey
+	}
+
+	record, err := i.idempotencyData.Begin(ctx.Context(), &models.IdempotencyRequest{
+		UserId:      user.Id,
+		Scope:       ctx.Method() + " " + ctx.Route().Path,
+		Key:         key,
+		RequestHash: requestHash(ctx),
+	})
+	if err != nil {
+		return err
+	}
+	if record.Response != nil {
+		ctx.Set(IdempotentReplayedHeader, "true")
+		ctx.Set(fiber.HeaderContentType, record.Response.ContentType)
+		return ctx.Status(record.Response.StatusCode).Send(record.Response.Body)
+	}
+
+	// A panicking handler leaves no response to store, so the key is freed for a retry before the app recovers
+	defer func() {
+		if r := recover(); r != nil {
+			i.release(ctx, record.Id)
+			panic(r)
+		}
+	}()
+
+	if err = ctx.Next(); err != nil {
+		// Rendered here rather than by the app, so the error response is the one stored
+		if err = ctx.App().Config().ErrorHandler(ctx, err); err != nil {
+			i.release(ctx, record.Id)
+			return err
+		}
+	}
+
+	response := ctx.Response()
+	if response.StatusCode() >= http.StatusInternalServerError {
+		i.release(ctx, record.Id)
+		return nil
+	}
+	err = i.idempotencyData.Complete(ctx.Context(), record.Id, &models.IdempotentResponse{
+		StatusCode:  response.StatusCode(),
+		ContentType: string(response.Header.ContentType()),
+		Body:        append([]byte{}, response.Body()...),
+	})
+	if err != nil {
+		common.GetLogger(ctx.Context()).WithFields(logrus.Fields{
+			"err": err,
+		}).Error("error_complete_idempotency_key")
+		i.release(ctx, record.Id)
+	}
+	return nil
+}
+
+// release frees the key for a retry. Failing to is only logged, since the response was already sent,
+// and the key is freed once its window passes anyway.
+func (i *Idempotency) release(ctx *fiber.Ctx, id int) {
+	if err := i.idempotencyData.Release(ctx.Context(), id); err != nil {
+		common.GetLogger(ctx.Context()).WithFields(logrus.Fields{
+			"err": err,
+		}).Error("error_release_idempotency_key")
+	}
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for _, c := range []byte(key) {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// requestHash tells a retry from a different request sent with the same key
+func requestHash(ctx *fiber.Ctx) string {
+	hash := sha256.New()
+	hash.Write([]byte(ctx.Method() + " " + ctx.Path() + "\n"))
+	hash.Write(ctx.Body())
+	return hex.EncodeToString(hash.Sum(nil))
+}
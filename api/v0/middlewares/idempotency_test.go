@@ -0,0 +1,234 @@
+package middlewares
+
+import (
+	"github.com/friendsofgo/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/api/v0/middlewares/middlewaresfakes"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"strings"
+	"testing"
+)
+
+var errMockHandler = error_handling.New("mock_unavailable", http.StatusServiceUnavailable, "error, mock unavailable")
+
+// newIdempotentApp mounts the middleware the way the router does, behind a user and in front of handler,
+// in an app recovering from panics as main's does
+func newIdempotentApp(fakeIdempotencyFunctions *middlewaresfakes.FakeIdempotencyFunctions, handler fiber.Handler) *fiber.App {
+	idempotency := NewIdempotency(fakeIdempotencyFunctions)
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Use(recover.New())
+	app.Post("/token", func(ctx *fiber.Ctx) error {
+		ctx.Locals(UserMetaKey, &models.User{Id: 3})
+		return ctx.Next()
+	}, idempotency.Idempotent, handler)
+	return app
+}
+
+func idempotentRequest(key string, body string) *http.Request {
+	req := httptest.NewRequest("POST", "/token", strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	return req
+}
+
+func TestIdempotency_Idempotent_HappyPath_FirstRequest(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return ctx.Status(http.StatusCreated).JSON(fiber.Map{"key": "inv_3kd9"})
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{"max_uses":1}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Happy Path First Request", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(IdempotentReplayedHeader))
+
+		_, request := fakeIdempotencyFunctions.BeginArgsForCall(0)
+		assert.Equal(t, 3, request.UserId)
+		assert.Equal(t, "POST /token", request.Scope)
+		assert.Equal(t, "retry-1", request.Key)
+		assert.Len(t, request.RequestHash, 64)
+
+		require.Equal(t, 1, fakeIdempotencyFunctions.CompleteCallCount())
+		_, id, response := fakeIdempotencyFunctions.CompleteArgsForCall(0)
+		assert.Equal(t, 7, id)
+		assert.Equal(t, &models.IdempotentResponse{
+			StatusCode:  http.StatusCreated,
+			ContentType: fiber.MIMEApplicationJSON,
+			Body:        []byte(`{"key":"inv_3kd9"}`),
+		}, response)
+	})
+}
+
+func TestIdempotency_Idempotent_HappyPath_Replay(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7, Response: &models.IdempotentResponse{
+		StatusCode:  http.StatusCreated,
+		ContentType: fiber.MIMEApplicationJSON,
+		Body:        []byte(`{"key":"inv_3kd9"}`),
+	}}, nil)
+
+	handled := false
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		handled = true
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{"max_uses":1}`), -1)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	t.Run("Test Idempotent - Happy Path Replay", func(t *testing.T) {
+		assert.False(t, handled)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "true", resp.Header.Get(IdempotentReplayedHeader))
+		assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+		assert.Equal(t, `{"key":"inv_3kd9"}`, string(body))
+		assert.Equal(t, 0, fakeIdempotencyFunctions.CompleteCallCount())
+	})
+}
+
+func TestIdempotency_Idempotent_HappyPath_NoKey(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	resp, err := app.Test(idempotentRequest("", `{}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Happy Path No Key", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, 0, fakeIdempotencyFunctions.BeginCallCount())
+	})
+}
+
+func TestIdempotency_Idempotent_HappyPath_SameRequestSameHash(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	for _, body := range []string{`{"max_uses":1}`, `{"max_uses":1}`, `{"max_uses":2}`} {
+		_, err := app.Test(idempotentRequest("retry-1", body), -1)
+		require.NoError(t, err)
+	}
+	t.Run("Test Idempotent - Hash Depends On The Body", func(t *testing.T) {
+		_, first := fakeIdempotencyFunctions.BeginArgsForCall(0)
+		_, retry := fakeIdempotencyFunctions.BeginArgsForCall(1)
+		_, different := fakeIdempotencyFunctions.BeginArgsForCall(2)
+		assert.Equal(t, first.RequestHash, retry.RequestHash)
+		assert.NotEqual(t, first.RequestHash, different.RequestHash)
+	})
+}
+
+func TestIdempotency_Idempotent_HappyPath_ClientErrorIsStored(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return error_handling.BadRequest("invalid_max_uses", "error, max_uses must be at least 1")
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{"max_uses":0}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Client Error Is Stored", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Equal(t, 1, fakeIdempotencyFunctions.CompleteCallCount())
+		_, _, response := fakeIdempotencyFunctions.CompleteArgsForCall(0)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		assert.Contains(t, string(response.Body), "invalid_max_uses")
+	})
+}
+
+func TestIdempotency_Idempotent_HappyPath_ServerErrorIsReleased(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return errMockHandler
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Server Error Is Released", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 0, fakeIdempotencyFunctions.CompleteCallCount())
+		require.Equal(t, 1, fakeIdempotencyFunctions.ReleaseCallCount())
+		_, id := fakeIdempotencyFunctions.ReleaseArgsForCall(0)
+		assert.Equal(t, 7, id)
+	})
+}
+
+func TestIdempotency_Idempotent_Fail_HandlerPanics(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		panic("mock handler panic")
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Fail Handler Panics Releases The Key", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, 0, fakeIdempotencyFunctions.CompleteCallCount())
+		require.Equal(t, 1, fakeIdempotencyFunctions.ReleaseCallCount())
+		_, id := fakeIdempotencyFunctions.ReleaseArgsForCall(0)
+		assert.Equal(t, 7, id)
+	})
+}
+
+func TestIdempotency_Idempotent_Fail_Complete(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(&models.IdempotencyRecord{Id: 7}, nil)
+	fakeIdempotencyFunctions.CompleteReturns(errors.New("connection lost"))
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Fail Complete Releases The Key", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, 1, fakeIdempotencyFunctions.ReleaseCallCount())
+	})
+}
+
+func TestIdempotency_Idempotent_Fail_InvalidKey(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	resp, err := app.Test(idempotentRequest(strings.Repeat("k", 256), `{}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Fail Invalid Key", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeIdempotencyFunctions.BeginCallCount())
+	})
+}
+
+func TestIdempotency_Idempotent_Fail_Begin(t *testing.T) {
+	fakeIdempotencyFunctions := middlewaresfakes.FakeIdempotencyFunctions{}
+	fakeIdempotencyFunctions.BeginReturns(nil, error_handling.New("idempotency_key_reused", http.StatusConflict,
+		"error, the idempotency key was already sent with a different request"))
+
+	handled := false
+	app := newIdempotentApp(&fakeIdempotencyFunctions, func(ctx *fiber.Ctx) error {
+		handled = true
+		return ctx.SendStatus(http.StatusCreated)
+	})
+	resp, err := app.Test(idempotentRequest("retry-1", `{"max_uses":2}`), -1)
+	require.NoError(t, err)
+	t.Run("Test Idempotent - Fail Key Reused", func(t *testing.T) {
+		assert.False(t, handled)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+}
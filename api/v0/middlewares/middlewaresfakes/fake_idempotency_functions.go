@@ -0,0 +1,271 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package middlewaresfakes
+
+import (
+	"context"
+	"sync"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeIdempotencyFunctions struct {
+	BeginStub        func(context.Context, *models.IdempotencyRequest) (*models.IdempotencyRecord, error)
+	beginMutex       sync.RWMutex
+	beginArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.IdempotencyRequest
+	}
+	beginReturns struct {
+		result1 *models.IdempotencyRecord
+		result2 error
+	}
+	beginReturnsOnCall map[int]struct {
+		result1 *models.IdempotencyRecord
+		result2 error
+	}
+	CompleteStub        func(context.Context, int, *models.IdempotentResponse) error
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.IdempotentResponse
+	}
+	completeReturns struct {
+		result1 error
+	}
+	completeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReleaseStub        func(context.Context, int) error
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	releaseReturns struct {
+		result1 error
+	}
+	releaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIdempotencyFunctions) Begin(arg1 context.Context, arg2 *models.IdempotencyRequest) (*models.IdempotencyRecord, error) {
+	fake.beginMutex.Lock()
+	ret, specificReturn := fake.beginReturnsOnCall[len(fake.beginArgsForCall)]
+	fake.beginArgsForCall = append(fake.beginArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.IdempotencyRequest
+	}{arg1, arg2})
+	stub := fake.BeginStub
+	fakeReturns := fake.beginReturns
+	fake.recordInvocation("Begin", []interface{}{arg1, arg2})
+	fake.beginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIdempotencyFunctions) BeginCallCount() int {
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	return len(fake.beginArgsForCall)
+}
+
+func (fake *FakeIdempotencyFunctions) BeginCalls(stub func(context.Context, *models.IdempotencyRequest) (*models.IdempotencyRecord, error)) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = stub
+}
+
+func (fake *FakeIdempotencyFunctions) BeginArgsForCall(i int) (context.Context, *models.IdempotencyRequest) {
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	argsForCall := fake.beginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIdempotencyFunctions) BeginReturns(result1 *models.IdempotencyRecord, result2 error) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	fake.beginReturns = struct {
+		result1 *models.IdempotencyRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyFunctions) BeginReturnsOnCall(i int, result1 *models.IdempotencyRecord, result2 error) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	if fake.beginReturnsOnCall == nil {
+		fake.beginReturnsOnCall = make(map[int]struct {
+			result1 *models.IdempotencyRecord
+			result2 error
+		})
+	}
+	fake.beginReturnsOnCall[i] = struct {
+		result1 *models.IdempotencyRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyFunctions) Complete(arg1 context.Context, arg2 int, arg3 *models.IdempotentResponse) error {
+	fake.completeMutex.Lock()
+	ret, specificReturn := fake.completeReturnsOnCall[len(fake.completeArgsForCall)]
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.IdempotentResponse
+	}{arg1, arg2, arg3})
+	stub := fake.CompleteStub
+	fakeReturns := fake.completeReturns
+	fake.recordInvocation("Complete", []interface{}{arg1, arg2, arg3})
+	fake.completeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIdempotencyFunctions) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeIdempotencyFunctions) CompleteCalls(stub func(context.Context, int, *models.IdempotentResponse) error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = stub
+}
+
+func (fake *FakeIdempotencyFunctions) CompleteArgsForCall(i int) (context.Context, int, *models.IdempotentResponse) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	argsForCall := fake.completeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIdempotencyFunctions) CompleteReturns(result1 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	fake.completeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyFunctions) CompleteReturnsOnCall(i int, result1 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	if fake.completeReturnsOnCall == nil {
+		fake.completeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyFunctions) Release(arg1 context.Context, arg2 int) error {
+	fake.releaseMutex.Lock()
+	ret, specificReturn := fake.releaseReturnsOnCall[len(fake.releaseArgsForCall)]
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseStub
+	fakeReturns := fake.releaseReturns
+	fake.recordInvocation("Release", []interface{}{arg1, arg2})
+	fake.releaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIdempotencyFunctions) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakeIdempotencyFunctions) ReleaseCalls(stub func(context.Context, int) error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *FakeIdempotencyFunctions) ReleaseArgsForCall(i int) (context.Context, int) {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	argsForCall := fake.releaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIdempotencyFunctions) ReleaseReturns(result1 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	fake.releaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyFunctions) ReleaseReturnsOnCall(i int, result1 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	if fake.releaseReturnsOnCall == nil {
+		fake.releaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyFunctions) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIdempotencyFunctions) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -1,16 +1,14 @@
 package middlewares
 
 import (
-	"errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
-	"net/http"
-	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/src/utils/error_handling"
 	"time"
 )
 
 var (
-	ErrThrottleLimitExceeded = errors.New("please limit your requests to 5 per 5 seconds")
+	ErrThrottleLimitExceeded = error_handling.Forbidden("throttled", "please limit your requests to 5 per 5 seconds")
 )
 
 func Throttle() func(ctx *fiber.Ctx) error {
@@ -18,7 +16,7 @@ func Throttle() func(ctx *fiber.Ctx) error {
 		Max:        5,
 		Expiration: 5 * time.Second,
 		LimitReached: func(ctx *fiber.Ctx) error {
-			return ctx.Status(http.StatusForbidden).JSON(helpers.WrapErrInErrMap(ErrThrottleLimitExceeded))
+			return ErrThrottleLimitExceeded
 		},
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/models"
 	"sync"
 	"testing"
 )
@@ -15,7 +16,7 @@ var m sync.RWMutex
 var wg sync.WaitGroup
 
 func TestValidate_Throttle(t *testing.T) {
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/:token/validate", Throttle())
 	req := httptest.NewRequest("GET", "/mock_token_value/validate", nil)
 
@@ -43,11 +44,12 @@ func TestValidate_Throttle(t *testing.T) {
 				require.NoError(t, err)
 
 				if resp.StatusCode == http.StatusForbidden {
-					var errorRespExpected map[string][]string
+					var errorRespExpected models.ErrorResponse
 					err = json.Unmarshal([]byte(respBodyStringified), &errorRespExpected)
 					require.NoError(t, err)
 
-					require.Equal(t, helpers.WrapErrInErrMap(ErrThrottleLimitExceeded), errorRespExpected)
+					require.Equal(t, ErrThrottleLimitExceeded.Code, errorRespExpected.Code)
+					require.Equal(t, []string{ErrThrottleLimitExceeded.Message}, errorRespExpected.Errors)
 					m.Lock()
 					errorResponseCount = errorResponseCount + 1
 					m.Unlock()
@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"platform_engineer_clone/models"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . bizFunctions
+type bizFunctions interface {
+	Subscribe(lastEventId string) (*models.TokenStreamSubscription, error)
+	Unsubscribe(subscription *models.TokenStreamSubscription)
+}
+
+// headerLastEventId is sent by EventSource clients when they reconnect
+const headerLastEventId = "Last-Event-ID"
+
+// eventReset tells a resuming client it may have missed events, and should reload what it shows
+const eventReset = "reset"
+
+type APIStream struct {
+	bizLayer          bizFunctions
+	heartbeatInterval time.Duration
+}
+
+func NewAPIStream(bizLayer bizFunctions, heartbeatInterval time.Duration) *APIStream {
+	return &APIStream{bizLayer: bizLayer, heartbeatInterval: heartbeatInterval}
+}
+
+// writeEvent writes the event in the server-sent events format
+func writeEvent(w *bufio.Writer, event *models.TokenStreamEvent) error {
+	data, err := json.Marshal(&event.Event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %v\nevent: %v\ndata: %s\n\n", event.Id, event.Event.Type, data)
+	return err
+}
+
+// writeStream writes the events until the subscription ends, with a heartbeat comment whenever the stream is idle
+// for the heartbeat interval, which keeps proxies from closing it, and finds out when the client has gone
+func (s *APIStream) writeStream(w *bufio.Writer, subscription *models.TokenStreamSubscription) error {
+	if subscription.Reset {
+		if _, err := fmt.Fprintf(w, "event: %v\ndata: {}\n\n", eventReset); err != nil {
+			return err
+		}
+	}
+	for i := range subscription.Backlog {
+		if err := writeEvent(w, &subscription.Backlog[i]); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return nil
+			}
+			if err := writeEvent(w, &event); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if _, err := w.WriteString(": heartbeat\n\n"); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		heartbeat.Reset(s.heartbeatInterval)
+	}
+}
+
+// Stream
+// @Id StreamTokenEvents
+// @Summary Stream
+// @Description Pushes token lifecycle events as server-sent events, named after their type:
+// @Description token.created, token.validated, token.revoked and token.expired, with the event as JSON data.
+// @Description Each event has an id, and clients reconnecting with a "Last-Event-ID" header resume after it,
+// @Description from the latest events the instance keeps. A "reset" event first tells the client
+// @Description some events are no longer kept, or the id wasn't issued by this instance, e.g. before a restart,
+// @Description and it should reload what it shows.
+// @Description Idle streams get a heartbeat comment, and a client too slow to keep up is disconnected, to resume.
+// @Description Each instance streams the events it handles, created, revoked and expired events
+// @Description once they are relayed from the outbox.
+// @Tags Token
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "id of the last event received, to resume after"
+// @Success 200 {object} models.TokenLifecycleEvent
+// @Failure 503 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/stream [get]
+func (s *APIStream) Stream(ctx *fiber.Ctx) error {
+	subscription, err := s.bizLayer.Subscribe(ctx.Get(headerLastEventId))
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	// Writing only fails once the client has gone, which ends the subscription
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer s.bizLayer.Unsubscribe(subscription)
+		_ = s.writeStream(w, subscription)
+	})
+	return nil
+}
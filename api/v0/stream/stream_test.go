@@ -0,0 +1,137 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/api/v0/stream/streamfakes"
+	BusinessStream "platform_engineer_clone/business/v0/stream"
+	"platform_engineer_clone/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mockStreamEvent(id string, eventType string, tokenId int) models.TokenStreamEvent {
+	return models.TokenStreamEvent{
+		Id: id,
+		Event: models.TokenLifecycleEvent{
+			Type:       eventType,
+			Token:      models.TokenRef{Id: tokenId, KeyPrefix: "inv_3k"},
+			OccurredAt: time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC),
+		},
+	}
+}
+
+// mockSubscription returns a subscription that receives the events, then ends
+func mockSubscription(backlog []models.TokenStreamEvent, reset bool, events ...models.TokenStreamEvent) *models.TokenStreamSubscription {
+	live := make(chan models.TokenStreamEvent, len(events))
+	for _, event := range events {
+		live <- event
+	}
+	close(live)
+	return &models.TokenStreamSubscription{Backlog: backlog, Reset: reset, Events: live}
+}
+
+func streamApp(apiStream *APIStream) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/stream", apiStream.Stream)
+	return app
+}
+
+func TestStream_StatusOk(t *testing.T) {
+	fakeBizFunctions := &streamfakes.FakeBizFunctions{}
+	subscription := mockSubscription(nil, false,
+		mockStreamEvent("kq3x-1", models.TokenLifecycleCreated, 3),
+		mockStreamEvent("kq3x-2", models.TokenLifecycleValidated, 3))
+	fakeBizFunctions.SubscribeReturns(subscription, nil)
+
+	req := httptest.NewRequest("GET", "/stream", nil)
+	resp, err := streamApp(NewAPIStream(fakeBizFunctions, time.Minute)).Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Stream - Ok", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get(fiber.HeaderContentType))
+		assert.Equal(t, "no-cache", resp.Header.Get(fiber.HeaderCacheControl))
+		assert.Equal(t, "", fakeBizFunctions.SubscribeArgsForCall(0))
+		assert.Equal(t, subscription, fakeBizFunctions.UnsubscribeArgsForCall(0))
+		assert.Equal(t, "id: kq3x-1\nevent: token.created\n"+
+			`data: {"type":"token.created","token":{"id":3,"key_prefix":"inv_3k"},"occurred_at":"2024-06-01T09:30:00Z"}`+"\n\n"+
+			"id: kq3x-2\nevent: token.validated\n"+
+			`data: {"type":"token.validated","token":{"id":3,"key_prefix":"inv_3k"},"occurred_at":"2024-06-01T09:30:00Z"}`+"\n\n",
+			string(body))
+	})
+}
+
+func TestStream_StatusOk_Resume(t *testing.T) {
+	fakeBizFunctions := &streamfakes.FakeBizFunctions{}
+	fakeBizFunctions.SubscribeReturns(mockSubscription(
+		[]models.TokenStreamEvent{mockStreamEvent("kq3x-4", models.TokenLifecycleRevoked, 3)}, true,
+		mockStreamEvent("kq3x-5", models.TokenLifecycleExpired, 6)), nil)
+
+	req := httptest.NewRequest("GET", "/stream", nil)
+	req.Header.Set("Last-Event-ID", "kq3x-2")
+	resp, err := streamApp(NewAPIStream(fakeBizFunctions, time.Minute)).Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Stream - Ok Resume", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "kq3x-2", fakeBizFunctions.SubscribeArgsForCall(0))
+
+		scanner := bufio.NewScanner(bytes.NewReader(body))
+		lines := []string{}
+		for scanner.Scan() {
+			if scanner.Text() != "" && !strings.HasPrefix(scanner.Text(), "data: ") {
+				lines = append(lines, scanner.Text())
+			}
+		}
+		assert.Equal(t, []string{"event: reset", "id: kq3x-4", "event: token.revoked", "id: kq3x-5", "event: token.expired"}, lines)
+	})
+}
+
+func TestStream_Heartbeat(t *testing.T) {
+	live := make(chan models.TokenStreamEvent)
+	fakeBizFunctions := &streamfakes.FakeBizFunctions{}
+	fakeBizFunctions.SubscribeReturns(&models.TokenStreamSubscription{Events: live}, nil)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(live)
+	}()
+
+	req := httptest.NewRequest("GET", "/stream", nil)
+	resp, err := streamApp(NewAPIStream(fakeBizFunctions, 10*time.Millisecond)).Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Stream - Heartbeat", func(t *testing.T) {
+		assert.Contains(t, string(body), ": heartbeat\n\n")
+	})
+}
+
+func TestStream_StatusServiceUnavailable(t *testing.T) {
+	fakeBizFunctions := &streamfakes.FakeBizFunctions{}
+	fakeBizFunctions.SubscribeReturns(nil, BusinessStream.ErrBrokerClosed)
+
+	req := httptest.NewRequest("GET", "/stream", nil)
+	resp, err := streamApp(NewAPIStream(fakeBizFunctions, time.Minute)).Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Stream - Service Unavailable", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Contains(t, string(body), `"code":"stream_closed"`)
+	})
+}
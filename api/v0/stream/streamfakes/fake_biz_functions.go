@@ -0,0 +1,153 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package streamfakes
+
+import (
+	"sync"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeBizFunctions struct {
+	SubscribeStub        func(string) (*models.TokenStreamSubscription, error)
+	subscribeMutex       sync.RWMutex
+	subscribeArgsForCall []struct {
+		arg1 string
+	}
+	subscribeReturns struct {
+		result1 *models.TokenStreamSubscription
+		result2 error
+	}
+	subscribeReturnsOnCall map[int]struct {
+		result1 *models.TokenStreamSubscription
+		result2 error
+	}
+	UnsubscribeStub        func(*models.TokenStreamSubscription)
+	unsubscribeMutex       sync.RWMutex
+	unsubscribeArgsForCall []struct {
+		arg1 *models.TokenStreamSubscription
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBizFunctions) Subscribe(arg1 string) (*models.TokenStreamSubscription, error) {
+	fake.subscribeMutex.Lock()
+	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
+	fake.subscribeArgsForCall = append(fake.subscribeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SubscribeStub
+	fakeReturns := fake.subscribeReturns
+	fake.recordInvocation("Subscribe", []interface{}{arg1})
+	fake.subscribeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) SubscribeCallCount() int {
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
+	return len(fake.subscribeArgsForCall)
+}
+
+func (fake *FakeBizFunctions) SubscribeCalls(stub func(string) (*models.TokenStreamSubscription, error)) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = stub
+}
+
+func (fake *FakeBizFunctions) SubscribeArgsForCall(i int) string {
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
+	argsForCall := fake.subscribeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBizFunctions) SubscribeReturns(result1 *models.TokenStreamSubscription, result2 error) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = nil
+	fake.subscribeReturns = struct {
+		result1 *models.TokenStreamSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) SubscribeReturnsOnCall(i int, result1 *models.TokenStreamSubscription, result2 error) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = nil
+	if fake.subscribeReturnsOnCall == nil {
+		fake.subscribeReturnsOnCall = make(map[int]struct {
+			result1 *models.TokenStreamSubscription
+			result2 error
+		})
+	}
+	fake.subscribeReturnsOnCall[i] = struct {
+		result1 *models.TokenStreamSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Unsubscribe(arg1 *models.TokenStreamSubscription) {
+	fake.unsubscribeMutex.Lock()
+	fake.unsubscribeArgsForCall = append(fake.unsubscribeArgsForCall, struct {
+		arg1 *models.TokenStreamSubscription
+	}{arg1})
+	stub := fake.UnsubscribeStub
+	fake.recordInvocation("Unsubscribe", []interface{}{arg1})
+	fake.unsubscribeMutex.Unlock()
+	if stub != nil {
+		fake.UnsubscribeStub(arg1)
+	}
+}
+
+func (fake *FakeBizFunctions) UnsubscribeCallCount() int {
+	fake.unsubscribeMutex.RLock()
+	defer fake.unsubscribeMutex.RUnlock()
+	return len(fake.unsubscribeArgsForCall)
+}
+
+func (fake *FakeBizFunctions) UnsubscribeCalls(stub func(*models.TokenStreamSubscription)) {
+	fake.unsubscribeMutex.Lock()
+	defer fake.unsubscribeMutex.Unlock()
+	fake.UnsubscribeStub = stub
+}
+
+func (fake *FakeBizFunctions) UnsubscribeArgsForCall(i int) *models.TokenStreamSubscription {
+	fake.unsubscribeMutex.RLock()
+	defer fake.unsubscribeMutex.RUnlock()
+	argsForCall := fake.unsubscribeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBizFunctions) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
+	fake.unsubscribeMutex.RLock()
+	defer fake.unsubscribeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBizFunctions) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,189 @@
+package token
+
+import (
+	"encoding/json"
+	"github.com/friendsofgo/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/api/v0/token/tokenfakes"
+	BusinessToken "platform_engineer_clone/business/v0/token"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+func mockTokenIterator(tokens ...models.Token) models.TokenIterator {
+	return func(each func(token *models.Token) error) error {
+		for i := range tokens {
+			if err := each(&tokens[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func mockExportTokens() []models.Token {
+	createdAt := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+	return []models.Token{
+		{
+			Id:             1,
+			KeyPrefix:      "ab",
+			CreatedAt:      createdAt,
+			ExpiresAt:      createdAt.AddDate(0, 0, 7),
+			CreatedBy:      "Demby",
+			MaxUses:        null.IntFrom(3),
+			UseCount:       1,
+			Label:          null.StringFrom(`ACME, "beta"`),
+			Note:           null.StringFrom("line one\nline two"),
+			RecipientEmail: null.StringFrom("jane@acme.com"),
+			NotBefore:      null.TimeFrom(createdAt.AddDate(0, 0, 1)),
+		},
+		{
+			Id:        2,
+			KeyPrefix: "de",
+			CreatedAt: createdAt,
+			ExpiresAt: createdAt.AddDate(0, 0, 7),
+			Revoked:   true,
+			CreatedBy: "Demby",
+			Label:     null.StringFrom("=HYPERLINK(\"http://evil\")"),
+		},
+	}
+}
+
+func TestExport_StatusOk_CSV(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ExportReturns(mockTokenIterator(mockExportTokens()...), nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export?status=active&label=ACME", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Export - Ok CSV", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get(fiber.HeaderContentType))
+		assert.Equal(t, `attachment; filename="tokens.csv"`, resp.Header.Get(fiber.HeaderContentDisposition))
+
+		want := "id,key_prefix,created_at,expires_at,revoked,expired,created_by,max_uses,use_count,label,note,recipient_email,not_before,campaign_id\r\n" +
+			"1,ab,2024-06-01T09:30:00Z,2024-06-08T09:30:00Z,false,false,Demby,3,1,\"ACME, \"\"beta\"\"\",\"line one\r\nline two\",jane@acme.com,2024-06-02T09:30:00Z,\r\n" +
+			"2,de,2024-06-01T09:30:00Z,2024-06-08T09:30:00Z,true,false,Demby,,0,\"'=HYPERLINK(\"\"http://evil\"\")\",,,,\r\n"
+		assert.Equal(t, want, string(body))
+
+		_, filter := fakeBizFunctions.ExportArgsForCall(0)
+		assert.Equal(t, "active", filter.Status)
+		assert.Equal(t, "ACME", filter.Label)
+	})
+}
+
+func TestExport_StatusOk_JSON(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ExportReturns(mockTokenIterator(mockExportTokens()...), nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export?format=json", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Export - Ok JSON", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `attachment; filename="tokens.json"`, resp.Header.Get(fiber.HeaderContentDisposition))
+
+		var tokens []models.Token
+		require.NoError(t, json.Unmarshal(body, &tokens))
+		assert.Equal(t, mockExportTokens(), tokens)
+	})
+}
+
+func TestExport_StatusOk_JSONEmpty(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ExportReturns(mockTokenIterator(), nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export?format=json", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	t.Run("Test Export - Ok JSON Empty", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "[]", string(body))
+	})
+}
+
+func TestExport_BadRequest_UnknownFormat(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export?format=xlsx", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Export - Bad Request Unknown Format", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.ExportCallCount())
+	})
+}
+
+func TestExport_BadRequest_InvalidFilter(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ExportReturns(nil, errors.Wrap(BusinessToken.ErrInvalidTokenFilter, "unknown status"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export?status=pending", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Export - Bad Request Invalid Filter", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestExport_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ExportReturns(nil, errMockExport)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/export", apiToken.Export)
+
+	req := httptest.NewRequest("GET", "/export", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Export - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
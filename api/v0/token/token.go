@@ -7,14 +7,25 @@ import (
 	"net/http"
 	"platform_engineer_clone/api/helpers"
 	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . bizFunctions
 type bizFunctions interface {
-	Validate(ctx context.Context, key string) error
-	GetAll(ctx context.Context) ([]models.Token, error)
+	Validate(ctx context.Context, key string, scope string, meta *models.RequestMeta) (*models.TokenValidation, error)
+	GetAll(ctx context.Context, filter *models.TokenFilter) (*models.TokenPage, error)
+	Export(ctx context.Context, filter *models.TokenFilter) (models.TokenIterator, error)
 	Revoke(ctx context.Context, key string) error
-	Generate(ctx context.Context, user *models.User) (string, error)
+	RevokeById(ctx context.Context, id int) error
+	Update(ctx context.Context, key string, params *models.UpdateToken) (*models.Token, error)
+	UpdateById(ctx context.Context, id int, params *models.UpdateToken) (*models.Token, error)
+	Generate(ctx context.Context, user *models.User, params *models.CreateToken) (string, error)
+	GenerateBatch(ctx context.Context, user *models.User, params *models.CreateTokenBatch) ([]string, error)
+	Redeem(ctx context.Context, key string, meta *models.RequestMeta) error
+	GetEvents(ctx context.Context, key string) ([]models.TokenEvent, error)
+	GetEventsById(ctx context.Context, id int) ([]models.TokenEvent, error)
+	GetQuota(ctx context.Context, user *models.User) (*models.TokenQuota, error)
 }
 
 // These error codes are used in tests
@@ -23,8 +34,40 @@ var (
 	errMockRevoke   = errors.New("error, mock Revoke")
 	errMockValidate = errors.New("error, mock Validate")
 	errMockGenerate = errors.New("error, mock Generate")
+	errMockRedeem   = errors.New("error, mock Redeem")
+	errMockEvents   = errors.New("error, mock GetEvents")
+	errMockExport   = errors.New("error, mock Export")
+	errMockUpdate   = errors.New("error, mock Update")
 )
 
+var (
+	errInvalidTokenId     = error_handling.BadRequest("invalid_token_id", "error, token id must be a positive integer")
+	errInvalidBody        = error_handling.BadRequest("invalid_body", "error, invalid request body")
+	errInvalidQuery       = error_handling.BadRequest("invalid_query", "error, invalid query params")
+	errUserMetaConversion = errors.New("error, userMeta conversion fails")
+)
+
+// HeaderNextCursor holds the cursor of the next page of a token listing
+const HeaderNextCursor = "X-Next-Cursor"
+
+// tokenId parses the token id from the path
+func tokenId(ctx *fiber.Ctx) (int, error) {
+	id, err := ctx.ParamsInt("id")
+	if err != nil || id < 1 {
+		return 0, errInvalidTokenId
+	}
+	return id, nil
+}
+
+// requestMeta identifies the client using a token, for the token's audit trail
+func requestMeta(ctx *fiber.Ctx) *models.RequestMeta {
+	return &models.RequestMeta{
+		Ip:        ctx.IP(),
+		UserAgent: ctx.Get(fiber.HeaderUserAgent),
+		RequestId: common.GetRequestId(ctx.Context()),
+	}
+}
+
 type APIToken struct {
 	bizLayer bizFunctions
 }
@@ -36,88 +79,367 @@ func NewAPIToken(bizLayer bizFunctions) *APIToken {
 // ValidateToken
 // @Id ValidateToken
 // @Summary Validate
-// @Description Validates a string token passed
+// @Description Validates a string token passed, and returns its expiry and scopes.
+// @Description Failures carry a stable "reason": malformed keys get a 400 without a lookup, unknown tokens a 404,
+// @Description revoked, expired or exhausted tokens a 410, and tokens without the requested "scope" or used
+// @Description before their "not_before" a 403.
 // @Tags Token
 // @Param token path string true "token"
+// @Param scope query string false "scope the token must carry" example(beta:analytics)
 // @Accept application/json
 // @Produce application/json
-// @Success 200 {boolean} boolean
-// @Failure 400 {object} models.AuthFailBadRequest
-// @Failure 500 {object} models.AuthFailInternalServerError
+// @Success 200 {object} models.TokenValidation
+// @Failure 400 {object} models.TokenValidationFailure
+// @Failure 403 {object} models.TokenValidationFailure
+// @Failure 404 {object} models.TokenValidationFailure
+// @Failure 410 {object} models.TokenValidationFailure
+// @Failure 500 {object} models.TokenValidationFailure
 // @Router /v0/token/{token}/validate [get]
 func (t *APIToken) ValidateToken(ctx *fiber.Ctx) error {
 	token := ctx.Params("token")
 
-	err := t.bizLayer.Validate(ctx.Context(), token)
+	validation, err := t.bizLayer.Validate(ctx.Context(), token, ctx.Query("scope"), requestMeta(ctx))
+	if err != nil {
+		failure := helpers.HTTPError(err)
+		if failure.Status >= http.StatusInternalServerError {
+			helpers.LogRequestError(ctx, err)
+		}
+		return ctx.Status(failure.Status).JSON(models.TokenValidationFailure{
+			Reason: failure.Code,
+			Errors: []string{failure.SafeMessage()},
+		})
+	}
+	return ctx.Status(http.StatusOK).JSON(validation)
+}
+
+// RedeemToken
+// @Id RedeemToken
+// @Summary Redeem
+// @Description Consumes one use of a token, failing with a 410 once it is revoked, expired or its max uses are exhausted
+// @Tags Token
+// @Param token path string true "token"
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {boolean} boolean
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 410 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /v0/token/{token}/redeem [post]
+func (t *APIToken) RedeemToken(ctx *fiber.Ctx) error {
+	token := ctx.Params("token")
+
+	err := t.bizLayer.Redeem(ctx.Context(), token, requestMeta(ctx))
 	if err != nil {
-		return ctx.Status(http.StatusInternalServerError).JSON(helpers.WrapErrInErrMap(err))
+		return err
 	}
 	return ctx.Status(http.StatusOK).JSON(true)
 }
 
+// GetEvents
+// @Id GetEvents
+// @Summary Events
+// @Description Fetches the token's validations and redemptions, most recent first
+// @Tags Token
+// @Param token path string true "token"
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} []models.TokenEvent
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/{token}/events [get]
+func (t *APIToken) GetEvents(ctx *fiber.Ctx) error {
+	token := ctx.Params("token")
+
+	events, err := t.bizLayer.GetEvents(ctx.Context(), token)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(events)
+}
+
+// GetEventsById
+// @Id GetEventsById
+// @Summary Events by id
+// @Description Fetches the token's validations and redemptions by its id, most recent first.
+// @Tags Token
+// @Param id path int true "token id"
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} []models.TokenEvent
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/id/{id}/events [get]
+func (t *APIToken) GetEventsById(ctx *fiber.Ctx) error {
+	id, err := tokenId(ctx)
+	if err != nil {
+		return err
+	}
+
+	events, err := t.bizLayer.GetEventsById(ctx.Context(), id)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(events)
+}
+
 // GetAll
 // @Id GetAll
 // @Summary Fetch all
-// @Description Fetches all tokens added by the admin user
+// @Description Fetches a page of the tokens added by the admin user, newest first by default.
+// @Description When there are more tokens, the X-Next-Cursor header holds the cursor to pass as "cursor" for the next page.
 // @Tags Token
 // @Accept application/json
 // @Produce application/json
+// @Param label query string false "exact label"
+// @Param recipient_email query string false "exact recipient email"
+// @Param search query string false "part of the label, note or recipient email"
+// @Param status query string false "token status" Enums(active, revoked, expired)
+// @Param created_by query int false "creator's user id"
+// @Param campaign_id query int false "campaign id"
+// @Param created_after query string false "YYYY-MM-DD or RFC 3339, inclusive"
+// @Param created_before query string false "YYYY-MM-DD or RFC 3339, exclusive"
+// @Param expires_after query string false "YYYY-MM-DD or RFC 3339, inclusive"
+// @Param expires_before query string false "YYYY-MM-DD or RFC 3339, exclusive"
+// @Param sort query string false "sort order, prefix with - to sort descending" Enums(created_at, -created_at, expires_at, -expires_at)
+// @Param cursor query string false "X-Next-Cursor of the previous page"
+// @Param limit query int false "page size, at most 500" default(50)
 // @Success 200 {object} []models.Token
-// @Failure 400 {object} models.AuthFailBadRequest
-// @Failure 500 {object} models.AuthFailInternalServerError
+// @Header 200 {string} X-Next-Cursor "cursor of the next page, absent on the last page"
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Security BasicAuth
 // @Router /v0/token [get]
 func (t *APIToken) GetAll(ctx *fiber.Ctx) error {
-	tokens, err := t.bizLayer.GetAll(ctx.Context())
+	var filter models.TokenFilter
+	if err := ctx.QueryParser(&filter); err != nil {
+		return errInvalidQuery.Detail(err.Error())
+	}
+
+	page, err := t.bizLayer.GetAll(ctx.Context(), &filter)
 	if err != nil {
-		return ctx.Status(http.StatusInternalServerError).JSON(helpers.WrapErrInErrMap(err))
+		return err
 	}
-	return ctx.Status(http.StatusOK).JSON(tokens)
+	if page.NextCursor != "" {
+		ctx.Set(HeaderNextCursor, page.NextCursor)
+	}
+	return ctx.Status(http.StatusOK).JSON(page.Tokens)
 }
 
 // Revoke
 // @Id Revoke
 // @Summary Revoke
-// @Description Revokes a token's access
+// @Description Revokes a token's access. Unknown and already revoked tokens get a 404.
 // @Tags Token
 // @Accept application/json
 // @Produce application/json
 // @Param token path string true "token"
 // @Success 200 {boolean} boolean
-// @Failure 400 {object} models.AuthFailBadRequest
-// @Failure 500 {object} models.AuthFailInternalServerError
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Security BasicAuth
 // @Router /v0/token/{token}/revoke [delete]
 func (t *APIToken) Revoke(ctx *fiber.Ctx) error {
 	token := ctx.Params("token")
 	err := t.bizLayer.Revoke(ctx.Context(), token)
 	if err != nil {
-		return ctx.Status(http.StatusInternalServerError).JSON(helpers.WrapErrInErrMap(err))
+		return err
+	}
+	return ctx.Status(http.StatusOK).SendString("Revoked token access!")
+}
+
+// RevokeById
+// @Id RevokeById
+// @Summary Revoke by id
+// @Description Revokes a token's access by its id.
+// @Tags Token
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "token id"
+// @Success 200 {boolean} boolean
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/id/{id}/revoke [delete]
+func (t *APIToken) RevokeById(ctx *fiber.Ctx) error {
+	id, err := tokenId(ctx)
+	if err != nil {
+		return err
+	}
+	err = t.bizLayer.RevokeById(ctx.Context(), id)
+	if err != nil {
+		return err
 	}
 	return ctx.Status(http.StatusOK).SendString("Revoked token access!")
 }
 
+// Update
+// @Id Update
+// @Summary Update
+// @Description Extends or shortens a token's expiry, or revokes and reinstates it.
+// @Description "extend_by" is relative to the current expiry, e.g. "48h", and negative to shorten it.
+// @Description The new expiry must be at least the min ttl from now, and within the max ttl of the token's creation.
+// @Tags Token
+// @Accept application/json
+// @Produce application/json
+// @Param token path string true "token"
+// @Param body body models.UpdateToken true "expiry and revoked changes"
+// @Success 200 {object} models.Token
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/{token} [patch]
+func (t *APIToken) Update(ctx *fiber.Ctx) error {
+	token := ctx.Params("token")
+
+	var params models.UpdateToken
+	if err := ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	updated, err := t.bizLayer.Update(ctx.Context(), token, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(updated)
+}
+
+// UpdateById
+// @Id UpdateById
+// @Summary Update by id
+// @Description Updates a token by its id, as the update by token does.
+// @Description In the signed token mode, the expiry is part of the key, so only "revoked" can be changed by id.
+// @Tags Token
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "token id"
+// @Param body body models.UpdateToken true "expiry and revoked changes"
+// @Success 200 {object} models.Token
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/id/{id} [patch]
+func (t *APIToken) UpdateById(ctx *fiber.Ctx) error {
+	id, err := tokenId(ctx)
+	if err != nil {
+		return err
+	}
+
+	var params models.UpdateToken
+	if err = ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	updated, err := t.bizLayer.UpdateById(ctx.Context(), id, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(updated)
+}
+
 // GetToken Creates a new invite token
 // @Id GetToken
 // @Summary Create
-// @Description Creates a new invite token
+// @Description Creates a new invite token. The body is optional, and defaults to the configured days valid with unlimited uses.
+// @Description A request sent with an "Idempotency-Key" header is handled once, and retries with the same key and body
+// @Description get the original response, marked with "Idempotent-Replayed: true", while a different body is a 409.
 // @Tags Token
 // @Accept application/json
 // @Produce application/json
+// @Param Idempotency-Key header string false "key of your choosing, to replay the original response to retries"
+// @Param body body models.CreateToken false "expiry, max uses and label options"
 // @Success 201 {string} string
-// @Failure 400 {object} models.AuthFailBadRequest
-// @Failure 500 {object} models.AuthFailInternalServerError
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 429 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Security BasicAuth
 // @Router /v0/token [post]
 func (t *APIToken) GetToken(ctx *fiber.Ctx) error {
 	userMeta, ok := ctx.Locals("userMeta").(*models.User)
 	if !ok {
-		return ctx.Status(http.StatusInternalServerError).JSON(helpers.WrapStrInErrMap("userMeta conversion fails"))
+		return errUserMetaConversion
+	}
+
+	var params models.CreateToken
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&params); err != nil {
+			return errInvalidBody.Detail(err.Error())
+		}
 	}
 
-	generatedToken, err := t.bizLayer.Generate(ctx.Context(), userMeta)
+	generatedToken, err := t.bizLayer.Generate(ctx.Context(), userMeta, &params)
 	if err != nil {
-		return ctx.Status(http.StatusInternalServerError).JSON(helpers.WrapErrInErrMap(err))
+		return err
 	}
 	return ctx.Status(http.StatusCreated).JSON(generatedToken)
 }
+
+// GetQuota Fetches the admin user's token quota
+// @Id GetQuota
+// @Summary Quota
+// @Description Fetches how many active tokens the admin user has, out of their quota. Active tokens are neither
+// @Description revoked nor expired, and creating tokens past the quota is a 429. "limit" and "remaining" are null
+// @Description when the user's tokens aren't capped.
+// @Tags Me
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} models.TokenQuota
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/me/quota [get]
+func (t *APIToken) GetQuota(ctx *fiber.Ctx) error {
+	userMeta, ok := ctx.Locals("userMeta").(*models.User)
+	if !ok {
+		return errUserMetaConversion
+	}
+
+	quota, err := t.bizLayer.GetQuota(ctx.Context(), userMeta)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(quota)
+}
+
+// GenerateBatch Creates invite tokens in bulk
+// @Id GenerateBatch
+// @Summary Create batch
+// @Description Creates "count" invite tokens sharing the same options, up to the configured cap.
+// @Description Either every token is created, or none are.
+// @Tags Token
+// @Accept application/json
+// @Produce application/json
+// @Param Idempotency-Key header string false "key of your choosing, to replay the original response to retries"
+// @Param body body models.CreateTokenBatch true "count, and the options shared by every token"
+// @Success 201 {object} []string
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 429 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/token/batch [post]
+func (t *APIToken) GenerateBatch(ctx *fiber.Ctx) error {
+	userMeta, ok := ctx.Locals("userMeta").(*models.User)
+	if !ok {
+		return errUserMetaConversion
+	}
+
+	var params models.CreateTokenBatch
+	if err := ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	generatedTokens, err := t.bizLayer.GenerateBatch(ctx.Context(), userMeta, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusCreated).JSON(generatedTokens)
+}
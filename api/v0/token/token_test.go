@@ -1,12 +1,18 @@
 package token
 
 import (
+	"encoding/json"
+	"github.com/friendsofgo/errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
 	"platform_engineer_clone/api/v0/token/tokenfakes"
+	BusinessToken "platform_engineer_clone/business/v0/token"
 	"platform_engineer_clone/models"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -17,7 +23,7 @@ func TestGetToken_StatusCreated(t *testing.T) {
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Post("/", func(ctx *fiber.Ctx) error {
 		ctx.Locals("userMeta", &models.User{Id: 1})
 		return ctx.Next()
@@ -37,7 +43,7 @@ func TestGetToken_InternalServerError_GenerateFails(t *testing.T) {
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Post("/", func(ctx *fiber.Ctx) error {
 		ctx.Locals("userMeta", &models.User{Id: 1})
 		return ctx.Next()
@@ -51,12 +57,78 @@ func TestGetToken_InternalServerError_GenerateFails(t *testing.T) {
 	})
 }
 
+func TestGetToken_StatusCreated_WithBody(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateReturns("12345", nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetToken)
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"expires_in":"48h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetToken - StatusCreated With Body", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		_, _, params := fakeBizFunctions.GenerateArgsForCall(0)
+		assert.Equal(t, "48h", params.ExpiresIn)
+	})
+}
+
+func TestGetToken_BadRequest_InvalidBody(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetToken)
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"expires_in":`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetToken - Bad Request Invalid Body", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.GenerateCallCount())
+	})
+}
+
+func TestGetToken_BadRequest_TTLOutOfBounds(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateReturns("", errors.Wrap(BusinessToken.ErrTokenTTLOutOfBounds, "mock"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetToken)
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"expires_in":"1000h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetToken - Bad Request TTL Out Of Bounds", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
 func TestGetToken_InternalServerError_UserMetaFails(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", apiToken.GetToken)
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -67,16 +139,85 @@ func TestGetToken_InternalServerError_UserMetaFails(t *testing.T) {
 	})
 }
 
+func TestGetToken_TooManyRequests_QuotaExceeded(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateReturns("", errors.Wrap(models.ErrTokenQuotaExceeded.Detail("100 of 100 active tokens in use"), "mock"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetToken)
+
+	req := httptest.NewRequest("POST", "/", nil)
+
+	resp, _ := app.Test(req, 1)
+	var body models.ErrorResponse
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+	t.Run("Test GetToken - Too Many Requests Quota Exceeded", func(t *testing.T) {
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "token_quota_exceeded", body.Code)
+	})
+}
+
+func TestGetQuota_StatusOk(t *testing.T) {
+	limit, remaining := 100, 58
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetQuotaReturns(&models.TokenQuota{Limit: &limit, Used: 42, Remaining: &remaining}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetQuota)
+
+	req := httptest.NewRequest("GET", "/", nil)
+
+	resp, _ := app.Test(req, 1)
+	var quota models.TokenQuota
+	_ = json.NewDecoder(resp.Body).Decode(&quota)
+	t.Run("Test GetQuota - Status Ok", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.TokenQuota{Limit: &limit, Used: 42, Remaining: &remaining}, quota)
+		_, user := fakeBizFunctions.GetQuotaArgsForCall(0)
+		assert.Equal(t, 1, user.Id)
+	})
+}
+
+func TestGetQuota_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetQuotaReturns(nil, errors.New("mock error"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GetQuota)
+
+	req := httptest.NewRequest("GET", "/", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetQuota - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
 var m sync.RWMutex
 var wg sync.WaitGroup
 
 func TestValidate_StatusOk(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
-	fakeBizFunctions.ValidateReturns(nil)
+	fakeBizFunctions.ValidateReturns(&models.TokenValidation{Valid: true, Scopes: []string{}}, nil)
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/:token/validate", apiToken.ValidateToken)
 
 	req := httptest.NewRequest("GET", "/mock_token_value/validate", nil)
@@ -89,11 +230,11 @@ func TestValidate_StatusOk(t *testing.T) {
 
 func TestValidate_InternalServerError(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
-	fakeBizFunctions.ValidateReturns(errMockValidate)
+	fakeBizFunctions.ValidateReturns(nil, errMockValidate)
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/:token/validate", apiToken.ValidateToken)
 
 	req := httptest.NewRequest("GET", "/mock_token_value/validate", nil)
@@ -104,13 +245,169 @@ func TestValidate_InternalServerError(t *testing.T) {
 	})
 }
 
+func TestValidate_BadRequest_MalformedKey(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ValidateReturns(nil, errors.Wrap(BusinessToken.ErrMalformedKey, "checksum mismatch"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/:token/validate", apiToken.ValidateToken)
+
+	req := httptest.NewRequest("GET", "/inv_garbage/validate", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Validate - Bad Request Malformed Key", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestRedeem_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.RedeemReturns(nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/:token/redeem", apiToken.RedeemToken)
+
+	req := httptest.NewRequest("POST", "/mock_token_value/redeem", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Redeem - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestRedeem_Gone_Exhausted(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.RedeemReturns(BusinessToken.ErrTokenExhausted)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/:token/redeem", apiToken.RedeemToken)
+
+	req := httptest.NewRequest("POST", "/mock_token_value/redeem", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Redeem - Gone Exhausted", func(t *testing.T) {
+		assert.Equal(t, http.StatusGone, resp.StatusCode)
+	})
+}
+
+func TestRedeem_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.RedeemReturns(errMockRedeem)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/:token/redeem", apiToken.RedeemToken)
+
+	req := httptest.NewRequest("POST", "/mock_token_value/redeem", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Redeem - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
+func TestGetEvents_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetEventsReturns([]models.TokenEvent{{Id: 1}}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/:token/events", apiToken.GetEvents)
+
+	req := httptest.NewRequest("GET", "/mock_token_value/events", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetEvents - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestGetEvents_NotFound(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetEventsReturns(nil, errors.Wrap(models.ErrNotFound, "mock no results"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/:token/events", apiToken.GetEvents)
+
+	req := httptest.NewRequest("GET", "/mock_token_value/events", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetEvents - Not Found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetEventsById_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetEventsByIdReturns([]models.TokenEvent{{Id: 1}}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/id/:id/events", apiToken.GetEventsById)
+
+	req := httptest.NewRequest("GET", "/id/4/events", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetEventsById - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, id := fakeBizFunctions.GetEventsByIdArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestGetEventsById_BadRequest_InvalidId(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/id/:id/events", apiToken.GetEventsById)
+
+	req := httptest.NewRequest("GET", "/id/abc/events", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetEventsById - Bad Request Invalid Id", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.GetEventsByIdCallCount())
+	})
+}
+
+func TestGetEvents_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetEventsReturns(nil, errMockEvents)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/:token/events", apiToken.GetEvents)
+
+	req := httptest.NewRequest("GET", "/mock_token_value/events", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetEvents - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
 func TestRevoke_StatusOk(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
 	fakeBizFunctions.RevokeReturns(nil)
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Delete("/:token/revoke", apiToken.Revoke)
 
 	req := httptest.NewRequest("DELETE", "/mock_token_value/revoke", nil)
@@ -127,7 +424,7 @@ func TestRevoke_InternalServerError(t *testing.T) {
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Delete("/:token/revoke", apiToken.Revoke)
 
 	req := httptest.NewRequest("DELETE", "/mock_token_value/revoke", nil)
@@ -138,13 +435,67 @@ func TestRevoke_InternalServerError(t *testing.T) {
 	})
 }
 
+func TestRevokeById_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.RevokeByIdReturns(nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Delete("/id/:id/revoke", apiToken.RevokeById)
+
+	req := httptest.NewRequest("DELETE", "/id/4/revoke", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test RevokeById - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, id := fakeBizFunctions.RevokeByIdArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestRevokeById_BadRequest(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Delete("/id/:id/revoke", apiToken.RevokeById)
+
+	req := httptest.NewRequest("DELETE", "/id/abc/revoke", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test RevokeById - Bad Request", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.RevokeByIdCallCount())
+	})
+}
+
+func TestRevokeById_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.RevokeByIdReturns(errMockRevoke)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Delete("/id/:id/revoke", apiToken.RevokeById)
+
+	req := httptest.NewRequest("DELETE", "/id/4/revoke", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test RevokeById - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
 func TestGetAll_StatusOk(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
-	fakeBizFunctions.GetAllReturns(nil, nil)
+	fakeBizFunctions.GetAllReturns(&models.TokenPage{}, nil)
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", apiToken.GetAll)
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -155,13 +506,39 @@ func TestGetAll_StatusOk(t *testing.T) {
 	})
 }
 
+func TestGetAll_StatusOk_NextCursor(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetAllReturns(&models.TokenPage{
+		Tokens:     []models.Token{{Id: 2, KeyPrefix: "inv_3k"}},
+		NextCursor: "eyJ2IjoiMjAyNC0wNi0wMVQwMDowMDowMFoiLCJpZCI6Mn0",
+	}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/", apiToken.GetAll)
+
+	req := httptest.NewRequest("GET", "/?limit=1", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetAll - Ok Next Cursor", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "eyJ2IjoiMjAyNC0wNi0wMVQwMDowMDowMFoiLCJpZCI6Mn0", resp.Header.Get(HeaderNextCursor))
+
+		var tokens []models.Token
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&tokens))
+		require.Len(t, tokens, 1)
+		assert.Equal(t, 2, tokens[0].Id)
+	})
+}
+
 func TestGetAll_InternalServerError(t *testing.T) {
 	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
 	fakeBizFunctions.GetAllReturns(nil, errMockGetAll)
 
 	apiToken := NewAPIToken(fakeBizFunctions)
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
 	app.Get("/", apiToken.GetAll)
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -171,3 +548,301 @@ func TestGetAll_InternalServerError(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
 }
+
+func TestGetAll_StatusOk_Filtered(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetAllReturns(&models.TokenPage{}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/", apiToken.GetAll)
+
+	req := httptest.NewRequest("GET", "/?label=ACME&recipient_email=jane@acme.com&search=kickoff"+
+		"&status=active&created_by=3&created_after=2024-06-01&sort=-expires_at&limit=20", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetAll - Ok Filtered", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, filter := fakeBizFunctions.GetAllArgsForCall(0)
+		assert.Equal(t, "ACME", filter.Label)
+		assert.Equal(t, "jane@acme.com", filter.RecipientEmail)
+		assert.Equal(t, "kickoff", filter.Search)
+		assert.Equal(t, "active", filter.Status)
+		assert.Equal(t, 3, filter.CreatedBy)
+		assert.Equal(t, "2024-06-01", filter.CreatedAfter)
+		assert.Equal(t, "-expires_at", filter.Sort)
+		assert.Equal(t, 20, filter.Limit)
+	})
+}
+
+func TestGetAll_BadRequest_InvalidFilter(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetAllReturns(nil, errors.Wrap(BusinessToken.ErrInvalidTokenFilter, "unknown status"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/", apiToken.GetAll)
+
+	req := httptest.NewRequest("GET", "/?status=pending", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GetAll - Bad Request Invalid Filter", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestGenerateBatch_StatusCreated(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateBatchReturns([]string{"12345", "67890"}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/batch", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GenerateBatch)
+
+	req := httptest.NewRequest("POST", "/batch", strings.NewReader(`{"count":2,"expires_in":"48h","label":"Launch"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GenerateBatch - StatusCreated", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		_, _, params := fakeBizFunctions.GenerateBatchArgsForCall(0)
+		assert.Equal(t, 2, params.Count)
+		assert.Equal(t, "48h", params.ExpiresIn)
+		assert.Equal(t, "Launch", params.Label)
+	})
+}
+
+func TestGenerateBatch_BadRequest_Count(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateBatchReturns(nil, errors.Wrap(BusinessToken.ErrInvalidBatchCount, "count must be between 1 and 500"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/batch", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GenerateBatch)
+
+	req := httptest.NewRequest("POST", "/batch", strings.NewReader(`{"count":1000}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GenerateBatch - Bad Request Count", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestGenerateBatch_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.GenerateBatchReturns(nil, errMockGenerate)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Post("/batch", func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}, apiToken.GenerateBatch)
+
+	req := httptest.NewRequest("POST", "/batch", strings.NewReader(`{"count":2}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test GenerateBatch - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
+func TestUpdate_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateReturns(&models.Token{Id: 4}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/:token", apiToken.Update)
+
+	req := httptest.NewRequest("PATCH", "/mock_token_value", strings.NewReader(`{"extend_by":"48h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Update - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, key, params := fakeBizFunctions.UpdateArgsForCall(0)
+		assert.Equal(t, "mock_token_value", key)
+		assert.Equal(t, "48h", params.ExtendBy)
+	})
+}
+
+func TestUpdateById_StatusOk(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateByIdReturns(&models.Token{Id: 4}, nil)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/id/:id", apiToken.UpdateById)
+
+	req := httptest.NewRequest("PATCH", "/id/4", strings.NewReader(`{"extend_by":"48h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test UpdateById - StatusOk", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, id, params := fakeBizFunctions.UpdateByIdArgsForCall(0)
+		assert.Equal(t, 4, id)
+		assert.Equal(t, "48h", params.ExtendBy)
+	})
+}
+
+func TestUpdateById_NotFound(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateByIdReturns(nil, errors.Wrap(models.ErrNotFound, "mock no results"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/id/:id", apiToken.UpdateById)
+
+	req := httptest.NewRequest("PATCH", "/id/9", strings.NewReader(`{"extend_by":"48h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test UpdateById - Not Found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestUpdate_BadRequest(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateReturns(nil, errors.Wrap(BusinessToken.ErrTokenTTLOutOfBounds, "mock ttl"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/:token", apiToken.Update)
+
+	req := httptest.NewRequest("PATCH", "/mock_token_value", strings.NewReader(`{"extend_by":"9000h"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Update - Bad Request", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestUpdate_NotFound(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateReturns(nil, errors.Wrap(models.ErrNotFound, "mock no results"))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/:token", apiToken.Update)
+
+	req := httptest.NewRequest("PATCH", "/mock_token_value", strings.NewReader(`{"revoked":false}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Update - Not Found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestUpdate_InternalServerError(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateReturns(nil, errMockUpdate)
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Patch("/:token", apiToken.Update)
+
+	req := httptest.NewRequest("PATCH", "/mock_token_value", strings.NewReader(`{"revoked":false}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Update - Internal Server Error", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
+func TestValidate_Forbidden_MissingScope(t *testing.T) {
+	fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+	fakeBizFunctions.ValidateReturns(nil, errors.Wrap(BusinessToken.ErrMissingScope, `"org:43"`))
+
+	apiToken := NewAPIToken(fakeBizFunctions)
+
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	app.Get("/:token/validate", apiToken.ValidateToken)
+
+	req := httptest.NewRequest("GET", "/mock_token_value/validate?scope=org:43", nil)
+
+	resp, _ := app.Test(req, 1)
+	t.Run("Test Validate - Forbidden Missing Scope", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		_, _, scope, _ := fakeBizFunctions.ValidateArgsForCall(0)
+		assert.Equal(t, "org:43", scope)
+	})
+}
+
+func TestValidate_FailureReasons(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		reason  string
+		message string
+	}{
+		{"Malformed", BusinessToken.ErrMalformedKey.Detail("checksum mismatch"), http.StatusBadRequest,
+			models.TokenInvalidReasonMalformed, "error, malformed token key: checksum mismatch"},
+		{"Not Found", errors.Wrap(models.ErrNotFound.Wrap(errMockValidate), "error, Get fails"), http.StatusNotFound,
+			models.TokenInvalidReasonNotFound, "error, record not found"},
+		{"Revoked", BusinessToken.ErrTokenRevoked, http.StatusGone,
+			models.TokenInvalidReasonRevoked, "error, token is revoked"},
+		{"Expired", BusinessToken.ErrTokenExpired, http.StatusGone,
+			models.TokenInvalidReasonExpired, "error, token has already expired"},
+		{"Exhausted", BusinessToken.ErrTokenExhausted, http.StatusGone,
+			models.TokenInvalidReasonExhausted, "error, token has no uses remaining"},
+		{"Missing Scope", BusinessToken.ErrMissingScope, http.StatusForbidden,
+			models.TokenInvalidReasonMissingScope, "error, token does not carry the scope"},
+		{"Not Yet Active", BusinessToken.ErrTokenNotYetActive.Detail("usable from 2024-05-01T09:00:00Z"), http.StatusForbidden,
+			models.TokenInvalidReasonNotYetActive, "error, token is not active yet: usable from 2024-05-01T09:00:00Z"},
+		{"Internal Error", errMockValidate, http.StatusInternalServerError,
+			models.TokenInvalidReasonInternalError, "error, internal server error"},
+	}
+	for _, tt := range tests {
+		fakeBizFunctions := &tokenfakes.FakeBizFunctions{}
+		fakeBizFunctions.ValidateReturns(nil, tt.err)
+
+		apiToken := NewAPIToken(fakeBizFunctions)
+
+		app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+		app.Get("/:token/validate", apiToken.ValidateToken)
+
+		req := httptest.NewRequest("GET", "/mock_token_value/validate", nil)
+
+		resp, _ := app.Test(req, 1)
+		t.Run("Test Validate - Failure Reason "+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, resp.StatusCode)
+
+			var failure models.TokenValidationFailure
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&failure))
+			assert.False(t, failure.Valid)
+			assert.Equal(t, tt.reason, failure.Reason)
+			assert.Equal(t, []string{tt.message}, failure.Errors)
+		})
+	}
+}
@@ -9,11 +9,26 @@ import (
 )
 
 type FakeBizFunctions struct {
-	GenerateStub        func(context.Context, *models.User) (string, error)
+	ExportStub        func(context.Context, *models.TokenFilter) (models.TokenIterator, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.TokenFilter
+	}
+	exportReturns struct {
+		result1 models.TokenIterator
+		result2 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 models.TokenIterator
+		result2 error
+	}
+	GenerateStub        func(context.Context, *models.User, *models.CreateToken) (string, error)
 	generateMutex       sync.RWMutex
 	generateArgsForCall []struct {
 		arg1 context.Context
 		arg2 *models.User
+		arg3 *models.CreateToken
 	}
 	generateReturns struct {
 		result1 string
@@ -23,19 +38,90 @@ type FakeBizFunctions struct {
 		result1 string
 		result2 error
 	}
-	GetAllStub        func(context.Context) ([]models.Token, error)
+	GenerateBatchStub        func(context.Context, *models.User, *models.CreateTokenBatch) ([]string, error)
+	generateBatchMutex       sync.RWMutex
+	generateBatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateTokenBatch
+	}
+	generateBatchReturns struct {
+		result1 []string
+		result2 error
+	}
+	generateBatchReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetAllStub        func(context.Context, *models.TokenFilter) (*models.TokenPage, error)
 	getAllMutex       sync.RWMutex
 	getAllArgsForCall []struct {
 		arg1 context.Context
+		arg2 *models.TokenFilter
 	}
 	getAllReturns struct {
-		result1 []models.Token
+		result1 *models.TokenPage
 		result2 error
 	}
 	getAllReturnsOnCall map[int]struct {
-		result1 []models.Token
+		result1 *models.TokenPage
 		result2 error
 	}
+	GetEventsStub        func(context.Context, string) ([]models.TokenEvent, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getEventsReturns struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	GetEventsByIdStub        func(context.Context, int) ([]models.TokenEvent, error)
+	getEventsByIdMutex       sync.RWMutex
+	getEventsByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getEventsByIdReturns struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	getEventsByIdReturnsOnCall map[int]struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	GetQuotaStub        func(context.Context, *models.User) (*models.TokenQuota, error)
+	getQuotaMutex       sync.RWMutex
+	getQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+	}
+	getQuotaReturns struct {
+		result1 *models.TokenQuota
+		result2 error
+	}
+	getQuotaReturnsOnCall map[int]struct {
+		result1 *models.TokenQuota
+		result2 error
+	}
+	RedeemStub        func(context.Context, string, *models.RequestMeta) error
+	redeemMutex       sync.RWMutex
+	redeemArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *models.RequestMeta
+	}
+	redeemReturns struct {
+		result1 error
+	}
+	redeemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RevokeStub        func(context.Context, string) error
 	revokeMutex       sync.RWMutex
 	revokeArgsForCall []struct {
@@ -48,35 +134,147 @@ type FakeBizFunctions struct {
 	revokeReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ValidateStub        func(context.Context, string) error
+	RevokeByIdStub        func(context.Context, int) error
+	revokeByIdMutex       sync.RWMutex
+	revokeByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	revokeByIdReturns struct {
+		result1 error
+	}
+	revokeByIdReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStub        func(context.Context, string, *models.UpdateToken) (*models.Token, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *models.UpdateToken
+	}
+	updateReturns struct {
+		result1 *models.Token
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *models.Token
+		result2 error
+	}
+	UpdateByIdStub        func(context.Context, int, *models.UpdateToken) (*models.Token, error)
+	updateByIdMutex       sync.RWMutex
+	updateByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateToken
+	}
+	updateByIdReturns struct {
+		result1 *models.Token
+		result2 error
+	}
+	updateByIdReturnsOnCall map[int]struct {
+		result1 *models.Token
+		result2 error
+	}
+	ValidateStub        func(context.Context, string, string, *models.RequestMeta) (*models.TokenValidation, error)
 	validateMutex       sync.RWMutex
 	validateArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
+		arg3 string
+		arg4 *models.RequestMeta
 	}
 	validateReturns struct {
-		result1 error
+		result1 *models.TokenValidation
+		result2 error
 	}
 	validateReturnsOnCall map[int]struct {
-		result1 error
+		result1 *models.TokenValidation
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBizFunctions) Generate(arg1 context.Context, arg2 *models.User) (string, error) {
+func (fake *FakeBizFunctions) Export(arg1 context.Context, arg2 *models.TokenFilter) (models.TokenIterator, error) {
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.TokenFilter
+	}{arg1, arg2})
+	stub := fake.ExportStub
+	fakeReturns := fake.exportReturns
+	fake.recordInvocation("Export", []interface{}{arg1, arg2})
+	fake.exportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeBizFunctions) ExportCalls(stub func(context.Context, *models.TokenFilter) (models.TokenIterator, error)) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = stub
+}
+
+func (fake *FakeBizFunctions) ExportArgsForCall(i int) (context.Context, *models.TokenFilter) {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	argsForCall := fake.exportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) ExportReturns(result1 models.TokenIterator, result2 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 models.TokenIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) ExportReturnsOnCall(i int, result1 models.TokenIterator, result2 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 models.TokenIterator
+			result2 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 models.TokenIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Generate(arg1 context.Context, arg2 *models.User, arg3 *models.CreateToken) (string, error) {
 	fake.generateMutex.Lock()
 	ret, specificReturn := fake.generateReturnsOnCall[len(fake.generateArgsForCall)]
 	fake.generateArgsForCall = append(fake.generateArgsForCall, struct {
 		arg1 context.Context
 		arg2 *models.User
-	}{arg1, arg2})
+		arg3 *models.CreateToken
+	}{arg1, arg2, arg3})
 	stub := fake.GenerateStub
 	fakeReturns := fake.generateReturns
-	fake.recordInvocation("Generate", []interface{}{arg1, arg2})
+	fake.recordInvocation("Generate", []interface{}{arg1, arg2, arg3})
 	fake.generateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -90,17 +288,17 @@ func (fake *FakeBizFunctions) GenerateCallCount() int {
 	return len(fake.generateArgsForCall)
 }
 
-func (fake *FakeBizFunctions) GenerateCalls(stub func(context.Context, *models.User) (string, error)) {
+func (fake *FakeBizFunctions) GenerateCalls(stub func(context.Context, *models.User, *models.CreateToken) (string, error)) {
 	fake.generateMutex.Lock()
 	defer fake.generateMutex.Unlock()
 	fake.GenerateStub = stub
 }
 
-func (fake *FakeBizFunctions) GenerateArgsForCall(i int) (context.Context, *models.User) {
+func (fake *FakeBizFunctions) GenerateArgsForCall(i int) (context.Context, *models.User, *models.CreateToken) {
 	fake.generateMutex.RLock()
 	defer fake.generateMutex.RUnlock()
 	argsForCall := fake.generateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeBizFunctions) GenerateReturns(result1 string, result2 error) {
@@ -129,18 +327,85 @@ func (fake *FakeBizFunctions) GenerateReturnsOnCall(i int, result1 string, resul
 	}{result1, result2}
 }
 
-func (fake *FakeBizFunctions) GetAll(arg1 context.Context) ([]models.Token, error) {
+func (fake *FakeBizFunctions) GenerateBatch(arg1 context.Context, arg2 *models.User, arg3 *models.CreateTokenBatch) ([]string, error) {
+	fake.generateBatchMutex.Lock()
+	ret, specificReturn := fake.generateBatchReturnsOnCall[len(fake.generateBatchArgsForCall)]
+	fake.generateBatchArgsForCall = append(fake.generateBatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateTokenBatch
+	}{arg1, arg2, arg3})
+	stub := fake.GenerateBatchStub
+	fakeReturns := fake.generateBatchReturns
+	fake.recordInvocation("GenerateBatch", []interface{}{arg1, arg2, arg3})
+	fake.generateBatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GenerateBatchCallCount() int {
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
+	return len(fake.generateBatchArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GenerateBatchCalls(stub func(context.Context, *models.User, *models.CreateTokenBatch) ([]string, error)) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = stub
+}
+
+func (fake *FakeBizFunctions) GenerateBatchArgsForCall(i int) (context.Context, *models.User, *models.CreateTokenBatch) {
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
+	argsForCall := fake.generateBatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) GenerateBatchReturns(result1 []string, result2 error) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = nil
+	fake.generateBatchReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GenerateBatchReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = nil
+	if fake.generateBatchReturnsOnCall == nil {
+		fake.generateBatchReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.generateBatchReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetAll(arg1 context.Context, arg2 *models.TokenFilter) (*models.TokenPage, error) {
 	fake.getAllMutex.Lock()
 	ret, specificReturn := fake.getAllReturnsOnCall[len(fake.getAllArgsForCall)]
 	fake.getAllArgsForCall = append(fake.getAllArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 *models.TokenFilter
+	}{arg1, arg2})
 	stub := fake.GetAllStub
 	fakeReturns := fake.getAllReturns
-	fake.recordInvocation("GetAll", []interface{}{arg1})
+	fake.recordInvocation("GetAll", []interface{}{arg1, arg2})
 	fake.getAllMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -154,45 +419,303 @@ func (fake *FakeBizFunctions) GetAllCallCount() int {
 	return len(fake.getAllArgsForCall)
 }
 
-func (fake *FakeBizFunctions) GetAllCalls(stub func(context.Context) ([]models.Token, error)) {
+func (fake *FakeBizFunctions) GetAllCalls(stub func(context.Context, *models.TokenFilter) (*models.TokenPage, error)) {
 	fake.getAllMutex.Lock()
 	defer fake.getAllMutex.Unlock()
 	fake.GetAllStub = stub
 }
 
-func (fake *FakeBizFunctions) GetAllArgsForCall(i int) context.Context {
+func (fake *FakeBizFunctions) GetAllArgsForCall(i int) (context.Context, *models.TokenFilter) {
 	fake.getAllMutex.RLock()
 	defer fake.getAllMutex.RUnlock()
 	argsForCall := fake.getAllArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeBizFunctions) GetAllReturns(result1 []models.Token, result2 error) {
+func (fake *FakeBizFunctions) GetAllReturns(result1 *models.TokenPage, result2 error) {
 	fake.getAllMutex.Lock()
 	defer fake.getAllMutex.Unlock()
 	fake.GetAllStub = nil
 	fake.getAllReturns = struct {
-		result1 []models.Token
+		result1 *models.TokenPage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeBizFunctions) GetAllReturnsOnCall(i int, result1 []models.Token, result2 error) {
+func (fake *FakeBizFunctions) GetAllReturnsOnCall(i int, result1 *models.TokenPage, result2 error) {
 	fake.getAllMutex.Lock()
 	defer fake.getAllMutex.Unlock()
 	fake.GetAllStub = nil
 	if fake.getAllReturnsOnCall == nil {
 		fake.getAllReturnsOnCall = make(map[int]struct {
-			result1 []models.Token
+			result1 *models.TokenPage
 			result2 error
 		})
 	}
 	fake.getAllReturnsOnCall[i] = struct {
-		result1 []models.Token
+		result1 *models.TokenPage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetEvents(arg1 context.Context, arg2 string) ([]models.TokenEvent, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetEventsStub
+	fakeReturns := fake.getEventsReturns
+	fake.recordInvocation("GetEvents", []interface{}{arg1, arg2})
+	fake.getEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetEventsCalls(stub func(context.Context, string) ([]models.TokenEvent, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeBizFunctions) GetEventsArgsForCall(i int) (context.Context, string) {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetEventsReturns(result1 []models.TokenEvent, result2 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []models.TokenEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetEventsReturnsOnCall(i int, result1 []models.TokenEvent, result2 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []models.TokenEvent
+			result2 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []models.TokenEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetEventsById(arg1 context.Context, arg2 int) ([]models.TokenEvent, error) {
+	fake.getEventsByIdMutex.Lock()
+	ret, specificReturn := fake.getEventsByIdReturnsOnCall[len(fake.getEventsByIdArgsForCall)]
+	fake.getEventsByIdArgsForCall = append(fake.getEventsByIdArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetEventsByIdStub
+	fakeReturns := fake.getEventsByIdReturns
+	fake.recordInvocation("GetEventsById", []interface{}{arg1, arg2})
+	fake.getEventsByIdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetEventsByIdCallCount() int {
+	fake.getEventsByIdMutex.RLock()
+	defer fake.getEventsByIdMutex.RUnlock()
+	return len(fake.getEventsByIdArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetEventsByIdCalls(stub func(context.Context, int) ([]models.TokenEvent, error)) {
+	fake.getEventsByIdMutex.Lock()
+	defer fake.getEventsByIdMutex.Unlock()
+	fake.GetEventsByIdStub = stub
+}
+
+func (fake *FakeBizFunctions) GetEventsByIdArgsForCall(i int) (context.Context, int) {
+	fake.getEventsByIdMutex.RLock()
+	defer fake.getEventsByIdMutex.RUnlock()
+	argsForCall := fake.getEventsByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetEventsByIdReturns(result1 []models.TokenEvent, result2 error) {
+	fake.getEventsByIdMutex.Lock()
+	defer fake.getEventsByIdMutex.Unlock()
+	fake.GetEventsByIdStub = nil
+	fake.getEventsByIdReturns = struct {
+		result1 []models.TokenEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetEventsByIdReturnsOnCall(i int, result1 []models.TokenEvent, result2 error) {
+	fake.getEventsByIdMutex.Lock()
+	defer fake.getEventsByIdMutex.Unlock()
+	fake.GetEventsByIdStub = nil
+	if fake.getEventsByIdReturnsOnCall == nil {
+		fake.getEventsByIdReturnsOnCall = make(map[int]struct {
+			result1 []models.TokenEvent
+			result2 error
+		})
+	}
+	fake.getEventsByIdReturnsOnCall[i] = struct {
+		result1 []models.TokenEvent
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeBizFunctions) GetQuota(arg1 context.Context, arg2 *models.User) (*models.TokenQuota, error) {
+	fake.getQuotaMutex.Lock()
+	ret, specificReturn := fake.getQuotaReturnsOnCall[len(fake.getQuotaArgsForCall)]
+	fake.getQuotaArgsForCall = append(fake.getQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+	}{arg1, arg2})
+	stub := fake.GetQuotaStub
+	fakeReturns := fake.getQuotaReturns
+	fake.recordInvocation("GetQuota", []interface{}{arg1, arg2})
+	fake.getQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetQuotaCallCount() int {
+	fake.getQuotaMutex.RLock()
+	defer fake.getQuotaMutex.RUnlock()
+	return len(fake.getQuotaArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetQuotaCalls(stub func(context.Context, *models.User) (*models.TokenQuota, error)) {
+	fake.getQuotaMutex.Lock()
+	defer fake.getQuotaMutex.Unlock()
+	fake.GetQuotaStub = stub
+}
+
+func (fake *FakeBizFunctions) GetQuotaArgsForCall(i int) (context.Context, *models.User) {
+	fake.getQuotaMutex.RLock()
+	defer fake.getQuotaMutex.RUnlock()
+	argsForCall := fake.getQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetQuotaReturns(result1 *models.TokenQuota, result2 error) {
+	fake.getQuotaMutex.Lock()
+	defer fake.getQuotaMutex.Unlock()
+	fake.GetQuotaStub = nil
+	fake.getQuotaReturns = struct {
+		result1 *models.TokenQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetQuotaReturnsOnCall(i int, result1 *models.TokenQuota, result2 error) {
+	fake.getQuotaMutex.Lock()
+	defer fake.getQuotaMutex.Unlock()
+	fake.GetQuotaStub = nil
+	if fake.getQuotaReturnsOnCall == nil {
+		fake.getQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.TokenQuota
+			result2 error
+		})
+	}
+	fake.getQuotaReturnsOnCall[i] = struct {
+		result1 *models.TokenQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Redeem(arg1 context.Context, arg2 string, arg3 *models.RequestMeta) error {
+	fake.redeemMutex.Lock()
+	ret, specificReturn := fake.redeemReturnsOnCall[len(fake.redeemArgsForCall)]
+	fake.redeemArgsForCall = append(fake.redeemArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *models.RequestMeta
+	}{arg1, arg2, arg3})
+	stub := fake.RedeemStub
+	fakeReturns := fake.redeemReturns
+	fake.recordInvocation("Redeem", []interface{}{arg1, arg2, arg3})
+	fake.redeemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBizFunctions) RedeemCallCount() int {
+	fake.redeemMutex.RLock()
+	defer fake.redeemMutex.RUnlock()
+	return len(fake.redeemArgsForCall)
+}
+
+func (fake *FakeBizFunctions) RedeemCalls(stub func(context.Context, string, *models.RequestMeta) error) {
+	fake.redeemMutex.Lock()
+	defer fake.redeemMutex.Unlock()
+	fake.RedeemStub = stub
+}
+
+func (fake *FakeBizFunctions) RedeemArgsForCall(i int) (context.Context, string, *models.RequestMeta) {
+	fake.redeemMutex.RLock()
+	defer fake.redeemMutex.RUnlock()
+	argsForCall := fake.redeemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) RedeemReturns(result1 error) {
+	fake.redeemMutex.Lock()
+	defer fake.redeemMutex.Unlock()
+	fake.RedeemStub = nil
+	fake.redeemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) RedeemReturnsOnCall(i int, result1 error) {
+	fake.redeemMutex.Lock()
+	defer fake.redeemMutex.Unlock()
+	fake.RedeemStub = nil
+	if fake.redeemReturnsOnCall == nil {
+		fake.redeemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.redeemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBizFunctions) Revoke(arg1 context.Context, arg2 string) error {
 	fake.revokeMutex.Lock()
 	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
@@ -255,24 +778,220 @@ func (fake *FakeBizFunctions) RevokeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeBizFunctions) Validate(arg1 context.Context, arg2 string) error {
+func (fake *FakeBizFunctions) RevokeById(arg1 context.Context, arg2 int) error {
+	fake.revokeByIdMutex.Lock()
+	ret, specificReturn := fake.revokeByIdReturnsOnCall[len(fake.revokeByIdArgsForCall)]
+	fake.revokeByIdArgsForCall = append(fake.revokeByIdArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RevokeByIdStub
+	fakeReturns := fake.revokeByIdReturns
+	fake.recordInvocation("RevokeById", []interface{}{arg1, arg2})
+	fake.revokeByIdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBizFunctions) RevokeByIdCallCount() int {
+	fake.revokeByIdMutex.RLock()
+	defer fake.revokeByIdMutex.RUnlock()
+	return len(fake.revokeByIdArgsForCall)
+}
+
+func (fake *FakeBizFunctions) RevokeByIdCalls(stub func(context.Context, int) error) {
+	fake.revokeByIdMutex.Lock()
+	defer fake.revokeByIdMutex.Unlock()
+	fake.RevokeByIdStub = stub
+}
+
+func (fake *FakeBizFunctions) RevokeByIdArgsForCall(i int) (context.Context, int) {
+	fake.revokeByIdMutex.RLock()
+	defer fake.revokeByIdMutex.RUnlock()
+	argsForCall := fake.revokeByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) RevokeByIdReturns(result1 error) {
+	fake.revokeByIdMutex.Lock()
+	defer fake.revokeByIdMutex.Unlock()
+	fake.RevokeByIdStub = nil
+	fake.revokeByIdReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) RevokeByIdReturnsOnCall(i int, result1 error) {
+	fake.revokeByIdMutex.Lock()
+	defer fake.revokeByIdMutex.Unlock()
+	fake.RevokeByIdStub = nil
+	if fake.revokeByIdReturnsOnCall == nil {
+		fake.revokeByIdReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeByIdReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) Update(arg1 context.Context, arg2 string, arg3 *models.UpdateToken) (*models.Token, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *models.UpdateToken
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeBizFunctions) UpdateCalls(stub func(context.Context, string, *models.UpdateToken) (*models.Token, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeBizFunctions) UpdateArgsForCall(i int) (context.Context, string, *models.UpdateToken) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) UpdateReturns(result1 *models.Token, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) UpdateReturnsOnCall(i int, result1 *models.Token, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *models.Token
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) UpdateById(arg1 context.Context, arg2 int, arg3 *models.UpdateToken) (*models.Token, error) {
+	fake.updateByIdMutex.Lock()
+	ret, specificReturn := fake.updateByIdReturnsOnCall[len(fake.updateByIdArgsForCall)]
+	fake.updateByIdArgsForCall = append(fake.updateByIdArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateToken
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateByIdStub
+	fakeReturns := fake.updateByIdReturns
+	fake.recordInvocation("UpdateById", []interface{}{arg1, arg2, arg3})
+	fake.updateByIdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) UpdateByIdCallCount() int {
+	fake.updateByIdMutex.RLock()
+	defer fake.updateByIdMutex.RUnlock()
+	return len(fake.updateByIdArgsForCall)
+}
+
+func (fake *FakeBizFunctions) UpdateByIdCalls(stub func(context.Context, int, *models.UpdateToken) (*models.Token, error)) {
+	fake.updateByIdMutex.Lock()
+	defer fake.updateByIdMutex.Unlock()
+	fake.UpdateByIdStub = stub
+}
+
+func (fake *FakeBizFunctions) UpdateByIdArgsForCall(i int) (context.Context, int, *models.UpdateToken) {
+	fake.updateByIdMutex.RLock()
+	defer fake.updateByIdMutex.RUnlock()
+	argsForCall := fake.updateByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) UpdateByIdReturns(result1 *models.Token, result2 error) {
+	fake.updateByIdMutex.Lock()
+	defer fake.updateByIdMutex.Unlock()
+	fake.UpdateByIdStub = nil
+	fake.updateByIdReturns = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) UpdateByIdReturnsOnCall(i int, result1 *models.Token, result2 error) {
+	fake.updateByIdMutex.Lock()
+	defer fake.updateByIdMutex.Unlock()
+	fake.UpdateByIdStub = nil
+	if fake.updateByIdReturnsOnCall == nil {
+		fake.updateByIdReturnsOnCall = make(map[int]struct {
+			result1 *models.Token
+			result2 error
+		})
+	}
+	fake.updateByIdReturnsOnCall[i] = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Validate(arg1 context.Context, arg2 string, arg3 string, arg4 *models.RequestMeta) (*models.TokenValidation, error) {
 	fake.validateMutex.Lock()
 	ret, specificReturn := fake.validateReturnsOnCall[len(fake.validateArgsForCall)]
 	fake.validateArgsForCall = append(fake.validateArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
+		arg3 string
+		arg4 *models.RequestMeta
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.ValidateStub
 	fakeReturns := fake.validateReturns
-	fake.recordInvocation("Validate", []interface{}{arg1, arg2})
+	fake.recordInvocation("Validate", []interface{}{arg1, arg2, arg3, arg4})
 	fake.validateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeBizFunctions) ValidateCallCount() int {
@@ -281,51 +1000,72 @@ func (fake *FakeBizFunctions) ValidateCallCount() int {
 	return len(fake.validateArgsForCall)
 }
 
-func (fake *FakeBizFunctions) ValidateCalls(stub func(context.Context, string) error) {
+func (fake *FakeBizFunctions) ValidateCalls(stub func(context.Context, string, string, *models.RequestMeta) (*models.TokenValidation, error)) {
 	fake.validateMutex.Lock()
 	defer fake.validateMutex.Unlock()
 	fake.ValidateStub = stub
 }
 
-func (fake *FakeBizFunctions) ValidateArgsForCall(i int) (context.Context, string) {
+func (fake *FakeBizFunctions) ValidateArgsForCall(i int) (context.Context, string, string, *models.RequestMeta) {
 	fake.validateMutex.RLock()
 	defer fake.validateMutex.RUnlock()
 	argsForCall := fake.validateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeBizFunctions) ValidateReturns(result1 error) {
+func (fake *FakeBizFunctions) ValidateReturns(result1 *models.TokenValidation, result2 error) {
 	fake.validateMutex.Lock()
 	defer fake.validateMutex.Unlock()
 	fake.ValidateStub = nil
 	fake.validateReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.TokenValidation
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeBizFunctions) ValidateReturnsOnCall(i int, result1 error) {
+func (fake *FakeBizFunctions) ValidateReturnsOnCall(i int, result1 *models.TokenValidation, result2 error) {
 	fake.validateMutex.Lock()
 	defer fake.validateMutex.Unlock()
 	fake.ValidateStub = nil
 	if fake.validateReturnsOnCall == nil {
 		fake.validateReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 *models.TokenValidation
+			result2 error
 		})
 	}
 	fake.validateReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 *models.TokenValidation
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeBizFunctions) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
 	fake.generateMutex.RLock()
 	defer fake.generateMutex.RUnlock()
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
 	fake.getAllMutex.RLock()
 	defer fake.getAllMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	fake.getEventsByIdMutex.RLock()
+	defer fake.getEventsByIdMutex.RUnlock()
+	fake.getQuotaMutex.RLock()
+	defer fake.getQuotaMutex.RUnlock()
+	fake.redeemMutex.RLock()
+	defer fake.redeemMutex.RUnlock()
 	fake.revokeMutex.RLock()
 	defer fake.revokeMutex.RUnlock()
+	fake.revokeByIdMutex.RLock()
+	defer fake.revokeByIdMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateByIdMutex.RLock()
+	defer fake.updateByIdMutex.RUnlock()
 	fake.validateMutex.RLock()
 	defer fake.validateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . bizFunctions
+type bizFunctions interface {
+	Create(ctx context.Context, user *models.User, params *models.CreateWebhook) (*models.CreatedWebhook, error)
+	GetAll(ctx context.Context) ([]models.Webhook, error)
+	Update(ctx context.Context, id int, params *models.UpdateWebhook) (*models.Webhook, error)
+	Delete(ctx context.Context, id int) error
+	GetDeliveries(ctx context.Context, id int) ([]models.WebhookDelivery, error)
+}
+
+var (
+	errInvalidWebhookId   = error_handling.BadRequest("invalid_webhook_id", "error, webhook id must be a positive integer")
+	errInvalidBody        = error_handling.BadRequest("invalid_body", "error, invalid request body")
+	errUserMetaConversion = errors.New("error, userMeta conversion fails")
+)
+
+type APIWebhook struct {
+	bizLayer bizFunctions
+}
+
+func NewAPIWebhook(bizLayer bizFunctions) *APIWebhook {
+	return &APIWebhook{bizLayer}
+}
+
+// webhookId parses the webhook id from the path
+func webhookId(ctx *fiber.Ctx) (int, error) {
+	id, err := ctx.ParamsInt("id")
+	if err != nil || id < 1 {
+		return 0, errInvalidWebhookId
+	}
+	return id, nil
+}
+
+// Create
+// @Id CreateWebhook
+// @Summary Create
+// @Description Subscribes a URL to token lifecycle events, or to every event when "events" is omitted.
+// @Description Each delivery is a JSON POST signed in the "X-Webhook-Signature" header as "v1=<hex HMAC-SHA256>"
+// @Description of "<X-Webhook-Timestamp>.<body>", keyed with the returned secret, which is only shown once.
+// @Description Deliveries not acknowledged with a 2xx are retried with an exponential backoff, and redirects aren't followed.
+// @Description The URL must point to a public host, rather than a loopback, link-local or private address.
+// @Tags Webhook
+// @Accept application/json
+// @Produce application/json
+// @Param Idempotency-Key header string false "key of your choosing, to replay the original response to retries"
+// @Param body body models.CreateWebhook true "url, and the events to subscribe to"
+// @Success 201 {object} models.CreatedWebhook
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/webhooks [post]
+func (w *APIWebhook) Create(ctx *fiber.Ctx) error {
+	userMeta, ok := ctx.Locals("userMeta").(*models.User)
+	if !ok {
+		return errUserMetaConversion
+	}
+
+	var params models.CreateWebhook
+	if err := ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	created, err := w.bizLayer.Create(ctx.Context(), userMeta, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusCreated).JSON(created)
+}
+
+// GetAll
+// @Id GetWebhooks
+// @Summary Fetch all
+// @Description Fetches every webhook, without their secrets
+// @Tags Webhook
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} []models.Webhook
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/webhooks [get]
+func (w *APIWebhook) GetAll(ctx *fiber.Ctx) error {
+	webhooks, err := w.bizLayer.GetAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(webhooks)
+}
+
+// Update
+// @Id UpdateWebhook
+// @Summary Update
+// @Description Changes a webhook's url or events, or pauses it with "active": false.
+// @Description Deliveries queued while a webhook is paused are sent once it is active again.
+// @Tags Webhook
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "webhook id"
+// @Param body body models.UpdateWebhook true "url, events and active changes"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/webhooks/{id} [patch]
+func (w *APIWebhook) Update(ctx *fiber.Ctx) error {
+	id, err := webhookId(ctx)
+	if err != nil {
+		return err
+	}
+
+	var params models.UpdateWebhook
+	if err = ctx.BodyParser(&params); err != nil {
+		return errInvalidBody.Detail(err.Error())
+	}
+
+	updated, err := w.bizLayer.Update(ctx.Context(), id, &params)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(updated)
+}
+
+// Delete
+// @Id DeleteWebhook
+// @Summary Delete
+// @Description Deletes a webhook, along with its deliveries
+// @Tags Webhook
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "webhook id"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/webhooks/{id} [delete]
+func (w *APIWebhook) Delete(ctx *fiber.Ctx) error {
+	id, err := webhookId(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = w.bizLayer.Delete(ctx.Context(), id); err != nil {
+		return err
+	}
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// GetDeliveries
+// @Id GetWebhookDeliveries
+// @Summary Deliveries
+// @Description Fetches a webhook's 100 most recent deliveries, newest first, with the outcome of their last attempt
+// @Tags Webhook
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "webhook id"
+// @Success 200 {object} []models.WebhookDelivery
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BasicAuth
+// @Router /v0/webhooks/{id}/deliveries [get]
+func (w *APIWebhook) GetDeliveries(ctx *fiber.Ctx) error {
+	id, err := webhookId(ctx)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := w.bizLayer.GetDeliveries(ctx.Context(), id)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(http.StatusOK).JSON(deliveries)
+}
@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/api/v0/webhook/webhookfakes"
+	BusinessWebhook "platform_engineer_clone/business/v0/webhook"
+	"platform_engineer_clone/models"
+	"strings"
+	"testing"
+)
+
+func newTestApp(apiWebhook *APIWebhook) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: helpers.ErrorHandler})
+	withUser := func(ctx *fiber.Ctx) error {
+		ctx.Locals("userMeta", &models.User{Id: 1})
+		return ctx.Next()
+	}
+	app.Post("/webhooks", withUser, apiWebhook.Create)
+	app.Get("/webhooks", apiWebhook.GetAll)
+	app.Patch("/webhooks/:id", apiWebhook.Update)
+	app.Delete("/webhooks/:id", apiWebhook.Delete)
+	app.Get("/webhooks/:id/deliveries", apiWebhook.GetDeliveries)
+	return app
+}
+
+func TestCreate_StatusCreated(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+	fakeBizFunctions.CreateReturns(&models.CreatedWebhook{
+		Webhook: models.Webhook{Id: 4, Url: "https://hooks.acme.com"},
+		Secret:  "whsec_test",
+	}, nil)
+
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(`{"url":"https://hooks.acme.com","events":["token.created"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Create - StatusCreated", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created models.CreatedWebhook
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		assert.Equal(t, "whsec_test", created.Secret)
+
+		_, user, params := fakeBizFunctions.CreateArgsForCall(0)
+		assert.Equal(t, 1, user.Id)
+		assert.Equal(t, []string{models.TokenLifecycleCreated}, params.Events)
+	})
+}
+
+func TestCreate_BadRequest_InvalidParams(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+	fakeBizFunctions.CreateReturns(nil, BusinessWebhook.ErrInvalidWebhookParams.Detail("url must be an absolute http or https URL"))
+
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(`{"url":"hooks"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Create - BadRequest Invalid Params", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var errResp models.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		assert.Equal(t, "invalid_webhook_params", errResp.Code)
+	})
+}
+
+func TestUpdate_BadRequest_InvalidId(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+
+	req := httptest.NewRequest("PATCH", "/webhooks/abc", strings.NewReader(`{"active":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Update - BadRequest Invalid Id", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 0, fakeBizFunctions.UpdateCallCount())
+	})
+}
+
+func TestUpdate_StatusOK(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+	fakeBizFunctions.UpdateReturns(&models.Webhook{Id: 4}, nil)
+
+	req := httptest.NewRequest("PATCH", "/webhooks/4", strings.NewReader(`{"active":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(req, 1)
+	t.Run("Test Update - StatusOK", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		_, id, params := fakeBizFunctions.UpdateArgsForCall(0)
+		assert.Equal(t, 4, id)
+		require.NotNil(t, params.Active)
+		assert.False(t, *params.Active)
+	})
+}
+
+func TestDelete_NotFound(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+	fakeBizFunctions.DeleteReturns(models.ErrNotFound)
+
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(httptest.NewRequest("DELETE", "/webhooks/9", nil), 1)
+	t.Run("Test Delete - NotFound", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestDelete_NoContent(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(httptest.NewRequest("DELETE", "/webhooks/4", nil), 1)
+	t.Run("Test Delete - NoContent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
+
+func TestGetDeliveries_StatusOK(t *testing.T) {
+	fakeBizFunctions := &webhookfakes.FakeBizFunctions{}
+	fakeBizFunctions.GetDeliveriesReturns([]models.WebhookDelivery{{Id: 7, Status: models.WebhookDeliveryFailed}}, nil)
+
+	resp, _ := newTestApp(NewAPIWebhook(fakeBizFunctions)).Test(httptest.NewRequest("GET", "/webhooks/4/deliveries", nil), 1)
+	t.Run("Test GetDeliveries - StatusOK", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var deliveries []models.WebhookDelivery
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&deliveries))
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.WebhookDeliveryFailed, deliveries[0].Status)
+	})
+}
@@ -0,0 +1,438 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package webhookfakes
+
+import (
+	"context"
+	"sync"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeBizFunctions struct {
+	CreateStub        func(context.Context, *models.User, *models.CreateWebhook) (*models.CreatedWebhook, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateWebhook
+	}
+	createReturns struct {
+		result1 *models.CreatedWebhook
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 *models.CreatedWebhook
+		result2 error
+	}
+	DeleteStub        func(context.Context, int) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAllStub        func(context.Context) ([]models.Webhook, error)
+	getAllMutex       sync.RWMutex
+	getAllArgsForCall []struct {
+		arg1 context.Context
+	}
+	getAllReturns struct {
+		result1 []models.Webhook
+		result2 error
+	}
+	getAllReturnsOnCall map[int]struct {
+		result1 []models.Webhook
+		result2 error
+	}
+	GetDeliveriesStub        func(context.Context, int) ([]models.WebhookDelivery, error)
+	getDeliveriesMutex       sync.RWMutex
+	getDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getDeliveriesReturns struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}
+	getDeliveriesReturnsOnCall map[int]struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}
+	UpdateStub        func(context.Context, int, *models.UpdateWebhook) (*models.Webhook, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateWebhook
+	}
+	updateReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBizFunctions) Create(arg1 context.Context, arg2 *models.User, arg3 *models.CreateWebhook) (*models.CreatedWebhook, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.CreateWebhook
+	}{arg1, arg2, arg3})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeBizFunctions) CreateCalls(stub func(context.Context, *models.User, *models.CreateWebhook) (*models.CreatedWebhook, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeBizFunctions) CreateArgsForCall(i int) (context.Context, *models.User, *models.CreateWebhook) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) CreateReturns(result1 *models.CreatedWebhook, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 *models.CreatedWebhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) CreateReturnsOnCall(i int, result1 *models.CreatedWebhook, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 *models.CreatedWebhook
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 *models.CreatedWebhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Delete(arg1 context.Context, arg2 int) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBizFunctions) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeBizFunctions) DeleteCalls(stub func(context.Context, int) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeBizFunctions) DeleteArgsForCall(i int) (context.Context, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBizFunctions) GetAll(arg1 context.Context) ([]models.Webhook, error) {
+	fake.getAllMutex.Lock()
+	ret, specificReturn := fake.getAllReturnsOnCall[len(fake.getAllArgsForCall)]
+	fake.getAllArgsForCall = append(fake.getAllArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAllStub
+	fakeReturns := fake.getAllReturns
+	fake.recordInvocation("GetAll", []interface{}{arg1})
+	fake.getAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetAllCallCount() int {
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	return len(fake.getAllArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetAllCalls(stub func(context.Context) ([]models.Webhook, error)) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = stub
+}
+
+func (fake *FakeBizFunctions) GetAllArgsForCall(i int) context.Context {
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	argsForCall := fake.getAllArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBizFunctions) GetAllReturns(result1 []models.Webhook, result2 error) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = nil
+	fake.getAllReturns = struct {
+		result1 []models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetAllReturnsOnCall(i int, result1 []models.Webhook, result2 error) {
+	fake.getAllMutex.Lock()
+	defer fake.getAllMutex.Unlock()
+	fake.GetAllStub = nil
+	if fake.getAllReturnsOnCall == nil {
+		fake.getAllReturnsOnCall = make(map[int]struct {
+			result1 []models.Webhook
+			result2 error
+		})
+	}
+	fake.getAllReturnsOnCall[i] = struct {
+		result1 []models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetDeliveries(arg1 context.Context, arg2 int) ([]models.WebhookDelivery, error) {
+	fake.getDeliveriesMutex.Lock()
+	ret, specificReturn := fake.getDeliveriesReturnsOnCall[len(fake.getDeliveriesArgsForCall)]
+	fake.getDeliveriesArgsForCall = append(fake.getDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetDeliveriesStub
+	fakeReturns := fake.getDeliveriesReturns
+	fake.recordInvocation("GetDeliveries", []interface{}{arg1, arg2})
+	fake.getDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) GetDeliveriesCallCount() int {
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	return len(fake.getDeliveriesArgsForCall)
+}
+
+func (fake *FakeBizFunctions) GetDeliveriesCalls(stub func(context.Context, int) ([]models.WebhookDelivery, error)) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = stub
+}
+
+func (fake *FakeBizFunctions) GetDeliveriesArgsForCall(i int) (context.Context, int) {
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	argsForCall := fake.getDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBizFunctions) GetDeliveriesReturns(result1 []models.WebhookDelivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	fake.getDeliveriesReturns = struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) GetDeliveriesReturnsOnCall(i int, result1 []models.WebhookDelivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	if fake.getDeliveriesReturnsOnCall == nil {
+		fake.getDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []models.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.getDeliveriesReturnsOnCall[i] = struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Update(arg1 context.Context, arg2 int, arg3 *models.UpdateWebhook) (*models.Webhook, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.UpdateWebhook
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBizFunctions) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeBizFunctions) UpdateCalls(stub func(context.Context, int, *models.UpdateWebhook) (*models.Webhook, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeBizFunctions) UpdateArgsForCall(i int) (context.Context, int, *models.UpdateWebhook) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBizFunctions) UpdateReturns(result1 *models.Webhook, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) UpdateReturnsOnCall(i int, result1 *models.Webhook, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *models.Webhook
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBizFunctions) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getAllMutex.RLock()
+	defer fake.getAllMutex.RUnlock()
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBizFunctions) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
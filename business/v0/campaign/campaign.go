@@ -0,0 +1,248 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"platform_engineer_clone/src/utils/validation"
+	"strings"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . dataPersistence
+type dataPersistence interface {
+	CreateCampaign(ctx context.Context, newCampaign *models.NewCampaign) (*models.Campaign, error)
+	GetCampaigns(ctx context.Context) ([]models.Campaign, error)
+	GetCampaign(ctx context.Context, id int) (*models.Campaign, error)
+	UpdateCampaign(ctx context.Context, id int, changes *models.CampaignChanges) error
+	DeleteCampaign(ctx context.Context, id int) error
+	GetCampaignStats(ctx context.Context, id int, now time.Time) (*models.CampaignStats, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . tokenFunctions
+type tokenFunctions interface {
+	GenerateInCampaign(ctx context.Context, user *models.User, campaign *models.Campaign, params *models.CreateTokenBatch) ([]string, error)
+	RevokeCampaign(ctx context.Context, id int) (int, error)
+}
+
+// BusinessCampaign manages campaigns, named groups of tokens minted with shared defaults,
+// which are revoked together, and counted together in the campaign's stats.
+type BusinessCampaign struct {
+	dataLayer   dataPersistence
+	tokens      tokenFunctions
+	tokenMinTTL time.Duration
+	tokenMaxTTL time.Duration
+}
+
+// These errors are caused by the request, and carry the status and code the API renders them with
+var (
+	ErrInvalidCampaignParams = error_handling.BadRequest("invalid_campaign_params", "error, invalid campaign params")
+	ErrInvalidDefaultTTL     = error_handling.BadRequest("invalid_default_ttl", "error, default_ttl must be a valid duration e.g. 72h")
+	ErrDefaultTTLOutOfBounds = error_handling.BadRequest("default_ttl_out_of_bounds", "error, default_ttl is outside the allowed ttl")
+	ErrInvalidDefaultMaxUses = error_handling.BadRequest("invalid_default_max_uses", "error, default_max_uses must be at least 1")
+	ErrEndsBeforeStarts      = error_handling.BadRequest("ends_before_starts", "error, ends_at must be after starts_at")
+	ErrNoCampaignChanges     = error_handling.BadRequest("no_campaign_changes", "error, at least one campaign field must be provided")
+	ErrCampaignEnded         = error_handling.Gone("campaign_ended", "error, campaign has ended")
+)
+
+var (
+	errCreateCampaign         = errors.New("error, creating campaign fails")
+	errDeleteCampaign         = errors.New("error, deleting campaign fails")
+	errGetCampaign            = errors.New("error, get campaign fails")
+	errGetCampaigns           = errors.New("error, get all campaigns fails")
+	errGetCampaignStats       = errors.New("error, get campaign stats fails")
+	errUpdateCampaign         = errors.New("error, updating campaign fails")
+	errValidateCampaignParams = errors.New("error, validating campaign params fails")
+)
+
+// Create creates the campaign, which tokens can then be minted into
+func (b *BusinessCampaign) Create(ctx context.Context, user *models.User, params *models.CreateCampaign) (*models.Campaign, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	newCampaign := models.NewCampaign{
+		Name:           params.Name,
+		Description:    params.Description,
+		DefaultMaxUses: params.DefaultMaxUses,
+		StartsAt:       params.StartsAt,
+		EndsAt:         params.EndsAt,
+		CreatedBy:      user.Id,
+	}
+	var err error
+	if params.DefaultTTL != "" {
+		if newCampaign.DefaultTTL, err = b.defaultTTL(params.DefaultTTL); err != nil {
+			return nil, err
+		}
+	}
+	if err = checkDefaults(params.DefaultMaxUses, params.StartsAt, params.EndsAt); err != nil {
+		return nil, err
+	}
+
+	campaign, err := b.dataLayer.CreateCampaign(ctx, &newCampaign)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCampaign.Error())
+	}
+	return campaign, nil
+}
+
+// GetAll returns every campaign, oldest first
+func (b *BusinessCampaign) GetAll(ctx context.Context) ([]models.Campaign, error) {
+	campaigns, err := b.dataLayer.GetCampaigns(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaigns.Error())
+	}
+	return campaigns, nil
+}
+
+func (b *BusinessCampaign) Get(ctx context.Context, id int) (*models.Campaign, error) {
+	campaign, err := b.dataLayer.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaign.Error())
+	}
+	return campaign, nil
+}
+
+// Update changes the campaign, and returns the updated campaign.
+// The new defaults and dates only apply to tokens minted afterwards.
+func (b *BusinessCampaign) Update(ctx context.Context, id int, params *models.UpdateCampaign) (*models.Campaign, error) {
+	if params == nil || (params.Name == nil && params.Description == nil && params.DefaultTTL == nil &&
+		params.DefaultMaxUses == nil && params.StartsAt == nil && params.EndsAt == nil) {
+		return nil, ErrNoCampaignChanges
+	}
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	changes := models.CampaignChanges{
+		Name:           params.Name,
+		Description:    params.Description,
+		DefaultMaxUses: params.DefaultMaxUses,
+		StartsAt:       params.StartsAt,
+		EndsAt:         params.EndsAt,
+	}
+	var err error
+	if params.DefaultTTL != nil {
+		if changes.DefaultTTL, err = b.defaultTTL(*params.DefaultTTL); err != nil {
+			return nil, err
+		}
+	}
+
+	campaign, err := b.dataLayer.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaign.Error())
+	}
+	startsAt, endsAt := campaign.StartsAt.Ptr(), campaign.EndsAt.Ptr()
+	if params.StartsAt != nil {
+		startsAt = params.StartsAt
+	}
+	if params.EndsAt != nil {
+		endsAt = params.EndsAt
+	}
+	if err = checkDefaults(params.DefaultMaxUses, startsAt, endsAt); err != nil {
+		return nil, err
+	}
+
+	err = b.dataLayer.UpdateCampaign(ctx, id, &changes)
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateCampaign.Error())
+	}
+
+	campaign, err = b.dataLayer.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaign.Error())
+	}
+	return campaign, nil
+}
+
+// Delete removes the campaign. Its tokens are kept as they are, and no longer belong to a campaign.
+func (b *BusinessCampaign) Delete(ctx context.Context, id int) error {
+	err := b.dataLayer.DeleteCampaign(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errDeleteCampaign.Error())
+	}
+	return nil
+}
+
+// GenerateTokens mints tokens into the campaign, with its defaults, unless it was revoked or has ended
+func (b *BusinessCampaign) GenerateTokens(ctx context.Context, user *models.User, id int,
+	params *models.CreateTokenBatch) ([]string, error) {
+	campaign, err := b.dataLayer.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaign.Error())
+	}
+	if campaign.RevokedAt.Valid {
+		return nil, models.ErrCampaignRevoked
+	}
+	if campaign.EndsAt.Valid && !campaign.EndsAt.Time.After(time.Now()) {
+		return nil, ErrCampaignEnded
+	}
+	return b.tokens.GenerateInCampaign(ctx, user, campaign, params)
+}
+
+// Revoke revokes the campaign, so no more tokens can be minted into it, along with every token in it
+func (b *BusinessCampaign) Revoke(ctx context.Context, id int) (*models.CampaignRevocation, error) {
+	revoked, err := b.tokens.RevokeCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CampaignRevocation{Revoked: revoked}, nil
+}
+
+// GetStats counts the campaign's tokens issued, active, validated, expired and revoked
+func (b *BusinessCampaign) GetStats(ctx context.Context, id int) (*models.CampaignStats, error) {
+	if _, err := b.dataLayer.GetCampaign(ctx, id); err != nil {
+		return nil, errors.Wrap(err, errGetCampaign.Error())
+	}
+	stats, err := b.dataLayer.GetCampaignStats(ctx, id, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCampaignStats.Error())
+	}
+	return stats, nil
+}
+
+// defaultTTL parses the campaign's default ttl, which must be within the configured min and max ttl of tokens
+func (b *BusinessCampaign) defaultTTL(value string) (*time.Duration, error) {
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, ErrInvalidDefaultTTL
+	}
+	if ttl < b.tokenMinTTL || ttl > b.tokenMaxTTL {
+		return nil, ErrDefaultTTLOutOfBounds.Detail(
+			fmt.Sprintf("default_ttl must be between %v and %v", b.tokenMinTTL, b.tokenMaxTTL))
+	}
+	return &ttl, nil
+}
+
+func checkDefaults(defaultMaxUses *int, startsAt *time.Time, endsAt *time.Time) error {
+	if defaultMaxUses != nil && *defaultMaxUses < 1 {
+		return ErrInvalidDefaultMaxUses
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return ErrEndsBeforeStarts
+	}
+	return nil
+}
+
+func validateParams(params interface{}) error {
+	errs, err := validation.ValidateStructParams(params)
+	if err != nil {
+		return errors.Wrap(err, errValidateCampaignParams.Error())
+	}
+	if len(errs) > 0 {
+		return ErrInvalidCampaignParams.Detail(strings.Join(errs, ","))
+	}
+	return nil
+}
+
+// NewBusinessCampaign returns a new *BusinessCampaign instance, minting tokens through tokens.
+// Default ttls must be within the same min and max ttl as the tokens themselves.
+func NewBusinessCampaign(dataLayer dataPersistence, tokens tokenFunctions, tokenMinTTL time.Duration,
+	tokenMaxTTL time.Duration) *BusinessCampaign {
+	return &BusinessCampaign{
+		dataLayer:   dataLayer,
+		tokens:      tokens,
+		tokenMinTTL: tokenMinTTL,
+		tokenMaxTTL: tokenMaxTTL,
+	}
+}
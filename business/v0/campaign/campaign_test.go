@@ -0,0 +1,202 @@
+package campaign
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"platform_engineer_clone/business/v0/campaign/campaignfakes"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+func newTestCampaign(fake *campaignfakes.FakeDataPersistence, tokens *campaignfakes.FakeTokenFunctions) *BusinessCampaign {
+	return NewBusinessCampaign(fake, tokens, time.Hour, 30*24*time.Hour)
+}
+
+func TestBusinessCampaign_Create_HappyPath(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	fakeDataPersistence.CreateCampaignReturns(&models.Campaign{Id: 4, Name: "Spring launch"}, nil)
+
+	maxUses := 1
+	startsAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	campaign, err := newTestCampaign(&fakeDataPersistence, nil).Create(context.Background(), &models.User{Id: 1},
+		&models.CreateCampaign{
+			Name:           "Spring launch",
+			DefaultTTL:     "72h",
+			DefaultMaxUses: &maxUses,
+			StartsAt:       &startsAt,
+			EndsAt:         &endsAt,
+		})
+	t.Run("Test Create - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, campaign.Id)
+
+		_, newCampaign := fakeDataPersistence.CreateCampaignArgsForCall(0)
+		require.NotNil(t, newCampaign.DefaultTTL)
+		assert.Equal(t, 72*time.Hour, *newCampaign.DefaultTTL)
+		assert.Equal(t, &maxUses, newCampaign.DefaultMaxUses)
+		assert.Equal(t, 1, newCampaign.CreatedBy)
+	})
+}
+
+func TestBusinessCampaign_Create_InvalidParams(t *testing.T) {
+	zero := 0
+	startsAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		params  models.CreateCampaign
+		wantErr error
+	}{
+		{name: "missing name", params: models.CreateCampaign{}, wantErr: ErrInvalidCampaignParams},
+		{name: "invalid ttl", params: models.CreateCampaign{Name: "a", DefaultTTL: "3 days"}, wantErr: ErrInvalidDefaultTTL},
+		{name: "ttl out of bounds", params: models.CreateCampaign{Name: "a", DefaultTTL: "1m"}, wantErr: ErrDefaultTTLOutOfBounds},
+		{name: "zero max uses", params: models.CreateCampaign{Name: "a", DefaultMaxUses: &zero}, wantErr: ErrInvalidDefaultMaxUses},
+		{name: "ends before starts", params: models.CreateCampaign{Name: "a", StartsAt: &startsAt, EndsAt: &startsAt},
+			wantErr: ErrEndsBeforeStarts},
+	}
+	for _, tt := range tests {
+		fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+		_, err := newTestCampaign(&fakeDataPersistence, nil).Create(context.Background(), &models.User{Id: 1}, &tt.params)
+		t.Run("Test Create - Invalid Params - "+tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, fakeDataPersistence.CreateCampaignCallCount())
+		})
+	}
+}
+
+func TestBusinessCampaign_Update_HappyPath(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetCampaignReturnsOnCall(0, &models.Campaign{Id: 4}, nil)
+	fakeDataPersistence.GetCampaignReturnsOnCall(1, &models.Campaign{Id: 4, DefaultTTL: null.StringFrom("48h0m0s")}, nil)
+
+	ttl := "48h"
+	campaign, err := newTestCampaign(&fakeDataPersistence, nil).Update(context.Background(), 4,
+		&models.UpdateCampaign{DefaultTTL: &ttl})
+	t.Run("Test Update - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom("48h0m0s"), campaign.DefaultTTL)
+
+		_, id, changes := fakeDataPersistence.UpdateCampaignArgsForCall(0)
+		assert.Equal(t, 4, id)
+		require.NotNil(t, changes.DefaultTTL)
+		assert.Equal(t, 48*time.Hour, *changes.DefaultTTL)
+	})
+}
+
+func TestBusinessCampaign_Update_EndsBeforeStoredStart(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	startsAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	fakeDataPersistence.GetCampaignReturns(&models.Campaign{Id: 4, StartsAt: null.TimeFrom(startsAt)}, nil)
+
+	endsAt := startsAt.Add(-time.Hour)
+	_, err := newTestCampaign(&fakeDataPersistence, nil).Update(context.Background(), 4,
+		&models.UpdateCampaign{EndsAt: &endsAt})
+	t.Run("Test Update - Ends Before Stored Start", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrEndsBeforeStarts)
+		assert.Equal(t, 0, fakeDataPersistence.UpdateCampaignCallCount())
+	})
+}
+
+func TestBusinessCampaign_Update_NoChanges(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	_, err := newTestCampaign(&fakeDataPersistence, nil).Update(context.Background(), 4, &models.UpdateCampaign{})
+	t.Run("Test Update - No Changes", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrNoCampaignChanges)
+		assert.Equal(t, 0, fakeDataPersistence.GetCampaignCallCount())
+	})
+}
+
+func TestBusinessCampaign_GenerateTokens_HappyPath(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	campaign := models.Campaign{Id: 4, EndsAt: null.TimeFrom(time.Now().Add(time.Hour))}
+	fakeDataPersistence.GetCampaignReturns(&campaign, nil)
+	fakeTokens := campaignfakes.FakeTokenFunctions{}
+	fakeTokens.GenerateInCampaignReturns([]string{"1234", "5678"}, nil)
+
+	params := models.CreateTokenBatch{Count: 2}
+	keys, err := newTestCampaign(&fakeDataPersistence, &fakeTokens).GenerateTokens(context.Background(),
+		&models.User{Id: 1}, 4, &params)
+	t.Run("Test GenerateTokens - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1234", "5678"}, keys)
+
+		_, user, gotCampaign, gotParams := fakeTokens.GenerateInCampaignArgsForCall(0)
+		assert.Equal(t, 1, user.Id)
+		assert.Equal(t, &campaign, gotCampaign)
+		assert.Equal(t, &params, gotParams)
+	})
+}
+
+func TestBusinessCampaign_GenerateTokens_Closed(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign models.Campaign
+		wantErr  error
+	}{
+		{name: "revoked", campaign: models.Campaign{Id: 4, RevokedAt: null.TimeFrom(time.Now())}, wantErr: models.ErrCampaignRevoked},
+		{name: "ended", campaign: models.Campaign{Id: 4, EndsAt: null.TimeFrom(time.Now().Add(-time.Hour))}, wantErr: ErrCampaignEnded},
+	}
+	for _, tt := range tests {
+		fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+		fakeDataPersistence.GetCampaignReturns(&tt.campaign, nil)
+		fakeTokens := campaignfakes.FakeTokenFunctions{}
+		_, err := newTestCampaign(&fakeDataPersistence, &fakeTokens).GenerateTokens(context.Background(),
+			&models.User{Id: 1}, 4, &models.CreateTokenBatch{})
+		t.Run("Test GenerateTokens - Closed - "+tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, fakeTokens.GenerateInCampaignCallCount())
+		})
+	}
+}
+
+func TestBusinessCampaign_GenerateTokens_NotFound(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetCampaignReturns(nil, models.ErrNotFound)
+
+	_, err := newTestCampaign(&fakeDataPersistence, nil).GenerateTokens(context.Background(),
+		&models.User{Id: 1}, 9, &models.CreateTokenBatch{})
+	t.Run("Test GenerateTokens - Not Found", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestBusinessCampaign_Revoke_HappyPath(t *testing.T) {
+	fakeTokens := campaignfakes.FakeTokenFunctions{}
+	fakeTokens.RevokeCampaignReturns(150, nil)
+
+	revocation, err := newTestCampaign(nil, &fakeTokens).Revoke(context.Background(), 4)
+	t.Run("Test Revoke - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, &models.CampaignRevocation{Revoked: 150}, revocation)
+		_, id := fakeTokens.RevokeCampaignArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestBusinessCampaign_GetStats_HappyPath(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetCampaignReturns(&models.Campaign{Id: 4}, nil)
+	fakeDataPersistence.GetCampaignStatsReturns(&models.CampaignStats{Issued: 200, Validated: 80, Expired: 30}, nil)
+
+	stats, err := newTestCampaign(&fakeDataPersistence, nil).GetStats(context.Background(), 4)
+	t.Run("Test GetStats - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 200, stats.Issued)
+		_, id, _ := fakeDataPersistence.GetCampaignStatsArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestBusinessCampaign_GetStats_NotFound(t *testing.T) {
+	fakeDataPersistence := campaignfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetCampaignReturns(nil, models.ErrNotFound)
+
+	_, err := newTestCampaign(&fakeDataPersistence, nil).GetStats(context.Background(), 9)
+	t.Run("Test GetStats - Not Found", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.Equal(t, 0, fakeDataPersistence.GetCampaignStatsCallCount())
+	})
+}
@@ -0,0 +1,515 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package campaignfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeDataPersistence struct {
+	CreateCampaignStub        func(context.Context, *models.NewCampaign) (*models.Campaign, error)
+	createCampaignMutex       sync.RWMutex
+	createCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.NewCampaign
+	}
+	createCampaignReturns struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	createCampaignReturnsOnCall map[int]struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	DeleteCampaignStub        func(context.Context, int) error
+	deleteCampaignMutex       sync.RWMutex
+	deleteCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteCampaignReturns struct {
+		result1 error
+	}
+	deleteCampaignReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetCampaignStub        func(context.Context, int) (*models.Campaign, error)
+	getCampaignMutex       sync.RWMutex
+	getCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getCampaignReturns struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	getCampaignReturnsOnCall map[int]struct {
+		result1 *models.Campaign
+		result2 error
+	}
+	GetCampaignStatsStub        func(context.Context, int, time.Time) (*models.CampaignStats, error)
+	getCampaignStatsMutex       sync.RWMutex
+	getCampaignStatsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	getCampaignStatsReturns struct {
+		result1 *models.CampaignStats
+		result2 error
+	}
+	getCampaignStatsReturnsOnCall map[int]struct {
+		result1 *models.CampaignStats
+		result2 error
+	}
+	GetCampaignsStub        func(context.Context) ([]models.Campaign, error)
+	getCampaignsMutex       sync.RWMutex
+	getCampaignsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getCampaignsReturns struct {
+		result1 []models.Campaign
+		result2 error
+	}
+	getCampaignsReturnsOnCall map[int]struct {
+		result1 []models.Campaign
+		result2 error
+	}
+	UpdateCampaignStub        func(context.Context, int, *models.CampaignChanges) error
+	updateCampaignMutex       sync.RWMutex
+	updateCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.CampaignChanges
+	}
+	updateCampaignReturns struct {
+		result1 error
+	}
+	updateCampaignReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDataPersistence) CreateCampaign(arg1 context.Context, arg2 *models.NewCampaign) (*models.Campaign, error) {
+	fake.createCampaignMutex.Lock()
+	ret, specificReturn := fake.createCampaignReturnsOnCall[len(fake.createCampaignArgsForCall)]
+	fake.createCampaignArgsForCall = append(fake.createCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.NewCampaign
+	}{arg1, arg2})
+	stub := fake.CreateCampaignStub
+	fakeReturns := fake.createCampaignReturns
+	fake.recordInvocation("CreateCampaign", []interface{}{arg1, arg2})
+	fake.createCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) CreateCampaignCallCount() int {
+	fake.createCampaignMutex.RLock()
+	defer fake.createCampaignMutex.RUnlock()
+	return len(fake.createCampaignArgsForCall)
+}
+
+func (fake *FakeDataPersistence) CreateCampaignCalls(stub func(context.Context, *models.NewCampaign) (*models.Campaign, error)) {
+	fake.createCampaignMutex.Lock()
+	defer fake.createCampaignMutex.Unlock()
+	fake.CreateCampaignStub = stub
+}
+
+func (fake *FakeDataPersistence) CreateCampaignArgsForCall(i int) (context.Context, *models.NewCampaign) {
+	fake.createCampaignMutex.RLock()
+	defer fake.createCampaignMutex.RUnlock()
+	argsForCall := fake.createCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) CreateCampaignReturns(result1 *models.Campaign, result2 error) {
+	fake.createCampaignMutex.Lock()
+	defer fake.createCampaignMutex.Unlock()
+	fake.CreateCampaignStub = nil
+	fake.createCampaignReturns = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) CreateCampaignReturnsOnCall(i int, result1 *models.Campaign, result2 error) {
+	fake.createCampaignMutex.Lock()
+	defer fake.createCampaignMutex.Unlock()
+	fake.CreateCampaignStub = nil
+	if fake.createCampaignReturnsOnCall == nil {
+		fake.createCampaignReturnsOnCall = make(map[int]struct {
+			result1 *models.Campaign
+			result2 error
+		})
+	}
+	fake.createCampaignReturnsOnCall[i] = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) DeleteCampaign(arg1 context.Context, arg2 int) error {
+	fake.deleteCampaignMutex.Lock()
+	ret, specificReturn := fake.deleteCampaignReturnsOnCall[len(fake.deleteCampaignArgsForCall)]
+	fake.deleteCampaignArgsForCall = append(fake.deleteCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteCampaignStub
+	fakeReturns := fake.deleteCampaignReturns
+	fake.recordInvocation("DeleteCampaign", []interface{}{arg1, arg2})
+	fake.deleteCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) DeleteCampaignCallCount() int {
+	fake.deleteCampaignMutex.RLock()
+	defer fake.deleteCampaignMutex.RUnlock()
+	return len(fake.deleteCampaignArgsForCall)
+}
+
+func (fake *FakeDataPersistence) DeleteCampaignCalls(stub func(context.Context, int) error) {
+	fake.deleteCampaignMutex.Lock()
+	defer fake.deleteCampaignMutex.Unlock()
+	fake.DeleteCampaignStub = stub
+}
+
+func (fake *FakeDataPersistence) DeleteCampaignArgsForCall(i int) (context.Context, int) {
+	fake.deleteCampaignMutex.RLock()
+	defer fake.deleteCampaignMutex.RUnlock()
+	argsForCall := fake.deleteCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) DeleteCampaignReturns(result1 error) {
+	fake.deleteCampaignMutex.Lock()
+	defer fake.deleteCampaignMutex.Unlock()
+	fake.DeleteCampaignStub = nil
+	fake.deleteCampaignReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) DeleteCampaignReturnsOnCall(i int, result1 error) {
+	fake.deleteCampaignMutex.Lock()
+	defer fake.deleteCampaignMutex.Unlock()
+	fake.DeleteCampaignStub = nil
+	if fake.deleteCampaignReturnsOnCall == nil {
+		fake.deleteCampaignReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteCampaignReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) GetCampaign(arg1 context.Context, arg2 int) (*models.Campaign, error) {
+	fake.getCampaignMutex.Lock()
+	ret, specificReturn := fake.getCampaignReturnsOnCall[len(fake.getCampaignArgsForCall)]
+	fake.getCampaignArgsForCall = append(fake.getCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetCampaignStub
+	fakeReturns := fake.getCampaignReturns
+	fake.recordInvocation("GetCampaign", []interface{}{arg1, arg2})
+	fake.getCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetCampaignCallCount() int {
+	fake.getCampaignMutex.RLock()
+	defer fake.getCampaignMutex.RUnlock()
+	return len(fake.getCampaignArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetCampaignCalls(stub func(context.Context, int) (*models.Campaign, error)) {
+	fake.getCampaignMutex.Lock()
+	defer fake.getCampaignMutex.Unlock()
+	fake.GetCampaignStub = stub
+}
+
+func (fake *FakeDataPersistence) GetCampaignArgsForCall(i int) (context.Context, int) {
+	fake.getCampaignMutex.RLock()
+	defer fake.getCampaignMutex.RUnlock()
+	argsForCall := fake.getCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetCampaignReturns(result1 *models.Campaign, result2 error) {
+	fake.getCampaignMutex.Lock()
+	defer fake.getCampaignMutex.Unlock()
+	fake.GetCampaignStub = nil
+	fake.getCampaignReturns = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetCampaignReturnsOnCall(i int, result1 *models.Campaign, result2 error) {
+	fake.getCampaignMutex.Lock()
+	defer fake.getCampaignMutex.Unlock()
+	fake.GetCampaignStub = nil
+	if fake.getCampaignReturnsOnCall == nil {
+		fake.getCampaignReturnsOnCall = make(map[int]struct {
+			result1 *models.Campaign
+			result2 error
+		})
+	}
+	fake.getCampaignReturnsOnCall[i] = struct {
+		result1 *models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetCampaignStats(arg1 context.Context, arg2 int, arg3 time.Time) (*models.CampaignStats, error) {
+	fake.getCampaignStatsMutex.Lock()
+	ret, specificReturn := fake.getCampaignStatsReturnsOnCall[len(fake.getCampaignStatsArgsForCall)]
+	fake.getCampaignStatsArgsForCall = append(fake.getCampaignStatsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetCampaignStatsStub
+	fakeReturns := fake.getCampaignStatsReturns
+	fake.recordInvocation("GetCampaignStats", []interface{}{arg1, arg2, arg3})
+	fake.getCampaignStatsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetCampaignStatsCallCount() int {
+	fake.getCampaignStatsMutex.RLock()
+	defer fake.getCampaignStatsMutex.RUnlock()
+	return len(fake.getCampaignStatsArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetCampaignStatsCalls(stub func(context.Context, int, time.Time) (*models.CampaignStats, error)) {
+	fake.getCampaignStatsMutex.Lock()
+	defer fake.getCampaignStatsMutex.Unlock()
+	fake.GetCampaignStatsStub = stub
+}
+
+func (fake *FakeDataPersistence) GetCampaignStatsArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.getCampaignStatsMutex.RLock()
+	defer fake.getCampaignStatsMutex.RUnlock()
+	argsForCall := fake.getCampaignStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) GetCampaignStatsReturns(result1 *models.CampaignStats, result2 error) {
+	fake.getCampaignStatsMutex.Lock()
+	defer fake.getCampaignStatsMutex.Unlock()
+	fake.GetCampaignStatsStub = nil
+	fake.getCampaignStatsReturns = struct {
+		result1 *models.CampaignStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetCampaignStatsReturnsOnCall(i int, result1 *models.CampaignStats, result2 error) {
+	fake.getCampaignStatsMutex.Lock()
+	defer fake.getCampaignStatsMutex.Unlock()
+	fake.GetCampaignStatsStub = nil
+	if fake.getCampaignStatsReturnsOnCall == nil {
+		fake.getCampaignStatsReturnsOnCall = make(map[int]struct {
+			result1 *models.CampaignStats
+			result2 error
+		})
+	}
+	fake.getCampaignStatsReturnsOnCall[i] = struct {
+		result1 *models.CampaignStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetCampaigns(arg1 context.Context) ([]models.Campaign, error) {
+	fake.getCampaignsMutex.Lock()
+	ret, specificReturn := fake.getCampaignsReturnsOnCall[len(fake.getCampaignsArgsForCall)]
+	fake.getCampaignsArgsForCall = append(fake.getCampaignsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetCampaignsStub
+	fakeReturns := fake.getCampaignsReturns
+	fake.recordInvocation("GetCampaigns", []interface{}{arg1})
+	fake.getCampaignsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetCampaignsCallCount() int {
+	fake.getCampaignsMutex.RLock()
+	defer fake.getCampaignsMutex.RUnlock()
+	return len(fake.getCampaignsArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetCampaignsCalls(stub func(context.Context) ([]models.Campaign, error)) {
+	fake.getCampaignsMutex.Lock()
+	defer fake.getCampaignsMutex.Unlock()
+	fake.GetCampaignsStub = stub
+}
+
+func (fake *FakeDataPersistence) GetCampaignsArgsForCall(i int) context.Context {
+	fake.getCampaignsMutex.RLock()
+	defer fake.getCampaignsMutex.RUnlock()
+	argsForCall := fake.getCampaignsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDataPersistence) GetCampaignsReturns(result1 []models.Campaign, result2 error) {
+	fake.getCampaignsMutex.Lock()
+	defer fake.getCampaignsMutex.Unlock()
+	fake.GetCampaignsStub = nil
+	fake.getCampaignsReturns = struct {
+		result1 []models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetCampaignsReturnsOnCall(i int, result1 []models.Campaign, result2 error) {
+	fake.getCampaignsMutex.Lock()
+	defer fake.getCampaignsMutex.Unlock()
+	fake.GetCampaignsStub = nil
+	if fake.getCampaignsReturnsOnCall == nil {
+		fake.getCampaignsReturnsOnCall = make(map[int]struct {
+			result1 []models.Campaign
+			result2 error
+		})
+	}
+	fake.getCampaignsReturnsOnCall[i] = struct {
+		result1 []models.Campaign
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) UpdateCampaign(arg1 context.Context, arg2 int, arg3 *models.CampaignChanges) error {
+	fake.updateCampaignMutex.Lock()
+	ret, specificReturn := fake.updateCampaignReturnsOnCall[len(fake.updateCampaignArgsForCall)]
+	fake.updateCampaignArgsForCall = append(fake.updateCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.CampaignChanges
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCampaignStub
+	fakeReturns := fake.updateCampaignReturns
+	fake.recordInvocation("UpdateCampaign", []interface{}{arg1, arg2, arg3})
+	fake.updateCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) UpdateCampaignCallCount() int {
+	fake.updateCampaignMutex.RLock()
+	defer fake.updateCampaignMutex.RUnlock()
+	return len(fake.updateCampaignArgsForCall)
+}
+
+func (fake *FakeDataPersistence) UpdateCampaignCalls(stub func(context.Context, int, *models.CampaignChanges) error) {
+	fake.updateCampaignMutex.Lock()
+	defer fake.updateCampaignMutex.Unlock()
+	fake.UpdateCampaignStub = stub
+}
+
+func (fake *FakeDataPersistence) UpdateCampaignArgsForCall(i int) (context.Context, int, *models.CampaignChanges) {
+	fake.updateCampaignMutex.RLock()
+	defer fake.updateCampaignMutex.RUnlock()
+	argsForCall := fake.updateCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) UpdateCampaignReturns(result1 error) {
+	fake.updateCampaignMutex.Lock()
+	defer fake.updateCampaignMutex.Unlock()
+	fake.UpdateCampaignStub = nil
+	fake.updateCampaignReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) UpdateCampaignReturnsOnCall(i int, result1 error) {
+	fake.updateCampaignMutex.Lock()
+	defer fake.updateCampaignMutex.Unlock()
+	fake.UpdateCampaignStub = nil
+	if fake.updateCampaignReturnsOnCall == nil {
+		fake.updateCampaignReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCampaignReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createCampaignMutex.RLock()
+	defer fake.createCampaignMutex.RUnlock()
+	fake.deleteCampaignMutex.RLock()
+	defer fake.deleteCampaignMutex.RUnlock()
+	fake.getCampaignMutex.RLock()
+	defer fake.getCampaignMutex.RUnlock()
+	fake.getCampaignStatsMutex.RLock()
+	defer fake.getCampaignStatsMutex.RUnlock()
+	fake.getCampaignsMutex.RLock()
+	defer fake.getCampaignsMutex.RUnlock()
+	fake.updateCampaignMutex.RLock()
+	defer fake.updateCampaignMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDataPersistence) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
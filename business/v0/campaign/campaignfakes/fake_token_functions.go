@@ -0,0 +1,202 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package campaignfakes
+
+import (
+	"context"
+	"sync"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeTokenFunctions struct {
+	GenerateInCampaignStub        func(context.Context, *models.User, *models.Campaign, *models.CreateTokenBatch) ([]string, error)
+	generateInCampaignMutex       sync.RWMutex
+	generateInCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.Campaign
+		arg4 *models.CreateTokenBatch
+	}
+	generateInCampaignReturns struct {
+		result1 []string
+		result2 error
+	}
+	generateInCampaignReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RevokeCampaignStub        func(context.Context, int) (int, error)
+	revokeCampaignMutex       sync.RWMutex
+	revokeCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	revokeCampaignReturns struct {
+		result1 int
+		result2 error
+	}
+	revokeCampaignReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaign(arg1 context.Context, arg2 *models.User, arg3 *models.Campaign, arg4 *models.CreateTokenBatch) ([]string, error) {
+	fake.generateInCampaignMutex.Lock()
+	ret, specificReturn := fake.generateInCampaignReturnsOnCall[len(fake.generateInCampaignArgsForCall)]
+	fake.generateInCampaignArgsForCall = append(fake.generateInCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.User
+		arg3 *models.Campaign
+		arg4 *models.CreateTokenBatch
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GenerateInCampaignStub
+	fakeReturns := fake.generateInCampaignReturns
+	fake.recordInvocation("GenerateInCampaign", []interface{}{arg1, arg2, arg3, arg4})
+	fake.generateInCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaignCallCount() int {
+	fake.generateInCampaignMutex.RLock()
+	defer fake.generateInCampaignMutex.RUnlock()
+	return len(fake.generateInCampaignArgsForCall)
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaignCalls(stub func(context.Context, *models.User, *models.Campaign, *models.CreateTokenBatch) ([]string, error)) {
+	fake.generateInCampaignMutex.Lock()
+	defer fake.generateInCampaignMutex.Unlock()
+	fake.GenerateInCampaignStub = stub
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaignArgsForCall(i int) (context.Context, *models.User, *models.Campaign, *models.CreateTokenBatch) {
+	fake.generateInCampaignMutex.RLock()
+	defer fake.generateInCampaignMutex.RUnlock()
+	argsForCall := fake.generateInCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaignReturns(result1 []string, result2 error) {
+	fake.generateInCampaignMutex.Lock()
+	defer fake.generateInCampaignMutex.Unlock()
+	fake.GenerateInCampaignStub = nil
+	fake.generateInCampaignReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTokenFunctions) GenerateInCampaignReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.generateInCampaignMutex.Lock()
+	defer fake.generateInCampaignMutex.Unlock()
+	fake.GenerateInCampaignStub = nil
+	if fake.generateInCampaignReturnsOnCall == nil {
+		fake.generateInCampaignReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.generateInCampaignReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaign(arg1 context.Context, arg2 int) (int, error) {
+	fake.revokeCampaignMutex.Lock()
+	ret, specificReturn := fake.revokeCampaignReturnsOnCall[len(fake.revokeCampaignArgsForCall)]
+	fake.revokeCampaignArgsForCall = append(fake.revokeCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RevokeCampaignStub
+	fakeReturns := fake.revokeCampaignReturns
+	fake.recordInvocation("RevokeCampaign", []interface{}{arg1, arg2})
+	fake.revokeCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaignCallCount() int {
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	return len(fake.revokeCampaignArgsForCall)
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaignCalls(stub func(context.Context, int) (int, error)) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = stub
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaignArgsForCall(i int) (context.Context, int) {
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	argsForCall := fake.revokeCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaignReturns(result1 int, result2 error) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = nil
+	fake.revokeCampaignReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTokenFunctions) RevokeCampaignReturnsOnCall(i int, result1 int, result2 error) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = nil
+	if fake.revokeCampaignReturnsOnCall == nil {
+		fake.revokeCampaignReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.revokeCampaignReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTokenFunctions) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.generateInCampaignMutex.RLock()
+	defer fake.generateInCampaignMutex.RUnlock()
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTokenFunctions) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
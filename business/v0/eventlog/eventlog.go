@@ -0,0 +1,250 @@
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"os"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"sync"
+	"time"
+)
+
+// The fsync modes. Lines are written to the file as they are published, so they survive the process
+// crashing either way, and the mode only decides how many survive the machine crashing.
+const (
+	// FsyncAlways syncs every line before it is acknowledged
+	FsyncAlways = "always"
+	// FsyncInterval leaves syncing to the Sync job, run periodically
+	FsyncInterval = "interval"
+	// FsyncNever leaves syncing to the OS, and to rotation and Close
+	FsyncNever = "never"
+)
+
+// rotatedTimeFormat suffixes rotated files, which sort in the order they were rotated
+const rotatedTimeFormat = "20060102T150405.000Z"
+
+var (
+	errEncodeEvent     = errors.New("error, encoding event log line fails")
+	errOpenEventLog    = errors.New("error, opening event log fails")
+	errWriteEventLog   = errors.New("error, writing event log fails")
+	errSyncEventLog    = errors.New("error, syncing event log fails")
+	errRotateEventLog  = errors.New("error, rotating event log fails")
+	errCompressRotated = errors.New("error, compressing rotated event log fails")
+	errEventLogClosed  = errors.New("error, event log is closed")
+)
+
+// EventLog appends every token lifecycle event it is published to a local file, one JSON line per event.
+// The file is rotated once it would grow past the max size, or once the max age period it was
+// written in has passed, periods being aligned on UTC, e.g. at midnight for a 24h max age.
+// Rotated files are renamed with the time they were rotated, and gzipped in the background.
+type EventLog struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxAge   time.Duration
+	compress bool
+	fsync    string
+
+	file     *os.File
+	size     int64
+	period   time.Time
+	unsynced bool
+	closed   bool
+
+	compressing sync.WaitGroup
+}
+
+// Name identifies the event log among the outbox sinks
+func (l *EventLog) Name() string {
+	return "event_log"
+}
+
+// Publish appends the event to the file, rotating it first when it's due
+func (l *EventLog) Publish(ctx context.Context, event *models.TokenLifecycleEvent) error {
+	if l.path == "" {
+		return nil
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, errEncodeEvent.Error())
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return errEventLogClosed
+	}
+
+	now := time.Now()
+	if err = l.rotateIfDue(now, int64(len(line))); err != nil {
+		return err
+	}
+	if l.file == nil {
+		if err = l.open(); err != nil {
+			return err
+		}
+	}
+
+	written, err := l.file.Write(line)
+	l.size += int64(written)
+	if err != nil {
+		return errors.Wrap(err, errWriteEventLog.Error())
+	}
+	if l.fsync == FsyncAlways {
+		return l.sync()
+	}
+	l.unsynced = true
+	return nil
+}
+
+// open opens the file for appending, creating it readable only by its owner if missing.
+// An existing file keeps the period it was last written in, so it's rotated if that period has passed.
+func (l *EventLog) open() error {
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, errOpenEventLog.Error())
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, errOpenEventLog.Error())
+	}
+	l.file = file
+	l.size = info.Size()
+	l.period = l.periodOf(info.ModTime())
+	if info.Size() == 0 {
+		l.period = l.periodOf(time.Now())
+	}
+	return nil
+}
+
+func (l *EventLog) periodOf(t time.Time) time.Time {
+	if l.maxAge <= 0 {
+		return time.Time{}
+	}
+	return t.UTC().Truncate(l.maxAge)
+}
+
+// rotateIfDue rotates the file when the next line would take it past the max size, or its period has passed.
+// The file is opened first if needed, to find out its size and period.
+func (l *EventLog) rotateIfDue(now time.Time, next int64) error {
+	if l.file == nil {
+		if _, err := os.Stat(l.path); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+	tooBig := l.maxSize > 0 && l.size > 0 && l.size+next > l.maxSize
+	tooOld := l.maxAge > 0 && l.periodOf(now).After(l.period)
+	if !tooBig && !tooOld {
+		return nil
+	}
+	return l.rotate(now)
+}
+
+// rotate closes the file and renames it, so the next line starts a new file
+func (l *EventLog) rotate(now time.Time) error {
+	if err := l.closeFile(); err != nil {
+		return errors.Wrap(err, errRotateEventLog.Error())
+	}
+	rotatedPath := l.rotatedPath(now)
+	if err := os.Rename(l.path, rotatedPath); err != nil {
+		return errors.Wrap(err, errRotateEventLog.Error())
+	}
+	if l.compress {
+		l.compressing.Add(1)
+		go func() {
+			defer l.compressing.Done()
+			if err := gzipFile(rotatedPath); err != nil {
+				common.GetLogger(context.Background()).WithFields(logrus.Fields{
+					"err":  errors.Wrap(err, errCompressRotated.Error()),
+					"file": rotatedPath,
+				}).Error("error_compress_event_log")
+			}
+		}()
+	}
+	return nil
+}
+
+// rotatedPath suffixes the path with the rotation time, numbering it when a file was already rotated
+// in the same millisecond, so it isn't replaced. The rotated file is only removed once gzipped.
+func (l *EventLog) rotatedPath(now time.Time) string {
+	base := l.path + "." + now.UTC().Format(rotatedTimeFormat)
+	rotatedPath := base
+	for n := 1; exists(rotatedPath) || exists(rotatedPath+".gz"); n++ {
+		rotatedPath = fmt.Sprintf("%s_%d", base, n)
+	}
+	return rotatedPath
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func (l *EventLog) sync() error {
+	if err := l.file.Sync(); err != nil {
+		return errors.Wrap(err, errSyncEventLog.Error())
+	}
+	l.unsynced = false
+	return nil
+}
+
+// closeFile syncs and closes the file, if one is open
+func (l *EventLog) closeFile() error {
+	if l.file == nil {
+		return nil
+	}
+	err := l.sync()
+	if closeErr := l.file.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	return err
+}
+
+// Sync syncs the lines written since the last sync to disk.
+// It runs periodically in the background in the interval fsync mode, and failures are logged for the next run to retry.
+func (l *EventLog) Sync(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil || !l.unsynced {
+		return
+	}
+	if err := l.sync(); err != nil {
+		common.GetLogger(ctx).WithFields(logrus.Fields{
+			"err": err,
+		}).Error("error_sync_event_log")
+	}
+}
+
+// Close syncs and closes the file, and waits for rotated files to be compressed.
+// Publishing afterwards fails.
+func (l *EventLog) Close() error {
+	l.mu.Lock()
+	err := l.closeFile()
+	l.closed = true
+	l.mu.Unlock()
+
+	l.compressing.Wait()
+	return err
+}
+
+// NewEventLog returns a new *EventLog instance appending to the file at path, where an empty path disables the log.
+// A max size of 0 disables rotating by size, and a max age of 0 rotating by time.
+func NewEventLog(path string, maxSize int64, maxAge time.Duration, compress bool, fsync string) *EventLog {
+	return &EventLog{
+		path:     path,
+		maxSize:  maxSize,
+		maxAge:   maxAge,
+		compress: compress,
+		fsync:    fsync,
+	}
+}
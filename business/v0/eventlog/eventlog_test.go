@@ -0,0 +1,169 @@
+package eventlog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"platform_engineer_clone/models"
+	"sort"
+	"testing"
+	"time"
+)
+
+func mockEvent(tokenId int) *models.TokenLifecycleEvent {
+	return &models.TokenLifecycleEvent{
+		Type:       models.TokenLifecycleCreated,
+		Token:      models.TokenRef{Id: tokenId, KeyPrefix: "inv_3k"},
+		OccurredAt: time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+// readTokenIds returns the token ids of the events logged in the file, gunzipping it if needed
+func readTokenIds(t *testing.T, path string) []int {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var r io.Reader = f
+	if filepath.Ext(path) == ".gz" {
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r = gz
+	}
+
+	ids := []int{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var event models.TokenLifecycleEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		ids = append(ids, event.Token.Id)
+	}
+	require.NoError(t, scanner.Err())
+	return ids
+}
+
+// rotatedFiles returns the rotated files next to the log, oldest first
+func rotatedFiles(t *testing.T, path string) []string {
+	files, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	sort.Strings(files)
+	return files
+}
+
+func TestEventLog_Publish_HappyPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	eventLog := NewEventLog(path, 0, 0, true, FsyncAlways)
+
+	for id := 1; id <= 3; id++ {
+		require.NoError(t, eventLog.Publish(context.Background(), mockEvent(id)))
+	}
+	require.NoError(t, eventLog.Close())
+	t.Run("Test Publish - Happy Path", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, readTokenIds(t, path))
+		assert.Empty(t, rotatedFiles(t, path))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+}
+
+func TestEventLog_Publish_RotateBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	line, err := json.Marshal(mockEvent(1))
+	require.NoError(t, err)
+	// Two lines fit in a file
+	eventLog := NewEventLog(path, int64(2*(len(line)+1)), 0, true, FsyncNever)
+
+	for id := 1; id <= 5; id++ {
+		require.NoError(t, eventLog.Publish(context.Background(), mockEvent(id)))
+	}
+	require.NoError(t, eventLog.Close())
+	t.Run("Test Publish - Rotate By Size", func(t *testing.T) {
+		rotated := rotatedFiles(t, path)
+		require.Len(t, rotated, 2)
+		assert.Equal(t, ".gz", filepath.Ext(rotated[0]))
+		assert.Equal(t, []int{1, 2}, readTokenIds(t, rotated[0]))
+		assert.Equal(t, []int{3, 4}, readTokenIds(t, rotated[1]))
+		assert.Equal(t, []int{5}, readTokenIds(t, path))
+	})
+}
+
+func TestEventLog_Publish_RotateByAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	eventLog := NewEventLog(path, 0, 24*time.Hour, false, FsyncInterval)
+	require.NoError(t, eventLog.Publish(context.Background(), mockEvent(1)))
+	require.NoError(t, eventLog.Close())
+	yesterday := time.Now().Add(-24 * time.Hour)
+	require.NoError(t, os.Chtimes(path, yesterday, yesterday))
+
+	eventLog = NewEventLog(path, 0, 24*time.Hour, false, FsyncInterval)
+	require.NoError(t, eventLog.Publish(context.Background(), mockEvent(2)))
+	require.NoError(t, eventLog.Publish(context.Background(), mockEvent(3)))
+	eventLog.Sync(context.Background())
+	require.NoError(t, eventLog.Close())
+	t.Run("Test Publish - Rotate By Age", func(t *testing.T) {
+		rotated := rotatedFiles(t, path)
+		require.Len(t, rotated, 1)
+		assert.NotEqual(t, ".gz", filepath.Ext(rotated[0]))
+		assert.Equal(t, []int{1}, readTokenIds(t, rotated[0]))
+		assert.Equal(t, []int{2, 3}, readTokenIds(t, path))
+	})
+}
+
+func TestEventLog_Rotate_SameMillisecond(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	eventLog := NewEventLog(path, 0, 0, false, FsyncNever)
+	now := time.Now()
+
+	for id := 1; id <= 3; id++ {
+		require.NoError(t, eventLog.Publish(context.Background(), mockEvent(id)))
+		eventLog.mu.Lock()
+		require.NoError(t, eventLog.rotate(now))
+		eventLog.mu.Unlock()
+	}
+	require.NoError(t, eventLog.Close())
+	t.Run("Test Rotate - Same Millisecond Keeps Every File", func(t *testing.T) {
+		rotated := rotatedFiles(t, path)
+		require.Len(t, rotated, 3)
+		assert.Equal(t, []int{1}, readTokenIds(t, rotated[0]))
+		assert.Equal(t, []int{2}, readTokenIds(t, rotated[1]))
+		assert.Equal(t, []int{3}, readTokenIds(t, rotated[2]))
+	})
+}
+
+func TestEventLog_Publish_Disabled(t *testing.T) {
+	eventLog := NewEventLog("", 0, 0, true, FsyncAlways)
+	err := eventLog.Publish(context.Background(), mockEvent(1))
+	t.Run("Test Publish - Disabled", func(t *testing.T) {
+		assert.NoError(t, err)
+		assert.NoError(t, eventLog.Close())
+	})
+}
+
+func TestEventLog_Publish_FailClosed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	eventLog := NewEventLog(path, 0, 0, true, FsyncAlways)
+	require.NoError(t, eventLog.Close())
+
+	err := eventLog.Publish(context.Background(), mockEvent(1))
+	t.Run("Test Publish - Fail Closed", func(t *testing.T) {
+		assert.ErrorIs(t, err, errEventLogClosed)
+	})
+}
+
+func TestEventLog_Publish_FailOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "events.jsonl")
+	eventLog := NewEventLog(path, 0, 0, true, FsyncAlways)
+
+	err := eventLog.Publish(context.Background(), mockEvent(1))
+	t.Run("Test Publish - Fail Open", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errOpenEventLog.Error())
+	})
+}
@@ -0,0 +1,143 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
+	"platform_engineer_clone/src/utils/sealing"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . dataPersistence
+type dataPersistence interface {
+	Reserve(ctx context.Context, request *models.IdempotencyRequest, now time.Time, expiresAt time.Time) (*models.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, id int, response *models.IdempotentResponse) error
+	Release(ctx context.Context, id int) error
+	DeleteExpired(ctx context.Context, now time.Time, limit int) (int64, error)
+}
+
+var (
+	ErrIdempotencyKeyReused = error_handling.New("idempotency_key_reused", http.StatusConflict,
+		"error, the idempotency key was already sent with a different request")
+	ErrRequestInProgress = error_handling.New("idempotency_request_in_progress", http.StatusConflict,
+		"error, a request with the idempotency key is still being handled")
+)
+
+var (
+	errReserveKey   = errors.New("error, reserving idempotency key fails")
+	errOpenResponse = errors.New("error, opening stored response fails")
+	errSealResponse = errors.New("error, sealing response fails")
+	errCompleteKey  = errors.New("error, storing response for idempotency key fails")
+	errReleaseKey   = errors.New("error, releasing idempotency key fails")
+	errPurgeExpired = errors.New("error, purging expired idempotency keys fails")
+)
+
+// BusinessIdempotency remembers the response to every request sent with an idempotency key for the window,
+// so a client retrying the request, e.g. after a timeout, gets the original response rather than
+// a second token. Responses are sealed at rest, since they may hold token keys.
+type BusinessIdempotency struct {
+	dataLayer dataPersistence
+	sealer    *sealing.Sealer
+	window    time.Duration
+	batchSize int
+}
+
+// Begin reserves the key for the request. It returns the record with a nil response when the request
+// is new and must be handled, and the record with the original response when it's a retry to replay.
+func (b *BusinessIdempotency) Begin(ctx context.Context, request *models.IdempotencyRequest) (*models.IdempotencyRecord, error) {
+	now := time.Now()
+	record, reserved, err := b.dataLayer.Reserve(ctx, request, now, now.Add(b.window))
+	if err != nil {
+		return nil, errors.Wrap(err, errReserveKey.Error())
+	}
+	if reserved {
+		return record, nil
+	}
+	if record.RequestHash != request.RequestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if record.Response == nil {
+		return nil, ErrRequestInProgress
+	}
+
+	body, err := b.sealer.Open(record.Response.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, errOpenResponse.Error())
+	}
+	return &models.IdempotencyRecord{
+		Id:          record.Id,
+		RequestHash: record.RequestHash,
+		Response: &models.IdempotentResponse{
+			StatusCode:  record.Response.StatusCode,
+			ContentType: record.Response.ContentType,
+			Body:        body,
+		},
+	}, nil
+}
+
+// Complete stores the response to the request reserved by Begin, to replay to retries
+func (b *BusinessIdempotency) Complete(ctx context.Context, id int, response *models.IdempotentResponse) error {
+	body, err := b.sealer.Seal(response.Body)
+	if err != nil {
+		return errors.Wrap(err, errSealResponse.Error())
+	}
+	err = b.dataLayer.Complete(ctx, id, &models.IdempotentResponse{
+		StatusCode:  response.StatusCode,
+		ContentType: response.ContentType,
+		Body:        body,
+	})
+	if err != nil {
+		return errors.Wrap(err, errCompleteKey.Error())
+	}
+	return nil
+}
+
+// Release forgets the request reserved by Begin, when it failed in a way worth retrying
+func (b *BusinessIdempotency) Release(ctx context.Context, id int) error {
+	if err := b.dataLayer.Release(ctx, id); err != nil {
+		return errors.Wrap(err, errReleaseKey.Error())
+	}
+	return nil
+}
+
+// PurgeExpired deletes the keys whose window has passed, batch after batch.
+// It runs periodically in the background, and failures are logged for the next run to retry.
+func (b *BusinessIdempotency) PurgeExpired(ctx context.Context) {
+	logger := common.GetLogger(ctx)
+	now := time.Now()
+	var purged int64
+	for {
+		deleted, err := b.dataLayer.DeleteExpired(ctx, now, b.batchSize)
+		purged += deleted
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"err": errors.Wrap(err, errPurgeExpired.Error()),
+			}).Error("error_purge_idempotency_keys")
+			break
+		}
+		if deleted < int64(b.batchSize) {
+			break
+		}
+	}
+	if purged > 0 {
+		logger.WithFields(logrus.Fields{
+			"purged": purged,
+		}).Info("purge_idempotency_keys")
+	}
+}
+
+// NewBusinessIdempotency returns a new *BusinessIdempotency instance, keeping responses for the window,
+// and purging batchSize expired keys at a time
+func NewBusinessIdempotency(dataLayer dataPersistence, sealer *sealing.Sealer, window time.Duration,
+	batchSize int) *BusinessIdempotency {
+	return &BusinessIdempotency{
+		dataLayer: dataLayer,
+		sealer:    sealer,
+		window:    window,
+		batchSize: batchSize,
+	}
+}
@@ -0,0 +1,143 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/business/v0/idempotency/idempotencyfakes"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/sealing"
+	"testing"
+	"time"
+)
+
+func mockIdempotencyRequest() *models.IdempotencyRequest {
+	return &models.IdempotencyRequest{
+		UserId:      3,
+		Scope:       "POST /api/v0/token/",
+		Key:         "retry-1",
+		RequestHash: "5f0c",
+	}
+}
+
+func newTestBusinessIdempotency(dataLayer dataPersistence) *BusinessIdempotency {
+	return NewBusinessIdempotency(dataLayer, sealing.NewSealer("secret", "idempotency"), 24*time.Hour, 2)
+}
+
+func TestBusinessIdempotency_Begin_HappyPath_Reserved(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.ReserveReturns(&models.IdempotencyRecord{Id: 7, RequestHash: "5f0c"}, true, nil)
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	record, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Happy Path Reserved", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 7, record.Id)
+		assert.Nil(t, record.Response)
+
+		_, request, now, expiresAt := fakeDataPersistence.ReserveArgsForCall(0)
+		assert.Equal(t, mockIdempotencyRequest(), request)
+		assert.Equal(t, 24*time.Hour, expiresAt.Sub(now))
+	})
+}
+
+func TestBusinessIdempotency_Begin_HappyPath_Replay(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+
+	err := businessIdempotency.Complete(context.Background(), 7, &models.IdempotentResponse{
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte(`{"key":"inv_3kd9"}`),
+	})
+	require.NoError(t, err)
+	_, _, stored := fakeDataPersistence.CompleteArgsForCall(0)
+
+	fakeDataPersistence.ReserveReturns(&models.IdempotencyRecord{Id: 7, RequestHash: "5f0c", Response: stored}, false, nil)
+	record, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Happy Path Replay", func(t *testing.T) {
+		assert.NotContains(t, string(stored.Body), "inv_3kd9")
+		require.NoError(t, err)
+		assert.Equal(t, &models.IdempotentResponse{
+			StatusCode:  201,
+			ContentType: "application/json",
+			Body:        []byte(`{"key":"inv_3kd9"}`),
+		}, record.Response)
+	})
+}
+
+func TestBusinessIdempotency_Begin_Fail_KeyReused(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.ReserveReturns(&models.IdempotencyRecord{Id: 7, RequestHash: "a1b2"}, false, nil)
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	_, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Fail Key Reused", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+}
+
+func TestBusinessIdempotency_Begin_Fail_InProgress(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.ReserveReturns(&models.IdempotencyRecord{Id: 7, RequestHash: "5f0c"}, false, nil)
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	_, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Fail In Progress", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrRequestInProgress)
+	})
+}
+
+func TestBusinessIdempotency_Begin_Fail_Tampered(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.ReserveReturns(&models.IdempotencyRecord{
+		Id:          7,
+		RequestHash: "5f0c",
+		Response:    &models.IdempotentResponse{StatusCode: 201, Body: []byte("not sealed, and long enough to open")},
+	}, false, nil)
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	_, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Fail Tampered Response", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errOpenResponse.Error())
+	})
+}
+
+func TestBusinessIdempotency_Begin_Fail_Reserve(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.ReserveReturns(nil, false, errors.New("connection lost"))
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	_, err := businessIdempotency.Begin(context.Background(), mockIdempotencyRequest())
+	t.Run("Test Begin - Fail Reserve", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errReserveKey.Error())
+	})
+}
+
+func TestBusinessIdempotency_PurgeExpired_HappyPath_FullBatches(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.DeleteExpiredReturnsOnCall(0, 2, nil)
+	fakeDataPersistence.DeleteExpiredReturnsOnCall(1, 1, nil)
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	businessIdempotency.PurgeExpired(context.Background())
+	t.Run("Test PurgeExpired - Happy Path Full Batches", func(t *testing.T) {
+		assert.Equal(t, 2, fakeDataPersistence.DeleteExpiredCallCount())
+		_, _, limit := fakeDataPersistence.DeleteExpiredArgsForCall(0)
+		assert.Equal(t, 2, limit)
+	})
+}
+
+func TestBusinessIdempotency_PurgeExpired_Fail(t *testing.T) {
+	fakeDataPersistence := idempotencyfakes.FakeDataPersistence{}
+	fakeDataPersistence.DeleteExpiredReturns(0, errors.New("connection lost"))
+
+	businessIdempotency := newTestBusinessIdempotency(&fakeDataPersistence)
+	businessIdempotency.PurgeExpired(context.Background())
+	t.Run("Test PurgeExpired - Fail Stops", func(t *testing.T) {
+		assert.Equal(t, 1, fakeDataPersistence.DeleteExpiredCallCount())
+	})
+}
@@ -0,0 +1,364 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package idempotencyfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeDataPersistence struct {
+	CompleteStub        func(context.Context, int, *models.IdempotentResponse) error
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.IdempotentResponse
+	}
+	completeReturns struct {
+		result1 error
+	}
+	completeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExpiredStub        func(context.Context, time.Time, int) (int64, error)
+	deleteExpiredMutex       sync.RWMutex
+	deleteExpiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	deleteExpiredReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteExpiredReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	ReleaseStub        func(context.Context, int) error
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	releaseReturns struct {
+		result1 error
+	}
+	releaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReserveStub        func(context.Context, *models.IdempotencyRequest, time.Time, time.Time) (*models.IdempotencyRecord, bool, error)
+	reserveMutex       sync.RWMutex
+	reserveArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.IdempotencyRequest
+		arg3 time.Time
+		arg4 time.Time
+	}
+	reserveReturns struct {
+		result1 *models.IdempotencyRecord
+		result2 bool
+		result3 error
+	}
+	reserveReturnsOnCall map[int]struct {
+		result1 *models.IdempotencyRecord
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDataPersistence) Complete(arg1 context.Context, arg2 int, arg3 *models.IdempotentResponse) error {
+	fake.completeMutex.Lock()
+	ret, specificReturn := fake.completeReturnsOnCall[len(fake.completeArgsForCall)]
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.IdempotentResponse
+	}{arg1, arg2, arg3})
+	stub := fake.CompleteStub
+	fakeReturns := fake.completeReturns
+	fake.recordInvocation("Complete", []interface{}{arg1, arg2, arg3})
+	fake.completeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeDataPersistence) CompleteCalls(stub func(context.Context, int, *models.IdempotentResponse) error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = stub
+}
+
+func (fake *FakeDataPersistence) CompleteArgsForCall(i int) (context.Context, int, *models.IdempotentResponse) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	argsForCall := fake.completeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) CompleteReturns(result1 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	fake.completeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) CompleteReturnsOnCall(i int, result1 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	if fake.completeReturnsOnCall == nil {
+		fake.completeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) DeleteExpired(arg1 context.Context, arg2 time.Time, arg3 int) (int64, error) {
+	fake.deleteExpiredMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredReturnsOnCall[len(fake.deleteExpiredArgsForCall)]
+	fake.deleteExpiredArgsForCall = append(fake.deleteExpiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteExpiredStub
+	fakeReturns := fake.deleteExpiredReturns
+	fake.recordInvocation("DeleteExpired", []interface{}{arg1, arg2, arg3})
+	fake.deleteExpiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) DeleteExpiredCallCount() int {
+	fake.deleteExpiredMutex.RLock()
+	defer fake.deleteExpiredMutex.RUnlock()
+	return len(fake.deleteExpiredArgsForCall)
+}
+
+func (fake *FakeDataPersistence) DeleteExpiredCalls(stub func(context.Context, time.Time, int) (int64, error)) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = stub
+}
+
+func (fake *FakeDataPersistence) DeleteExpiredArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.deleteExpiredMutex.RLock()
+	defer fake.deleteExpiredMutex.RUnlock()
+	argsForCall := fake.deleteExpiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) DeleteExpiredReturns(result1 int64, result2 error) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = nil
+	fake.deleteExpiredReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) DeleteExpiredReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = nil
+	if fake.deleteExpiredReturnsOnCall == nil {
+		fake.deleteExpiredReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteExpiredReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) Release(arg1 context.Context, arg2 int) error {
+	fake.releaseMutex.Lock()
+	ret, specificReturn := fake.releaseReturnsOnCall[len(fake.releaseArgsForCall)]
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseStub
+	fakeReturns := fake.releaseReturns
+	fake.recordInvocation("Release", []interface{}{arg1, arg2})
+	fake.releaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakeDataPersistence) ReleaseCalls(stub func(context.Context, int) error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *FakeDataPersistence) ReleaseArgsForCall(i int) (context.Context, int) {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	argsForCall := fake.releaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) ReleaseReturns(result1 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	fake.releaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) ReleaseReturnsOnCall(i int, result1 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	if fake.releaseReturnsOnCall == nil {
+		fake.releaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) Reserve(arg1 context.Context, arg2 *models.IdempotencyRequest, arg3 time.Time, arg4 time.Time) (*models.IdempotencyRecord, bool, error) {
+	fake.reserveMutex.Lock()
+	ret, specificReturn := fake.reserveReturnsOnCall[len(fake.reserveArgsForCall)]
+	fake.reserveArgsForCall = append(fake.reserveArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.IdempotencyRequest
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReserveStub
+	fakeReturns := fake.reserveReturns
+	fake.recordInvocation("Reserve", []interface{}{arg1, arg2, arg3, arg4})
+	fake.reserveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDataPersistence) ReserveCallCount() int {
+	fake.reserveMutex.RLock()
+	defer fake.reserveMutex.RUnlock()
+	return len(fake.reserveArgsForCall)
+}
+
+func (fake *FakeDataPersistence) ReserveCalls(stub func(context.Context, *models.IdempotencyRequest, time.Time, time.Time) (*models.IdempotencyRecord, bool, error)) {
+	fake.reserveMutex.Lock()
+	defer fake.reserveMutex.Unlock()
+	fake.ReserveStub = stub
+}
+
+func (fake *FakeDataPersistence) ReserveArgsForCall(i int) (context.Context, *models.IdempotencyRequest, time.Time, time.Time) {
+	fake.reserveMutex.RLock()
+	defer fake.reserveMutex.RUnlock()
+	argsForCall := fake.reserveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDataPersistence) ReserveReturns(result1 *models.IdempotencyRecord, result2 bool, result3 error) {
+	fake.reserveMutex.Lock()
+	defer fake.reserveMutex.Unlock()
+	fake.ReserveStub = nil
+	fake.reserveReturns = struct {
+		result1 *models.IdempotencyRecord
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDataPersistence) ReserveReturnsOnCall(i int, result1 *models.IdempotencyRecord, result2 bool, result3 error) {
+	fake.reserveMutex.Lock()
+	defer fake.reserveMutex.Unlock()
+	fake.ReserveStub = nil
+	if fake.reserveReturnsOnCall == nil {
+		fake.reserveReturnsOnCall = make(map[int]struct {
+			result1 *models.IdempotencyRecord
+			result2 bool
+			result3 error
+		})
+	}
+	fake.reserveReturnsOnCall[i] = struct {
+		result1 *models.IdempotencyRecord
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDataPersistence) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	fake.deleteExpiredMutex.RLock()
+	defer fake.deleteExpiredMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	fake.reserveMutex.RLock()
+	defer fake.reserveMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDataPersistence) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
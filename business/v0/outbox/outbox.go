@@ -0,0 +1,130 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . dataPersistence
+type dataPersistence interface {
+	RelayPending(ctx context.Context, limit int, maxAttempts int, lease time.Duration,
+		publish func(event *models.OutboxEvent) ([]string, error)) (int, int, error)
+	DeleteSent(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . sink
+type sink interface {
+	Name() string
+	Publish(ctx context.Context, event *models.TokenLifecycleEvent) error
+}
+
+var (
+	errDecodeEvent = errors.New("error, decoding outbox event fails")
+	errRelayEvents = errors.New("error, relaying outbox events fails")
+	errDeleteSent  = errors.New("error, deleting sent outbox events fails")
+)
+
+// OutboxRelay publishes the events written to the outbox along with the token changes to every sink,
+// such as the webhooks.
+// Delivery is at least once: an event is retried until every sink accepts it, skipping the sinks that
+// accepted it on an earlier attempt. Events are relayed in the order they were written, and a failing event
+// holds back the ones after it, which keeps each token's events in order, until it has failed maxAttempts
+// times. It is then parked, left unsent for an operator to look into, and the events after it go on.
+// Each batch is claimed for the lease while it is published, so relays running on other instances leave it alone.
+type OutboxRelay struct {
+	dataLayer   dataPersistence
+	sinks       []sink
+	batchSize   int
+	maxAttempts int
+	lease       time.Duration
+	retention   time.Duration
+}
+
+// Relay publishes the pending events, batch after batch, then deletes the events sent before the retention.
+// It runs periodically in the background, and failures are logged for the next run to retry.
+func (b *OutboxRelay) Relay(ctx context.Context) {
+	logger := common.GetLogger(ctx)
+	relayed, parked := 0, 0
+	for {
+		sent, failed, err := b.dataLayer.RelayPending(ctx, b.batchSize, b.maxAttempts, b.lease,
+			func(event *models.OutboxEvent) ([]string, error) {
+				return b.publish(ctx, event)
+			})
+		relayed += sent
+		parked += failed
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"err": errors.Wrap(err, errRelayEvents.Error()),
+			}).Error("error_relay_outbox")
+			break
+		}
+		if sent+failed < b.batchSize {
+			break
+		}
+	}
+	if relayed > 0 {
+		logger.WithFields(logrus.Fields{
+			"relayed": relayed,
+		}).Info("relay_outbox")
+	}
+	if parked > 0 {
+		logger.WithFields(logrus.Fields{
+			"parked": parked,
+		}).Error("error_relay_outbox_parked")
+	}
+
+	if _, err := b.dataLayer.DeleteSent(ctx, time.Now().Add(-b.retention), b.batchSize); err != nil {
+		logger.WithFields(logrus.Fields{
+			"err": errors.Wrap(err, errDeleteSent.Error()),
+		}).Error("error_relay_outbox")
+	}
+}
+
+// publish sends the event to every sink that hasn't accepted it yet, and returns those that accepted it,
+// along with the first failure
+func (b *OutboxRelay) publish(ctx context.Context, outboxEvent *models.OutboxEvent) ([]string, error) {
+	var event models.TokenLifecycleEvent
+	if err := json.Unmarshal([]byte(outboxEvent.Payload), &event); err != nil {
+		return nil, errors.Wrap(err, errDecodeEvent.Error())
+	}
+
+	delivered := make(map[string]bool, len(outboxEvent.Delivered))
+	for _, name := range outboxEvent.Delivered {
+		delivered[name] = true
+	}
+	var accepted []string
+	var publishErr error
+	for _, sink := range b.sinks {
+		if delivered[sink.Name()] {
+			continue
+		}
+		if err := sink.Publish(ctx, &event); err != nil {
+			if publishErr == nil {
+				publishErr = err
+			}
+			continue
+		}
+		accepted = append(accepted, sink.Name())
+	}
+	return accepted, publishErr
+}
+
+// NewOutboxRelay returns a new *OutboxRelay instance, relaying batchSize events at a time to the sinks,
+// claimed for the lease, parking events once they have failed maxAttempts times, and keeping sent events
+// for the retention
+func NewOutboxRelay(dataLayer dataPersistence, batchSize int, maxAttempts int, lease time.Duration,
+	retention time.Duration, sinks ...sink) *OutboxRelay {
+	return &OutboxRelay{
+		dataLayer:   dataLayer,
+		sinks:       sinks,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		lease:       lease,
+		retention:   retention,
+	}
+}
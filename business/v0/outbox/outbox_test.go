@@ -0,0 +1,143 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/business/v0/outbox/outboxfakes"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+// relayEvents makes the fake relay the events through publish, the way the persistence layer does
+func relayEvents(fakeDataPersistence *outboxfakes.FakeDataPersistence, events ...models.OutboxEvent) {
+	fakeDataPersistence.RelayPendingStub = func(ctx context.Context, limit int, maxAttempts int, lease time.Duration,
+		publish func(event *models.OutboxEvent) ([]string, error)) (int, int, error) {
+		sent := 0
+		for i := range events {
+			if _, err := publish(&events[i]); err != nil {
+				return sent, 0, err
+			}
+			sent++
+		}
+		return sent, 0, nil
+	}
+}
+
+// namedSink returns a fake sink with the given name
+func namedSink(name string) *outboxfakes.FakeSink {
+	sink := outboxfakes.FakeSink{}
+	sink.NameReturns(name)
+	return &sink
+}
+
+func TestOutboxRelay_Relay_HappyPath(t *testing.T) {
+	fakeDataPersistence := outboxfakes.FakeDataPersistence{}
+	relayEvents(&fakeDataPersistence,
+		models.OutboxEvent{Id: 1, Payload: `{"type":"token.created","token":{"id":3,"key_prefix":"inv_3k"},"occurred_at":"2024-06-01T09:30:00Z"}`},
+		models.OutboxEvent{Id: 2, Payload: `{"type":"token.revoked","token":{"id":3,"key_prefix":"inv_3k"},"occurred_at":"2024-06-01T09:31:00Z"}`},
+	)
+	firstSink := namedSink("first")
+	secondSink := namedSink("second")
+
+	relay := NewOutboxRelay(&fakeDataPersistence, 10, 5, time.Minute, time.Hour, firstSink, secondSink)
+	relay.Relay(context.Background())
+	t.Run("Test Relay - Happy Path", func(t *testing.T) {
+		assert.Equal(t, 1, fakeDataPersistence.RelayPendingCallCount())
+		_, _, maxAttempts, lease, _ := fakeDataPersistence.RelayPendingArgsForCall(0)
+		assert.Equal(t, 5, maxAttempts)
+		assert.Equal(t, time.Minute, lease)
+		for _, sink := range []*outboxfakes.FakeSink{firstSink, secondSink} {
+			require.Equal(t, 2, sink.PublishCallCount())
+			_, created := sink.PublishArgsForCall(0)
+			assert.Equal(t, &models.TokenLifecycleEvent{
+				Type:       models.TokenLifecycleCreated,
+				Token:      models.TokenRef{Id: 3, KeyPrefix: "inv_3k"},
+				OccurredAt: time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC),
+			}, created)
+			_, revoked := sink.PublishArgsForCall(1)
+			assert.Equal(t, models.TokenLifecycleRevoked, revoked.Type)
+		}
+
+		_, before, _ := fakeDataPersistence.DeleteSentArgsForCall(0)
+		assert.WithinDuration(t, time.Now().Add(-time.Hour), before, time.Minute)
+	})
+}
+
+func TestOutboxRelay_Relay_HappyPath_FullBatches(t *testing.T) {
+	fakeDataPersistence := outboxfakes.FakeDataPersistence{}
+	fakeDataPersistence.RelayPendingReturnsOnCall(0, 2, 0, nil)
+	fakeDataPersistence.RelayPendingReturnsOnCall(1, 1, 1, nil)
+	fakeDataPersistence.RelayPendingReturnsOnCall(2, 1, 0, nil)
+
+	relay := NewOutboxRelay(&fakeDataPersistence, 2, 5, time.Minute, time.Hour)
+	relay.Relay(context.Background())
+	t.Run("Test Relay - Happy Path Full Batches Counting Parked Events", func(t *testing.T) {
+		assert.Equal(t, 3, fakeDataPersistence.RelayPendingCallCount())
+	})
+}
+
+func TestOutboxRelay_Relay_FailSink(t *testing.T) {
+	fakeDataPersistence := outboxfakes.FakeDataPersistence{}
+	relayEvents(&fakeDataPersistence,
+		models.OutboxEvent{Id: 1, Payload: `{"type":"token.created","token":{"id":3}}`},
+		models.OutboxEvent{Id: 2, Payload: `{"type":"token.created","token":{"id":4}}`},
+	)
+	failingSink := namedSink("failing")
+	failingSink.PublishReturns(fmt.Errorf("sink unavailable"))
+	otherSink := namedSink("other")
+
+	relay := NewOutboxRelay(&fakeDataPersistence, 1, 5, time.Minute, time.Hour, failingSink, otherSink)
+	relay.Relay(context.Background())
+	t.Run("Test Relay - Fail Sink Stops The Relay", func(t *testing.T) {
+		assert.Equal(t, 1, fakeDataPersistence.RelayPendingCallCount())
+		assert.Equal(t, 1, failingSink.PublishCallCount())
+		assert.Equal(t, 1, otherSink.PublishCallCount())
+		assert.Equal(t, 1, fakeDataPersistence.DeleteSentCallCount())
+	})
+}
+
+func TestOutboxRelay_Publish_FailSink_ReturnsAccepted(t *testing.T) {
+	failingSink := namedSink("failing")
+	failingSink.PublishReturns(fmt.Errorf("sink unavailable"))
+	otherSink := namedSink("other")
+
+	relay := NewOutboxRelay(&outboxfakes.FakeDataPersistence{}, 10, 5, time.Minute, time.Hour, failingSink, otherSink)
+	accepted, err := relay.publish(context.Background(), &models.OutboxEvent{Id: 1, Payload: `{"type":"token.created"}`})
+	t.Run("Test Publish - Fail Sink Returns Accepted", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Equal(t, []string{"other"}, accepted)
+	})
+}
+
+func TestOutboxRelay_Publish_SkipsDelivered(t *testing.T) {
+	deliveredSink := namedSink("delivered")
+	otherSink := namedSink("other")
+
+	relay := NewOutboxRelay(&outboxfakes.FakeDataPersistence{}, 10, 5, time.Minute, time.Hour, deliveredSink, otherSink)
+	accepted, err := relay.publish(context.Background(), &models.OutboxEvent{
+		Id:        1,
+		Payload:   `{"type":"token.created"}`,
+		Attempts:  1,
+		Delivered: []string{"delivered"},
+	})
+	t.Run("Test Publish - Skips Delivered", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other"}, accepted)
+		assert.Equal(t, 0, deliveredSink.PublishCallCount())
+		assert.Equal(t, 1, otherSink.PublishCallCount())
+	})
+}
+
+func TestOutboxRelay_Publish_FailDecode(t *testing.T) {
+	sink := outboxfakes.FakeSink{}
+	relay := NewOutboxRelay(&outboxfakes.FakeDataPersistence{}, 10, 5, time.Minute, time.Hour, &sink)
+	_, err := relay.publish(context.Background(), &models.OutboxEvent{Id: 1, Payload: "{"})
+	t.Run("Test Publish - Fail Decode", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errDecodeEvent.Error())
+		assert.Equal(t, 0, sink.PublishCallCount())
+	})
+}
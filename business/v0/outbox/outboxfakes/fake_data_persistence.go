@@ -0,0 +1,212 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package outboxfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeDataPersistence struct {
+	DeleteSentStub        func(context.Context, time.Time, int) (int64, error)
+	deleteSentMutex       sync.RWMutex
+	deleteSentArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	deleteSentReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteSentReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RelayPendingStub        func(context.Context, int, int, time.Duration, func(event *models.OutboxEvent) ([]string, error)) (int, int, error)
+	relayPendingMutex       sync.RWMutex
+	relayPendingArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 time.Duration
+		arg5 func(event *models.OutboxEvent) ([]string, error)
+	}
+	relayPendingReturns struct {
+		result1 int
+		result2 int
+		result3 error
+	}
+	relayPendingReturnsOnCall map[int]struct {
+		result1 int
+		result2 int
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDataPersistence) DeleteSent(arg1 context.Context, arg2 time.Time, arg3 int) (int64, error) {
+	fake.deleteSentMutex.Lock()
+	ret, specificReturn := fake.deleteSentReturnsOnCall[len(fake.deleteSentArgsForCall)]
+	fake.deleteSentArgsForCall = append(fake.deleteSentArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteSentStub
+	fakeReturns := fake.deleteSentReturns
+	fake.recordInvocation("DeleteSent", []interface{}{arg1, arg2, arg3})
+	fake.deleteSentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) DeleteSentCallCount() int {
+	fake.deleteSentMutex.RLock()
+	defer fake.deleteSentMutex.RUnlock()
+	return len(fake.deleteSentArgsForCall)
+}
+
+func (fake *FakeDataPersistence) DeleteSentCalls(stub func(context.Context, time.Time, int) (int64, error)) {
+	fake.deleteSentMutex.Lock()
+	defer fake.deleteSentMutex.Unlock()
+	fake.DeleteSentStub = stub
+}
+
+func (fake *FakeDataPersistence) DeleteSentArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.deleteSentMutex.RLock()
+	defer fake.deleteSentMutex.RUnlock()
+	argsForCall := fake.deleteSentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) DeleteSentReturns(result1 int64, result2 error) {
+	fake.deleteSentMutex.Lock()
+	defer fake.deleteSentMutex.Unlock()
+	fake.DeleteSentStub = nil
+	fake.deleteSentReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) DeleteSentReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteSentMutex.Lock()
+	defer fake.deleteSentMutex.Unlock()
+	fake.DeleteSentStub = nil
+	if fake.deleteSentReturnsOnCall == nil {
+		fake.deleteSentReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteSentReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RelayPending(arg1 context.Context, arg2 int, arg3 int, arg4 time.Duration, arg5 func(event *models.OutboxEvent) ([]string, error)) (int, int, error) {
+	fake.relayPendingMutex.Lock()
+	ret, specificReturn := fake.relayPendingReturnsOnCall[len(fake.relayPendingArgsForCall)]
+	fake.relayPendingArgsForCall = append(fake.relayPendingArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 time.Duration
+		arg5 func(event *models.OutboxEvent) ([]string, error)
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RelayPendingStub
+	fakeReturns := fake.relayPendingReturns
+	fake.recordInvocation("RelayPending", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.relayPendingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDataPersistence) RelayPendingCallCount() int {
+	fake.relayPendingMutex.RLock()
+	defer fake.relayPendingMutex.RUnlock()
+	return len(fake.relayPendingArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RelayPendingCalls(stub func(context.Context, int, int, time.Duration, func(event *models.OutboxEvent) ([]string, error)) (int, int, error)) {
+	fake.relayPendingMutex.Lock()
+	defer fake.relayPendingMutex.Unlock()
+	fake.RelayPendingStub = stub
+}
+
+func (fake *FakeDataPersistence) RelayPendingArgsForCall(i int) (context.Context, int, int, time.Duration, func(event *models.OutboxEvent) ([]string, error)) {
+	fake.relayPendingMutex.RLock()
+	defer fake.relayPendingMutex.RUnlock()
+	argsForCall := fake.relayPendingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeDataPersistence) RelayPendingReturns(result1 int, result2 int, result3 error) {
+	fake.relayPendingMutex.Lock()
+	defer fake.relayPendingMutex.Unlock()
+	fake.RelayPendingStub = nil
+	fake.relayPendingReturns = struct {
+		result1 int
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDataPersistence) RelayPendingReturnsOnCall(i int, result1 int, result2 int, result3 error) {
+	fake.relayPendingMutex.Lock()
+	defer fake.relayPendingMutex.Unlock()
+	fake.RelayPendingStub = nil
+	if fake.relayPendingReturnsOnCall == nil {
+		fake.relayPendingReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 int
+			result3 error
+		})
+	}
+	fake.relayPendingReturnsOnCall[i] = struct {
+		result1 int
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDataPersistence) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteSentMutex.RLock()
+	defer fake.deleteSentMutex.RUnlock()
+	fake.relayPendingMutex.RLock()
+	defer fake.relayPendingMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDataPersistence) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,161 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// subscriberBufferSize is how many events a subscriber may fall behind before it is dropped
+const subscriberBufferSize = 256
+
+var ErrBrokerClosed = error_handling.New("stream_closed", http.StatusServiceUnavailable,
+	"error, the event stream is shutting down")
+
+// streamedEvents are the event types pushed to subscribers
+var streamedEvents = map[string]bool{
+	models.TokenLifecycleCreated:   true,
+	models.TokenLifecycleValidated: true,
+	models.TokenLifecycleRevoked:   true,
+	models.TokenLifecycleExpired:   true,
+}
+
+// Broker fans token lifecycle events out to the stream's subscribers, in process.
+// It keeps the latest events in a bounded buffer, so subscribers can resume after reconnecting.
+// Publishing never waits for subscribers: one that falls too far behind is dropped,
+// and resumes from the buffer once it reconnects.
+// Event ids are prefixed with an epoch set when the broker starts, so ids from another instance,
+// or from before a restart, are never mistaken for its own.
+type Broker struct {
+	mu          sync.Mutex
+	epoch       string
+	lastId      int64
+	buffer      []models.TokenStreamEvent
+	bufferSize  int
+	subscribers map[*models.TokenStreamSubscription]chan models.TokenStreamEvent
+	closed      bool
+}
+
+// Name identifies the stream among the outbox sinks
+func (b *Broker) Name() string {
+	return "stream"
+}
+
+// Publish pushes the event to every subscriber, if it is one of the streamed types
+func (b *Broker) Publish(ctx context.Context, event *models.TokenLifecycleEvent) error {
+	if !streamedEvents[event.Type] {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+
+	b.lastId++
+	streamEvent := models.TokenStreamEvent{Id: b.epoch + "-" + strconv.FormatInt(b.lastId, 10), Event: *event}
+	b.buffer = append(b.buffer, streamEvent)
+	if len(b.buffer) > b.bufferSize {
+		b.buffer = b.buffer[len(b.buffer)-b.bufferSize:]
+	}
+
+	for subscription, events := range b.subscribers {
+		select {
+		case events <- streamEvent:
+		default:
+			b.drop(subscription)
+		}
+	}
+	return nil
+}
+
+// Subscribe starts a subscription, whose events are closed once it unsubscribes, falls too far behind,
+// or the broker closes. Given the id of the last event a subscriber received,
+// the subscription resumes with the buffered events after it, and "" starts with new events only.
+// Ids this broker hasn't issued, e.g. from another instance or before a restart, resume with the whole buffer.
+func (b *Broker) Subscribe(lastEventId string) (*models.TokenStreamSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrBrokerClosed
+	}
+
+	events := make(chan models.TokenStreamEvent, subscriberBufferSize)
+	subscription := &models.TokenStreamSubscription{
+		Backlog: []models.TokenStreamEvent{},
+		Events:  events,
+	}
+	if lastEventId != "" {
+		subscription.Backlog, subscription.Reset = b.after(lastEventId)
+	}
+	b.subscribers[subscription] = events
+	return subscription, nil
+}
+
+// after returns the buffered events after lastEventId, and whether any of them are no longer buffered
+func (b *Broker) after(lastEventId string) ([]models.TokenStreamEvent, bool) {
+	lastId, ok := b.sequence(lastEventId)
+	if !ok || lastId > b.lastId {
+		return append([]models.TokenStreamEvent{}, b.buffer...), true
+	}
+	oldestId := b.lastId - int64(len(b.buffer)) + 1
+	if lastId < oldestId-1 {
+		return append([]models.TokenStreamEvent{}, b.buffer...), true
+	}
+	return append([]models.TokenStreamEvent{}, b.buffer[lastId-oldestId+1:]...), false
+}
+
+// sequence returns the sequence of an event id, and false when the broker didn't issue it
+func (b *Broker) sequence(eventId string) (int64, bool) {
+	sequence, ok := strings.CutPrefix(eventId, b.epoch+"-")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(sequence, 10, 64)
+	if err != nil || id < 1 {
+		return 0, false
+	}
+	return id, true
+}
+
+// Unsubscribe ends the subscription, unless it has already ended
+func (b *Broker) Unsubscribe(subscription *models.TokenStreamSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.drop(subscription)
+}
+
+func (b *Broker) drop(subscription *models.TokenStreamSubscription) {
+	events, ok := b.subscribers[subscription]
+	if !ok {
+		return
+	}
+	delete(b.subscribers, subscription)
+	close(events)
+}
+
+// Close ends every subscription, so open streams finish and the server can shut down.
+// Subscribing afterwards fails, and publishing does nothing.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for subscription := range b.subscribers {
+		b.drop(subscription)
+	}
+	b.closed = true
+}
+
+// NewBroker returns a new *Broker instance, buffering the latest bufferSize events
+func NewBroker(bufferSize int) *Broker {
+	return &Broker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		bufferSize:  bufferSize,
+		buffer:      make([]models.TokenStreamEvent, 0, bufferSize),
+		subscribers: map[*models.TokenStreamSubscription]chan models.TokenStreamEvent{},
+	}
+}
@@ -0,0 +1,141 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"testing"
+)
+
+func publishEvents(broker *Broker, eventTypes ...string) {
+	for i, eventType := range eventTypes {
+		_ = broker.Publish(context.Background(), &models.TokenLifecycleEvent{
+			Type:  eventType,
+			Token: models.TokenRef{Id: i + 1},
+		})
+	}
+}
+
+// eventIds returns the ids of the events, in order
+func eventIds(events []models.TokenStreamEvent) []string {
+	ids := []string{}
+	for _, event := range events {
+		ids = append(ids, event.Id)
+	}
+	return ids
+}
+
+// brokerIds returns the ids the broker issues for the sequences
+func brokerIds(broker *Broker, sequences ...int) []string {
+	ids := []string{}
+	for _, sequence := range sequences {
+		ids = append(ids, fmt.Sprintf("%v-%v", broker.epoch, sequence))
+	}
+	return ids
+}
+
+func TestBroker_Publish_HappyPath(t *testing.T) {
+	broker := NewBroker(10)
+	first, err := broker.Subscribe("")
+	require.NoError(t, err)
+	second, err := broker.Subscribe("")
+	require.NoError(t, err)
+
+	publishEvents(broker, models.TokenLifecycleCreated, models.TokenLifecycleRedeemed, models.TokenLifecycleValidated)
+	t.Run("Test Publish - Happy Path Fans Out Streamed Events", func(t *testing.T) {
+		for _, subscription := range []*models.TokenStreamSubscription{first, second} {
+			assert.Empty(t, subscription.Backlog)
+			require.Len(t, subscription.Events, 2)
+			created := <-subscription.Events
+			assert.Equal(t, brokerIds(broker, 1)[0], created.Id)
+			assert.Equal(t, models.TokenLifecycleCreated, created.Event.Type)
+			validated := <-subscription.Events
+			assert.Equal(t, brokerIds(broker, 2)[0], validated.Id)
+			assert.Equal(t, models.TokenLifecycleValidated, validated.Event.Type)
+		}
+	})
+}
+
+func TestBroker_Subscribe_Resume(t *testing.T) {
+	broker := NewBroker(3)
+	publishEvents(broker, models.TokenLifecycleCreated, models.TokenLifecycleCreated,
+		models.TokenLifecycleCreated, models.TokenLifecycleRevoked, models.TokenLifecycleExpired)
+
+	other := NewBroker(3)
+	publishEvents(other, models.TokenLifecycleCreated)
+	require.NotEqual(t, broker.epoch, other.epoch)
+
+	tests := []struct {
+		name        string
+		lastEventId string
+		wantIds     []string
+		wantReset   bool
+	}{
+		{"new events only", "", []string{}, false},
+		{"buffered", broker.epoch + "-3", brokerIds(broker, 4, 5), false},
+		{"oldest buffered", broker.epoch + "-2", brokerIds(broker, 3, 4, 5), false},
+		{"up to date", broker.epoch + "-5", []string{}, false},
+		{"no longer buffered", broker.epoch + "-1", brokerIds(broker, 3, 4, 5), true},
+		{"unknown", broker.epoch + "-9", brokerIds(broker, 3, 4, 5), true},
+		{"another instance", other.epoch + "-1", brokerIds(broker, 3, 4, 5), true},
+		{"before the epochs", "3", brokerIds(broker, 3, 4, 5), true},
+		{"malformed", broker.epoch + "-x", brokerIds(broker, 3, 4, 5), true},
+	}
+	for _, tt := range tests {
+		subscription, err := broker.Subscribe(tt.lastEventId)
+		t.Run("Test Subscribe - Resume "+tt.name, func(t *testing.T) {
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIds, eventIds(subscription.Backlog))
+			assert.Equal(t, tt.wantReset, subscription.Reset)
+		})
+	}
+}
+
+func TestBroker_Publish_DropsSlowSubscriber(t *testing.T) {
+	broker := NewBroker(10)
+	slow, err := broker.Subscribe("")
+	require.NoError(t, err)
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		publishEvents(broker, models.TokenLifecycleValidated)
+	}
+	t.Run("Test Publish - Drops Slow Subscriber", func(t *testing.T) {
+		received := 0
+		for range slow.Events {
+			received++
+		}
+		assert.Equal(t, subscriberBufferSize, received)
+		assert.Empty(t, broker.subscribers)
+	})
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	broker := NewBroker(10)
+	subscription, err := broker.Subscribe("")
+	require.NoError(t, err)
+
+	broker.Unsubscribe(subscription)
+	broker.Unsubscribe(subscription)
+	publishEvents(broker, models.TokenLifecycleCreated)
+	t.Run("Test Unsubscribe", func(t *testing.T) {
+		_, open := <-subscription.Events
+		assert.False(t, open)
+	})
+}
+
+func TestBroker_Close(t *testing.T) {
+	broker := NewBroker(10)
+	subscription, err := broker.Subscribe("")
+	require.NoError(t, err)
+
+	broker.Close()
+	_, err = broker.Subscribe("")
+	t.Run("Test Close", func(t *testing.T) {
+		_, open := <-subscription.Events
+		assert.False(t, open)
+		assert.ErrorIs(t, err, ErrBrokerClosed)
+		assert.NoError(t, broker.Publish(context.Background(), &models.TokenLifecycleEvent{Type: models.TokenLifecycleCreated}))
+	})
+}
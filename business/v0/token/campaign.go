@@ -0,0 +1,123 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"time"
+)
+
+// ErrTokenOutlivesCampaign is returned when a token minted into a campaign is given an expiry past the campaign's end
+var ErrTokenOutlivesCampaign = error_handling.BadRequest("token_outlives_campaign", "error, token can't expire after its campaign ends")
+
+var (
+	errGenerateCampaignTokens = errors.New("error generating campaign tokens")
+	errRevokeCampaign         = errors.New("error revoking campaign")
+)
+
+// GenerateInCampaign creates params.Count tokens in the campaign, or a single one when count is omitted.
+// Tokens without their own expiry get the campaign's default ttl, or the configured days valid,
+// cut short to the campaign's end, and tokens without their own max uses get the campaign's default.
+// Tokens never activate before the campaign starts, and their ttl is measured from when they activate.
+// Like GenerateBatch, it's all or nothing.
+func (b *BusinessToken) GenerateInCampaign(ctx context.Context, user *models.User, campaign *models.Campaign,
+	params *models.CreateTokenBatch) ([]string, error) {
+	count := params.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 1 || count > b.tokenBatchMaxCount {
+		return nil, ErrInvalidBatchCount.Detail(
+			fmt.Sprintf("count must be between 1 and %v", b.tokenBatchMaxCount))
+	}
+
+	create := params.CreateToken
+	startsAt := time.Now()
+	if campaign.StartsAt.Valid && campaign.StartsAt.Time.After(startsAt) {
+		startsAt = campaign.StartsAt.Time
+		if create.NotBefore == nil || create.NotBefore.Before(startsAt) {
+			create.NotBefore = &campaign.StartsAt.Time
+		}
+	}
+	if create.ExpiresIn == "" && create.ExpiresAt == nil {
+		expiresAt, err := b.campaignExpiresAt(startsAt, campaign)
+		if err != nil {
+			return nil, err
+		}
+		create.ExpiresAt = &expiresAt
+	}
+	if create.MaxUses == nil {
+		create.MaxUses = campaign.DefaultMaxUses.Ptr()
+	}
+
+	newToken, err := b.newToken(startsAt, user, &create)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.EndsAt.Valid && newToken.ExpiresAt.After(campaign.EndsAt.Time) {
+		return nil, ErrTokenOutlivesCampaign.Detail(
+			fmt.Sprintf("campaign ends at %v", campaign.EndsAt.Time.Format(time.RFC3339)))
+	}
+	newToken.CampaignId = &campaign.Id
+	newToken.ActiveQuota, err = b.activeQuota(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenKeys, err := b.dataLayer.GenerateBatch(ctx, newToken, count, b.randomCharMinLength, b.randomCharMaxLength)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateCampaignTokens.Error())
+	}
+	return tokenKeys, nil
+}
+
+// campaignExpiresAt resolves the default expiry of the campaign's tokens activating at startsAt, which never outlive it.
+// The default ttl was within the max ttl when the campaign was saved, but the max ttl may have been lowered since.
+func (b *BusinessToken) campaignExpiresAt(startsAt time.Time, campaign *models.Campaign) (time.Time, error) {
+	expiresAt := startsAt.AddDate(0, 0, b.tokenDaysValid)
+	if campaign.DefaultTTL.Valid {
+		ttl, err := time.ParseDuration(campaign.DefaultTTL.String)
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, errGenerateCampaignTokens.Error())
+		}
+		if ttl > b.tokenMaxTTL {
+			return time.Time{}, ErrTokenTTLOutOfBounds.Detail(
+				fmt.Sprintf("ttl must be between %v and %v", b.tokenMinTTL, b.tokenMaxTTL))
+		}
+		expiresAt = startsAt.Add(ttl)
+	}
+	if campaign.EndsAt.Valid && campaign.EndsAt.Time.Before(expiresAt) {
+		expiresAt = campaign.EndsAt.Time
+	}
+	return expiresAt, nil
+}
+
+// RevokeCampaign revokes the campaign, and every token in it, and returns how many tokens were revoked.
+// The campaign is revoked first, so no more tokens are minted into it, then its tokens one batch at a time,
+// so revoking never locks more than a batch of rows. Revoking a campaign again revokes the tokens reinstated since.
+func (b *BusinessToken) RevokeCampaign(ctx context.Context, id int) (int, error) {
+	if err := b.dataLayer.RevokeCampaign(ctx, id); err != nil {
+		return 0, errors.Wrap(err, errRevokeCampaign.Error())
+	}
+
+	revoked := 0
+	afterId := 0
+	for {
+		refs, err := b.dataLayer.RevokeCampaignTokens(ctx, id, afterId, b.sweepBatchSize)
+		if err != nil {
+			return 0, errors.Wrap(err, errRevokeCampaign.Error())
+		}
+		if b.signer != nil {
+			for _, ref := range refs {
+				b.revoked.add(ref.Id)
+			}
+		}
+		revoked += len(refs)
+		if len(refs) < b.sweepBatchSize {
+			return revoked, nil
+		}
+		afterId = refs[len(refs)-1].Id
+	}
+}
@@ -0,0 +1,173 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"platform_engineer_clone/business/v0/token/tokenfakes"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+func TestBusinessToken_GenerateInCampaign_HappyPath_Defaults(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns([]string{"1234"}, nil)
+
+	startsAt := time.Now().Add(24 * time.Hour)
+	campaign := models.Campaign{
+		Id:             4,
+		DefaultTTL:     null.StringFrom("72h0m0s"),
+		DefaultMaxUses: null.IntFrom(3),
+		StartsAt:       null.TimeFrom(startsAt),
+	}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	keys, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &campaign, &models.CreateTokenBatch{})
+	t.Run("Test GenerateInCampaign - Happy Path Campaign Defaults", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1234"}, keys)
+
+		_, newToken, count, _, _ := fakeDataPersistence.GenerateBatchArgsForCall(0)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, intPtr(4), newToken.CampaignId)
+		assert.Equal(t, intPtr(3), newToken.MaxUses)
+		assert.Equal(t, startsAt.Add(72*time.Hour), newToken.ExpiresAt)
+		require.NotNil(t, newToken.NotBefore)
+		assert.Equal(t, startsAt, *newToken.NotBefore)
+	})
+}
+
+func TestBusinessToken_GenerateInCampaign_HappyPath_ExpiryCutToCampaignEnd(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns([]string{"1234", "5678"}, nil)
+
+	endsAt := time.Now().Add(48 * time.Hour)
+	campaign := models.Campaign{Id: 4, EndsAt: null.TimeFrom(endsAt)}
+	params := models.CreateTokenBatch{Count: 2, CreateToken: models.CreateToken{MaxUses: intPtr(1)}}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &campaign, &params)
+	t.Run("Test GenerateInCampaign - Happy Path Expiry Cut To Campaign End", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, count, _, _ := fakeDataPersistence.GenerateBatchArgsForCall(0)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, endsAt, newToken.ExpiresAt)
+		assert.Equal(t, intPtr(1), newToken.MaxUses)
+		assert.Nil(t, newToken.NotBefore)
+	})
+}
+
+func TestBusinessToken_GenerateInCampaign_HappyPath_TTLFromCampaignStart(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns([]string{"1234"}, nil)
+
+	// Expires past now plus the max ttl, but within it of the campaign's start
+	startsAt := time.Now().Add(29 * 24 * time.Hour)
+	campaign := models.Campaign{
+		Id:         4,
+		DefaultTTL: null.StringFrom("72h0m0s"),
+		StartsAt:   null.TimeFrom(startsAt),
+		EndsAt:     null.TimeFrom(startsAt.Add(48 * time.Hour)),
+	}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &campaign, &models.CreateTokenBatch{})
+	t.Run("Test GenerateInCampaign - Happy Path TTL From Campaign Start", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _, _ := fakeDataPersistence.GenerateBatchArgsForCall(0)
+		assert.Equal(t, startsAt.Add(48*time.Hour), newToken.ExpiresAt)
+		require.NotNil(t, newToken.NotBefore)
+		assert.Equal(t, startsAt, *newToken.NotBefore)
+	})
+}
+
+func TestBusinessToken_GenerateInCampaign_Fail_DefaultTTLOverMax(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	// Saved under a higher max ttl, and cut short by the campaign's end
+	campaign := models.Campaign{
+		Id:         4,
+		DefaultTTL: null.StringFrom("1440h0m0s"),
+		EndsAt:     null.TimeFrom(time.Now().Add(48 * time.Hour)),
+	}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &campaign, &models.CreateTokenBatch{})
+	t.Run("Test GenerateInCampaign - Fail Default TTL Over Max", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrTokenTTLOutOfBounds)
+		assert.Equal(t, 0, fakeDataPersistence.GenerateBatchCallCount())
+	})
+}
+
+func TestBusinessToken_GenerateInCampaign_Fail_OutlivesCampaign(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	campaign := models.Campaign{Id: 4, EndsAt: null.TimeFrom(time.Now().Add(48 * time.Hour))}
+	params := models.CreateTokenBatch{CreateToken: models.CreateToken{ExpiresIn: "72h"}}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &campaign, &params)
+	t.Run("Test GenerateInCampaign - Fail Outlives Campaign", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrTokenOutlivesCampaign)
+		assert.Equal(t, 0, fakeDataPersistence.GenerateBatchCallCount())
+	})
+}
+
+func TestBusinessToken_GenerateInCampaign_Fail_InvalidCount(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateInCampaign(context.Background(), &models.User{Id: 3}, &models.Campaign{Id: 4},
+		&models.CreateTokenBatch{Count: 501})
+	t.Run("Test GenerateInCampaign - Fail Invalid Count", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrInvalidBatchCount)
+	})
+}
+
+func TestBusinessToken_RevokeCampaign_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeCampaignTokensReturnsOnCall(0, []models.TokenRef{{Id: 3}, {Id: 5}}, nil)
+	fakeDataPersistence.RevokeCampaignTokensReturnsOnCall(1, []models.TokenRef{{Id: 8}}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 2, 0, testKeyFormat, true, nil)
+	revoked, err := businessToken.RevokeCampaign(context.Background(), 4)
+	t.Run("Test RevokeCampaign - Happy Path In Batches", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 3, revoked)
+		_, id := fakeDataPersistence.RevokeCampaignArgsForCall(0)
+		assert.Equal(t, 4, id)
+
+		require.Equal(t, 2, fakeDataPersistence.RevokeCampaignTokensCallCount())
+		_, id, afterId, limit := fakeDataPersistence.RevokeCampaignTokensArgsForCall(0)
+		assert.Equal(t, 4, id)
+		assert.Equal(t, 0, afterId)
+		assert.Equal(t, 2, limit)
+		_, _, afterId, _ = fakeDataPersistence.RevokeCampaignTokensArgsForCall(1)
+		assert.Equal(t, 5, afterId)
+	})
+}
+
+func TestBusinessToken_RevokeCampaign_Fail(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeCampaignReturns(models.ErrNotFound)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.RevokeCampaign(context.Background(), 9)
+	t.Run("Test RevokeCampaign - Fail Path", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.Contains(t, err.Error(), errRevokeCampaign.Error())
+		assert.Equal(t, 0, fakeDataPersistence.RevokeCampaignTokensCallCount())
+	})
+}
+
+func TestBusinessToken_RevokeCampaign_FailTokens(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeCampaignTokensReturns(nil, fmt.Errorf("connection lost"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.RevokeCampaign(context.Background(), 4)
+	t.Run("Test RevokeCampaign - Fail Tokens", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errRevokeCampaign.Error())
+	})
+}
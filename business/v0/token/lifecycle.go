@@ -0,0 +1,44 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . eventPublisher
+type eventPublisher interface {
+	Publish(ctx context.Context, event *models.TokenLifecycleEvent) error
+}
+
+var errPublishEvent = errors.New("error, publishing token lifecycle event fails")
+
+// publish tells subscribers what happened to the tokens, for the events that aren't written to the outbox
+// along with the change. Failing to publish is logged, and never fails the change itself.
+func (b *BusinessToken) publish(ctx context.Context, eventType string, refs ...models.TokenRef) {
+	now := time.Now()
+	for _, ref := range refs {
+		event := models.TokenLifecycleEvent{
+			Type:       eventType,
+			Token:      ref,
+			OccurredAt: now,
+		}
+		for _, publisher := range b.publishers {
+			if err := publisher.Publish(ctx, &event); err != nil {
+				common.GetLogger(ctx).WithFields(logrus.Fields{
+					"err":      errors.Wrap(err, errPublishEvent.Error()),
+					"event":    eventType,
+					"token_id": ref.Id,
+				}).Error("error_publish_event")
+			}
+		}
+	}
+}
+
+// tokenRef identifies the token in lifecycle events
+func tokenRef(token *models.Token) models.TokenRef {
+	return models.TokenRef{Id: token.Id, KeyPrefix: token.KeyPrefix}
+}
@@ -0,0 +1,99 @@
+package token
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/business/v0/token/tokenfakes"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+// publishedEvents returns the type and token id of every event published, in order
+func publishedEvents(fakePublisher *tokenfakes.FakeEventPublisher) [][2]interface{} {
+	events := [][2]interface{}{}
+	for i := 0; i < fakePublisher.PublishCallCount(); i++ {
+		_, event := fakePublisher.PublishArgsForCall(i)
+		events = append(events, [2]interface{}{event.Type, event.Token.Id})
+	}
+	return events
+}
+
+func TestBusinessToken_Publish_NotForOutboxEvents(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns([]string{"key-a", "key-b"}, nil)
+	fakeDataPersistence.RevokeTokenByIdReturns(&models.TokenRef{Id: 6, KeyPrefix: "inv_6a"}, nil)
+	fakeDataPersistence.ExpireTokensReturns([]models.TokenRef{{Id: 3}, {Id: 8}}, nil)
+	fakePublisher := tokenfakes.FakeEventPublisher{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil, &fakePublisher)
+	_, err := businessToken.GenerateBatch(context.Background(), &models.User{Id: 3}, &models.CreateTokenBatch{Count: 2})
+	require.NoError(t, err)
+	require.NoError(t, businessToken.RevokeById(context.Background(), 6))
+	businessToken.SweepExpired(context.Background())
+	t.Run("Test Publish - Not For Outbox Events", func(t *testing.T) {
+		assert.Equal(t, 0, fakePublisher.PublishCallCount())
+	})
+}
+
+func TestBusinessToken_Publish_ValidatedAndRedeemed(t *testing.T) {
+	tokenKey := "123456"
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 5, KeyPrefix: "12", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	fakeDataPersistence.RedeemTokenReturns(true, nil)
+	fakePublisher := tokenfakes.FakeEventPublisher{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil, &fakePublisher)
+	require.NoError(t, validateErr(businessToken, tokenKey))
+	require.NoError(t, businessToken.Redeem(context.Background(), tokenKey, &models.RequestMeta{}))
+	t.Run("Test Publish - Validated And Redeemed", func(t *testing.T) {
+		assert.Equal(t, [][2]interface{}{{models.TokenLifecycleValidated, 5}, {models.TokenLifecycleRedeemed, 5}},
+			publishedEvents(&fakePublisher))
+	})
+}
+
+func TestBusinessToken_Publish_NotOnFailedUse(t *testing.T) {
+	tokenKey := "123456"
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 5, ExpiresAt: time.Now().Add(time.Hour), Revoked: true}, nil)
+	fakePublisher := tokenfakes.FakeEventPublisher{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil, &fakePublisher)
+	assert.ErrorIs(t, validateErr(businessToken, tokenKey), ErrTokenRevoked)
+	t.Run("Test Publish - Not On Failed Use", func(t *testing.T) {
+		assert.Equal(t, 0, fakePublisher.PublishCallCount())
+	})
+}
+
+func TestBusinessToken_Publish_FailureIsOnlyLogged(t *testing.T) {
+	tokenKey := "123456"
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 6, KeyPrefix: "12", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	fakePublisher := tokenfakes.FakeEventPublisher{}
+	fakePublisher.PublishReturns(errPublishEvent)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil, &fakePublisher)
+	err := validateErr(businessToken, tokenKey)
+	t.Run("Test Publish - Failure Is Only Logged", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, [][2]interface{}{{models.TokenLifecycleValidated, 6}}, publishedEvents(&fakePublisher))
+	})
+}
+
+func TestBusinessToken_Publish_EveryPublisher(t *testing.T) {
+	tokenKey := "123456"
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 5, KeyPrefix: "12", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	failingPublisher := tokenfakes.FakeEventPublisher{}
+	failingPublisher.PublishReturns(errPublishEvent)
+	otherPublisher := tokenfakes.FakeEventPublisher{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil,
+		&failingPublisher, &otherPublisher)
+	require.NoError(t, validateErr(businessToken, tokenKey))
+	t.Run("Test Publish - Every Publisher", func(t *testing.T) {
+		assert.Equal(t, [][2]interface{}{{models.TokenLifecycleValidated, 5}}, publishedEvents(&failingPublisher))
+		assert.Equal(t, [][2]interface{}{{models.TokenLifecycleValidated, 5}}, publishedEvents(&otherPublisher))
+	})
+}
@@ -0,0 +1,109 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/data"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . purgePersistence
+type purgePersistence interface {
+	PurgeTokens(ctx context.Context, cutoff time.Time, afterId int, limit int,
+		archive func(tokens []models.ArchivedToken) error) (int64, int, error)
+}
+
+var ErrRetentionDisabled = errors.New("error, token retention is disabled, set APP_TOKEN_RETENTION_DAYS to purge")
+
+var (
+	errPurgeTokens   = errors.New("error, purging tokens fails")
+	errArchiveTokens = errors.New("error, archiving tokens fails")
+)
+
+// TokenPurger deletes tokens once they have been expired for longer than the retention period, revoked or not
+type TokenPurger struct {
+	dataLayer     purgePersistence
+	retentionDays int
+	batchSize     int
+	archivePath   string
+}
+
+// Purge deletes every token past the retention period, one batch at a time, and returns how many were deleted.
+// Batches go by id, so a batch where every token was reinstated meanwhile doesn't stop the purge.
+// Tokens are appended to the archive file first when one is configured, along with their events, so a failed
+// delete can leave a token archived twice, but never deleted without being archived.
+func (p *TokenPurger) Purge(ctx context.Context) (int64, error) {
+	if p.retentionDays < 1 {
+		return 0, ErrRetentionDisabled
+	}
+	cutoff := time.Now().AddDate(0, 0, -p.retentionDays)
+
+	var archive func(tokens []models.ArchivedToken) error
+	if p.archivePath != "" {
+		archive = p.archive
+	}
+
+	var total int64
+	afterId := 0
+	for {
+		purged, lastId, err := p.dataLayer.PurgeTokens(ctx, cutoff, afterId, p.batchSize, archive)
+		total += purged
+		if err != nil {
+			return total, errors.Wrap(err, errPurgeTokens.Error())
+		}
+		if lastId == 0 {
+			return total, nil
+		}
+		afterId = lastId
+	}
+}
+
+// PurgeExpired runs Purge as a periodic job, logging the result
+func (p *TokenPurger) PurgeExpired(ctx context.Context) {
+	logger := common.GetLogger(ctx)
+	purged, err := p.Purge(ctx)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"err":    err,
+			"purged": purged,
+		}).Error("error_purge_tokens")
+		return
+	}
+	if purged > 0 {
+		logger.WithFields(logrus.Fields{
+			"purged": purged,
+		}).Info("purge_tokens")
+	}
+}
+
+// archive appends the tokens to the archive file, and syncs it before they are deleted
+func (p *TokenPurger) archive(tokens []models.ArchivedToken) error {
+	file, err := data.OpenJSONLFile(p.archivePath)
+	if err != nil {
+		return errors.Wrap(err, errArchiveTokens.Error())
+	}
+	for i := range tokens {
+		if err = file.Write(&tokens[i]); err != nil {
+			_ = file.Close()
+			return errors.Wrap(err, errArchiveTokens.Error())
+		}
+	}
+	if err = file.Close(); err != nil {
+		return errors.Wrap(err, errArchiveTokens.Error())
+	}
+	return nil
+}
+
+// NewTokenPurger returns a purger keeping tokens for retentionDays, where 0 disables purging.
+// An empty archivePath deletes tokens without archiving them.
+func NewTokenPurger(dataLayer purgePersistence, retentionDays int, batchSize int, archivePath string) *TokenPurger {
+	return &TokenPurger{
+		dataLayer:     dataLayer,
+		retentionDays: retentionDays,
+		batchSize:     batchSize,
+		archivePath:   archivePath,
+	}
+}
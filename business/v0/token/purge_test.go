@@ -0,0 +1,177 @@
+package token
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"platform_engineer_clone/business/v0/token/tokenfakes"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/signing"
+	"testing"
+	"time"
+)
+
+func TestTokenPurger_Purge_HappyPath(t *testing.T) {
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+	fakePurgePersistence.PurgeTokensReturnsOnCall(0, 500, 500, nil)
+	fakePurgePersistence.PurgeTokensReturnsOnCall(1, 120, 1240, nil)
+	fakePurgePersistence.PurgeTokensReturnsOnCall(2, 0, 0, nil)
+
+	purger := NewTokenPurger(&fakePurgePersistence, 90, 500, "")
+	purged, err := purger.Purge(context.Background())
+	t.Run("Test Purge - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(620), purged)
+		require.Equal(t, 3, fakePurgePersistence.PurgeTokensCallCount())
+
+		_, cutoff, afterId, limit, archive := fakePurgePersistence.PurgeTokensArgsForCall(0)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, -90), cutoff, time.Minute)
+		assert.Equal(t, 0, afterId)
+		assert.Equal(t, 500, limit)
+		assert.Nil(t, archive)
+
+		_, _, afterId, _, _ = fakePurgePersistence.PurgeTokensArgsForCall(2)
+		assert.Equal(t, 1240, afterId)
+	})
+}
+
+func TestTokenPurger_Purge_HappyPath_PastReinstatedBatch(t *testing.T) {
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+	fakePurgePersistence.PurgeTokensReturnsOnCall(0, 0, 500, nil)
+	fakePurgePersistence.PurgeTokensReturnsOnCall(1, 30, 700, nil)
+	fakePurgePersistence.PurgeTokensReturnsOnCall(2, 0, 0, nil)
+
+	purger := NewTokenPurger(&fakePurgePersistence, 90, 500, "")
+	purged, err := purger.Purge(context.Background())
+	t.Run("Test Purge - Happy Path Past Reinstated Batch", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), purged)
+		require.Equal(t, 3, fakePurgePersistence.PurgeTokensCallCount())
+
+		_, _, afterId, _, _ := fakePurgePersistence.PurgeTokensArgsForCall(1)
+		assert.Equal(t, 500, afterId)
+	})
+}
+
+func TestTokenPurger_Purge_HappyPath_Archive(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "tokens.jsonl")
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+	fakePurgePersistence.PurgeTokensStub = func(ctx context.Context, cutoff time.Time, afterId int, limit int,
+		archive func(tokens []models.ArchivedToken) error) (int64, int, error) {
+		id := afterId + 1
+		if id > 2 {
+			return 0, 0, nil
+		}
+		tokens := []models.ArchivedToken{{
+			Token:  models.Token{Id: id, KeyPrefix: "inv_3k"},
+			Events: []models.TokenEvent{{Id: 10 + id, Action: models.TokenEventActionValidate}},
+		}}
+		return 1, id, archive(tokens)
+	}
+
+	purger := NewTokenPurger(&fakePurgePersistence, 90, 1, archivePath)
+	purged, err := purger.Purge(context.Background())
+	t.Run("Test Purge - Happy Path Archive", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+
+		file, err := os.Open(archivePath)
+		require.NoError(t, err)
+		defer file.Close()
+
+		var ids, eventIds []int
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var token models.ArchivedToken
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &token))
+			ids = append(ids, token.Id)
+			for _, event := range token.Events {
+				eventIds = append(eventIds, event.Id)
+			}
+		}
+		assert.Equal(t, []int{1, 2}, ids)
+		assert.Equal(t, []int{11, 12}, eventIds)
+	})
+}
+
+func TestTokenPurger_Purge_FailPath(t *testing.T) {
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+	fakePurgePersistence.PurgeTokensReturnsOnCall(0, 500, 500, nil)
+	fakePurgePersistence.PurgeTokensReturnsOnCall(1, 0, 0, fmt.Errorf("lock wait timeout"))
+
+	purger := NewTokenPurger(&fakePurgePersistence, 90, 500, "")
+	purged, err := purger.Purge(context.Background())
+	t.Run("Test Purge - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errPurgeTokens.Error())
+		assert.Equal(t, int64(500), purged)
+	})
+}
+
+func TestTokenPurger_Purge_FailPath_RetentionDisabled(t *testing.T) {
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+
+	purger := NewTokenPurger(&fakePurgePersistence, 0, 500, "")
+	_, err := purger.Purge(context.Background())
+	t.Run("Test Purge - Fail Path Retention Disabled", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrRetentionDisabled)
+		assert.Equal(t, 0, fakePurgePersistence.PurgeTokensCallCount())
+	})
+}
+
+func TestTokenPurger_Purge_Signed_RevokedUntilExpired(t *testing.T) {
+	signer := testSigner(t)
+	now := time.Now()
+	revoked, err := signer.Sign(signing.Claims{Id: 3, ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	// the fakes stand in for the token table, purging and listing revoked rows like the database does
+	tokens := []models.Token{
+		{Id: 3, Revoked: true, CreatedAt: now.AddDate(0, 0, -120), ExpiresAt: now.Add(time.Hour)},
+		{Id: 4, Revoked: true, CreatedAt: now.AddDate(0, 0, -200), ExpiresAt: now.AddDate(0, 0, -100)},
+	}
+	fakePurgePersistence := tokenfakes.FakePurgePersistence{}
+	fakePurgePersistence.PurgeTokensStub = func(ctx context.Context, cutoff time.Time, afterId int, limit int,
+		archive func(tokens []models.ArchivedToken) error) (int64, int, error) {
+		var kept []models.Token
+		lastId := 0
+		for _, token := range tokens {
+			if token.Id > afterId {
+				lastId = token.Id
+				if token.CreatedAt.Before(cutoff) && token.ExpiresAt.Before(cutoff) {
+					continue
+				}
+			}
+			kept = append(kept, token)
+		}
+		purged := int64(len(tokens) - len(kept))
+		tokens = kept
+		return purged, lastId, nil
+	}
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetRevokedTokenIdsStub = func(ctx context.Context, now time.Time) ([]int, error) {
+		var ids []int
+		for _, token := range tokens {
+			if token.Revoked && token.ExpiresAt.After(now) {
+				ids = append(ids, token.Id)
+			}
+		}
+		return ids, nil
+	}
+
+	purged, err := NewTokenPurger(&fakePurgePersistence, 90, 500, "").Purge(context.Background())
+	require.NoError(t, err)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	businessToken.RefreshRevoked(context.Background())
+	t.Run("Test Purge - Signed Revoked Until Expired", func(t *testing.T) {
+		assert.Equal(t, int64(1), purged)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, 3, tokens[0].Id)
+		assert.ErrorIs(t, validateErr(businessToken, revoked), ErrTokenRevoked)
+	})
+}
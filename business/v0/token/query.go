@@ -0,0 +1,115 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/date_handling"
+	"platform_engineer_clone/src/utils/error_handling"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// ErrInvalidTokenFilter is caused by the request, and carries the status and code the API renders it with
+var ErrInvalidTokenFilter = error_handling.BadRequest("invalid_token_filter", "error, invalid token filter")
+
+// tokenQuery validates the filter, and converts it into a query for the persistence layer.
+// The query fetches one token past the page size, to tell whether there is a next page.
+func tokenQuery(filter *models.TokenFilter) (*models.TokenQuery, int, error) {
+	if filter == nil {
+		filter = &models.TokenFilter{}
+	}
+	query := models.TokenQuery{
+		Label:          filter.Label,
+		RecipientEmail: filter.RecipientEmail,
+		Search:         filter.Search,
+		CreatedBy:      filter.CreatedBy,
+		CampaignId:     filter.CampaignId,
+	}
+
+	switch filter.Status {
+	case "", models.TokenStatusActive, models.TokenStatusRevoked, models.TokenStatusExpired:
+		query.Status = filter.Status
+	default:
+		return nil, 0, ErrInvalidTokenFilter.Detail(fmt.Sprintf("unknown status %q", filter.Status))
+	}
+
+	dateRanges := []struct {
+		name  string
+		value string
+		dest  **time.Time
+	}{
+		{"created_after", filter.CreatedAfter, &query.CreatedAfter},
+		{"created_before", filter.CreatedBefore, &query.CreatedBefore},
+		{"expires_after", filter.ExpiresAfter, &query.ExpiresAfter},
+		{"expires_before", filter.ExpiresBefore, &query.ExpiresBefore},
+	}
+	for _, dateRange := range dateRanges {
+		if dateRange.value == "" {
+			continue
+		}
+		t, err := date_handling.ParseDateOrTimestamp(dateRange.value)
+		if err != nil {
+			return nil, 0, ErrInvalidTokenFilter.Detail(
+				fmt.Sprintf("%v must be YYYY-MM-DD or an RFC 3339 timestamp", dateRange.name))
+		}
+		*dateRange.dest = &t
+	}
+
+	query.SortBy = models.TokenSortCreatedAt
+	query.SortDesc = true
+	if filter.Sort != "" {
+		query.SortDesc = strings.HasPrefix(filter.Sort, "-")
+		query.SortBy = strings.TrimPrefix(filter.Sort, "-")
+		if query.SortBy != models.TokenSortCreatedAt && query.SortBy != models.TokenSortExpiresAt {
+			return nil, 0, ErrInvalidTokenFilter.Detail(fmt.Sprintf("unknown sort %q", filter.Sort))
+		}
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, 0, ErrInvalidTokenFilter.Detail("malformed cursor")
+		}
+		query.After = cursor
+	}
+
+	pageSize := filter.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize < 0 || pageSize > maxPageSize {
+		return nil, 0, ErrInvalidTokenFilter.Detail(fmt.Sprintf("limit must be between 1 and %v", maxPageSize))
+	}
+	query.Limit = pageSize + 1
+
+	return &query, pageSize, nil
+}
+
+// encodeCursor returns an opaque cursor, continuing after the token in the query's sort order
+func encodeCursor(query *models.TokenQuery, token *models.Token) string {
+	cursor := models.TokenCursor{Value: token.CreatedAt, Id: token.Id}
+	if query.SortBy == models.TokenSortExpiresAt {
+		cursor.Value = token.ExpiresAt
+	}
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*models.TokenCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cursor models.TokenCursor
+	if err = json.Unmarshal(b, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
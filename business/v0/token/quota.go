@@ -0,0 +1,52 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"platform_engineer_clone/models"
+	"time"
+)
+
+var (
+	errGetTokenQuota     = errors.New("error, getting token quota fails")
+	errCountActiveTokens = errors.New("error, counting active tokens fails")
+)
+
+// activeQuota returns the user's cap on active tokens, which is their own when they have one,
+// and the configured one otherwise. It returns nil when the user's tokens aren't capped.
+func (b *BusinessToken) activeQuota(ctx context.Context, userId int) (*int, error) {
+	quota, err := b.dataLayer.GetUserTokenQuota(ctx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTokenQuota.Error())
+	}
+	if quota != nil {
+		return quota, nil
+	}
+	if b.activeTokenQuota == 0 {
+		return nil, nil
+	}
+	defaultQuota := b.activeTokenQuota
+	return &defaultQuota, nil
+}
+
+// GetQuota returns how many active tokens the user has, out of their quota
+func (b *BusinessToken) GetQuota(ctx context.Context, user *models.User) (*models.TokenQuota, error) {
+	quota, err := b.activeQuota(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	used, err := b.dataLayer.CountActiveTokens(ctx, user.Id, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, errCountActiveTokens.Error())
+	}
+
+	tokenQuota := models.TokenQuota{Limit: quota, Used: used}
+	if quota != nil {
+		remaining := *quota - used
+		if remaining < 0 {
+			remaining = 0
+		}
+		tokenQuota.Remaining = &remaining
+	}
+	return &tokenQuota, nil
+}
@@ -0,0 +1,135 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/business/v0/token/tokenfakes"
+	"platform_engineer_clone/models"
+	"testing"
+	"time"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestBusinessToken_Generate_HappyPath_DefaultQuota(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
+	t.Run("Test Generate - Happy Path Default Quota", func(t *testing.T) {
+		require.NoError(t, err)
+		_, userId := fakeDataPersistence.GetUserTokenQuotaArgsForCall(0)
+		assert.Equal(t, 3, userId)
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Equal(t, intPtr(100), newToken.ActiveQuota)
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_UserQuotaOverrides(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetUserTokenQuotaReturns(intPtr(5), nil)
+	fakeDataPersistence.GenerateBatchReturns([]string{"1234", "5678"}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateBatch(context.Background(), &models.User{Id: 3}, &models.CreateTokenBatch{Count: 2})
+	t.Run("Test GenerateBatch - Happy Path User Quota Overrides", func(t *testing.T) {
+		require.NoError(t, err)
+		_, newToken, count, _, _ := fakeDataPersistence.GenerateBatchArgsForCall(0)
+		assert.Equal(t, intPtr(5), newToken.ActiveQuota)
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_Uncapped(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
+	t.Run("Test Generate - Happy Path Uncapped", func(t *testing.T) {
+		require.NoError(t, err)
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Nil(t, newToken.ActiveQuota)
+	})
+}
+
+func TestBusinessToken_Generate_Fail_QuotaExceeded(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("", models.ErrTokenQuotaExceeded.Detail("100 of 100 active tokens in use"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
+	t.Run("Test Generate - Fail Quota Exceeded", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrTokenQuotaExceeded)
+	})
+}
+
+func TestBusinessToken_Generate_Fail_GetQuota(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetUserTokenQuotaReturns(nil, errors.New("connection lost"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
+	t.Run("Test Generate - Fail Get Quota", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errGetTokenQuota.Error())
+		assert.Equal(t, 0, fakeDataPersistence.GenerateCallCount())
+	})
+}
+
+func TestBusinessToken_GetQuota_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.CountActiveTokensReturns(42, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	quota, err := businessToken.GetQuota(context.Background(), &models.User{Id: 3})
+	t.Run("Test GetQuota - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, &models.TokenQuota{Limit: intPtr(100), Used: 42, Remaining: intPtr(58)}, quota)
+		_, userId, now := fakeDataPersistence.CountActiveTokensArgsForCall(0)
+		assert.Equal(t, 3, userId)
+		assert.WithinDuration(t, time.Now(), now, time.Minute)
+	})
+}
+
+func TestBusinessToken_GetQuota_HappyPath_OverQuota(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetUserTokenQuotaReturns(intPtr(10), nil)
+	fakeDataPersistence.CountActiveTokensReturns(42, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	quota, err := businessToken.GetQuota(context.Background(), &models.User{Id: 3})
+	t.Run("Test GetQuota - Happy Path Lowered Below Usage", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, &models.TokenQuota{Limit: intPtr(10), Used: 42, Remaining: intPtr(0)}, quota)
+	})
+}
+
+func TestBusinessToken_GetQuota_HappyPath_Uncapped(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.CountActiveTokensReturns(42, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	quota, err := businessToken.GetQuota(context.Background(), &models.User{Id: 3})
+	t.Run("Test GetQuota - Happy Path Uncapped", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, &models.TokenQuota{Used: 42}, quota)
+	})
+}
+
+func TestBusinessToken_GetQuota_Fail_Count(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.CountActiveTokensReturns(0, errors.New("connection lost"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 100, testKeyFormat, true, nil)
+	_, err := businessToken.GetQuota(context.Background(), &models.User{Id: 3})
+	t.Run("Test GetQuota - Fail Count", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errCountActiveTokens.Error())
+	})
+}
@@ -0,0 +1,111 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/signing"
+	"sync"
+	"time"
+)
+
+var errRefreshRevoked = errors.New("error, refreshing revoked tokens fails")
+
+// revocationSet holds the ids of revoked signed tokens, so they can be rejected without a lookup
+type revocationSet struct {
+	mu  sync.RWMutex
+	ids map[int]struct{}
+}
+
+func newRevocationSet() *revocationSet {
+	return &revocationSet{ids: map[int]struct{}{}}
+}
+
+func (r *revocationSet) has(id int) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.ids[id]
+	return ok
+}
+
+func (r *revocationSet) add(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids[id] = struct{}{}
+}
+
+func (r *revocationSet) remove(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.ids, id)
+}
+
+func (r *revocationSet) replace(ids []int) {
+	set := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = set
+}
+
+// RefreshRevoked reloads the revoked tokens that haven't expired yet.
+// Revocations made through this instance apply immediately, and those made elsewhere
+// apply once the next refresh picks them up. Failures are logged, keeping the previous set.
+func (b *BusinessToken) RefreshRevoked(ctx context.Context) {
+	ids, err := b.dataLayer.GetRevokedTokenIds(ctx, time.Now())
+	if err != nil {
+		common.GetLogger(ctx).WithFields(logrus.Fields{
+			"err": errors.Wrap(err, errRefreshRevoked.Error()),
+		}).Error("error_refresh_revoked")
+		return
+	}
+	b.revoked.replace(ids)
+}
+
+// isSigned reports whether the key is a signed token, which only happens in the signed token mode
+func (b *BusinessToken) isSigned(key string) bool {
+	return b.signer != nil && b.signer.IsSigned(key)
+}
+
+// signedClaims verifies the signed token. Tokens signed by a retired key are rejected as malformed.
+func (b *BusinessToken) signedClaims(key string) (*signing.Claims, error) {
+	claims, err := b.signer.Verify(key)
+	if err != nil {
+		return nil, ErrMalformedKey.Detail(err.Error())
+	}
+	return claims, nil
+}
+
+// validateSigned checks the signed token offline, against its claims and the revocation set.
+// Remaining uses aren't known without a lookup, so they are only enforced when redeeming,
+// and offline validations aren't recorded in the token's audit trail.
+func (b *BusinessToken) validateSigned(ctx context.Context, key string, scope string) (*models.TokenValidation, error) {
+	claims, err := b.signedClaims(key)
+	if err != nil {
+		return nil, err
+	}
+	if b.revoked.has(claims.Id) {
+		return nil, ErrTokenRevoked
+	}
+	if time.Now().After(claims.Expiry()) {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotYetActive(time.Now()) {
+		return nil, ErrTokenNotYetActive.Detail(
+			fmt.Sprintf("usable from %v", time.Unix(claims.NotBefore, 0).UTC().Format(time.RFC3339)))
+	}
+	scopes := claims.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	if err = checkScope(scopes, scope); err != nil {
+		return nil, err
+	}
+	b.publish(ctx, models.TokenLifecycleValidated, models.TokenRef{Id: claims.Id, KeyPrefix: b.keyFormat.VisiblePrefix(key)})
+	return &models.TokenValidation{Valid: true, ExpiresAt: claims.Expiry(), Scopes: scopes}, nil
+}
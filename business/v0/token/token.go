@@ -7,100 +7,612 @@ import (
 	"github.com/sirupsen/logrus"
 	"platform_engineer_clone/models"
 	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/error_handling"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
+	"platform_engineer_clone/src/utils/validation"
+	"strings"
 	"time"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . dataPersistence
 type dataPersistence interface {
-	GetAll(ctx context.Context) ([]models.Token, error)
-	Generate(ctx context.Context, createdBy int, randomCharMinLength int, randomCharMaxLength int) (string, error)
+	GetAll(ctx context.Context, query *models.TokenQuery) ([]models.Token, error)
+	IterateAll(ctx context.Context, query *models.TokenQuery, each func(token *models.Token) error) error
+	Generate(ctx context.Context, newToken *models.NewToken, randomCharMinLength int, randomCharMaxLength int) (string, error)
+	GenerateBatch(ctx context.Context, newToken *models.NewToken, count int, randomCharMinLength int, randomCharMaxLength int) ([]string, error)
 	GetToken(ctx context.Context, key string) (*models.Token, error)
-	UpdateTokenToExpired(ctx context.Context, token *models.Token) error
-	RevokeToken(ctx context.Context, key string) error
+	GetTokenById(ctx context.Context, id int) (*models.Token, error)
+	ExpireTokens(ctx context.Context, now time.Time, limit int) ([]models.TokenRef, error)
+	GetRevokedTokenIds(ctx context.Context, now time.Time) ([]int, error)
+	RevokeToken(ctx context.Context, key string) (*models.TokenRef, error)
+	RevokeTokenById(ctx context.Context, id int) (*models.TokenRef, error)
+	UpdateToken(ctx context.Context, id int, changes *models.TokenChanges) error
+	RedeemToken(ctx context.Context, id int) (bool, error)
+	CreateTokenEvent(ctx context.Context, event *models.NewTokenEvent) error
+	GetTokenEvents(ctx context.Context, tokenId int) ([]models.TokenEvent, error)
+	GetTokenScopes(ctx context.Context, tokenId int) ([]string, error)
+	GetUserTokenQuota(ctx context.Context, userId int) (*int, error)
+	CountActiveTokens(ctx context.Context, userId int, now time.Time) (int, error)
+	RevokeCampaign(ctx context.Context, campaignId int) error
+	RevokeCampaignTokens(ctx context.Context, campaignId int, afterId int, limit int) ([]models.TokenRef, error)
 }
 
 type BusinessToken struct {
 	dataLayer           dataPersistence
 	tokenDaysValid      int
+	tokenMinTTL         time.Duration
+	tokenMaxTTL         time.Duration
 	randomCharMinLength int
 	randomCharMaxLength int
+	tokenBatchMaxCount  int
+	sweepBatchSize      int
+	activeTokenQuota    int
+	keyFormat           keygen.Format
+	acceptLegacyKeys    bool
+	signer              *signing.Signer
+	revoked             *revocationSet
+	publishers          []eventPublisher
 }
 
+// These errors are caused by the request, and carry the status and code the API renders them with
 var (
-	errGenerateToken          = errors.New("error generating token")
-	errGetToken               = errors.New("error, Get fails")
-	errGetTokens              = errors.New("error, get all fails")
-	errRevokeToken            = errors.New("error revoking token")
-	errTokenRevoked           = errors.New("error, token is revoked")
-	errTokenExpired           = errors.New("error, token has already expired")
-	errTokenDeterminedExpired = errors.New("error, token has already expired")
-	errUpdateTokenToExpired   = errors.New("error, updating token to expired failed")
+	ErrExpiresInAndExpiresAt = error_handling.BadRequest("expires_in_and_expires_at", "error, only one of expires_in or expires_at can be provided")
+	ErrInvalidExpiresIn      = error_handling.BadRequest("invalid_expires_in", "error, expires_in must be a valid duration e.g. 72h")
+	ErrTokenTTLOutOfBounds   = error_handling.BadRequest("ttl_out_of_bounds", "error, token expiry is outside the allowed ttl")
+	ErrInvalidMaxUses        = error_handling.BadRequest("invalid_max_uses", "error, max_uses must be at least 1")
+	ErrInvalidTokenParams    = error_handling.BadRequest("invalid_token_params", "error, invalid token params")
+	ErrInvalidBatchCount     = error_handling.BadRequest("invalid_batch_count", "error, invalid batch count")
+	ErrTokenExhausted        = error_handling.Gone(models.TokenInvalidReasonExhausted, "error, token has no uses remaining")
+	ErrTokenRevoked          = error_handling.Gone(models.TokenInvalidReasonRevoked, "error, token is revoked")
+	ErrTokenExpired          = error_handling.Gone(models.TokenInvalidReasonExpired, "error, token has already expired")
+	ErrMalformedKey          = error_handling.BadRequest(models.TokenInvalidReasonMalformed, "error, malformed token key")
+	ErrExtendByAndExpiresAt  = error_handling.BadRequest("extend_by_and_expires_at", "error, only one of extend_by or expires_at can be provided")
+	ErrInvalidExtendBy       = error_handling.BadRequest("invalid_extend_by", "error, extend_by must be a valid duration e.g. 48h")
+	ErrNoTokenChanges        = error_handling.BadRequest("no_token_changes", "error, at least one of extend_by, expires_at or revoked must be provided")
+	ErrSignedTokenExpiry     = error_handling.BadRequest("signed_token_expiry", "error, signed tokens embed their expiry, so it can't be changed")
+	ErrMissingScope          = error_handling.Forbidden(models.TokenInvalidReasonMissingScope, "error, token does not carry the scope")
+	ErrTokenNotYetActive     = error_handling.Forbidden(models.TokenInvalidReasonNotYetActive, "error, token is not active yet")
+	ErrNotBeforeAfterExpiry  = error_handling.BadRequest("not_before_after_expiry", "error, not_before must be before the token expires")
 )
 
-func (b *BusinessToken) GetAll(ctx context.Context) ([]models.Token, error) {
-	tokens, err := b.dataLayer.GetAll(ctx)
+var (
+	errGenerateToken       = errors.New("error generating token")
+	errGenerateTokenBatch  = errors.New("error generating token batch")
+	errGetToken            = errors.New("error, Get fails")
+	errGetTokens           = errors.New("error, get all fails")
+	errExportTokens        = errors.New("error, export fails")
+	errGetTokenEvents      = errors.New("error, get token events fails")
+	errGetTokenScopes      = errors.New("error, get token scopes fails")
+	errCreateTokenEvent    = errors.New("error, recording token event fails")
+	errValidateTokenParams = errors.New("error, validating token params fails")
+	errRedeemToken         = errors.New("error redeeming token")
+	errRevokeToken         = errors.New("error revoking token")
+	errUpdateToken         = errors.New("error updating token")
+	errExpireTokens        = errors.New("error, flagging expired tokens fails")
+)
+
+// GetAll returns a page of the tokens matching the filter, newest first unless sorted otherwise
+func (b *BusinessToken) GetAll(ctx context.Context, filter *models.TokenFilter) (*models.TokenPage, error) {
+	query, pageSize, err := tokenQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := b.dataLayer.GetAll(ctx, query)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetTokens.Error())
 	}
-	return tokens, nil
+
+	page := models.TokenPage{Tokens: tokens}
+	if len(tokens) > pageSize {
+		page.Tokens = tokens[:pageSize]
+		page.NextCursor = encodeCursor(query, &page.Tokens[pageSize-1])
+	}
+	return &page, nil
 }
 
-func (b *BusinessToken) Generate(ctx context.Context, user *models.User) (string, error) {
-	tokenKey, err := b.dataLayer.Generate(ctx, user.Id, b.randomCharMinLength, b.randomCharMaxLength)
+// Export validates the filter up front, and returns an iterator over every matching token.
+// Paging does not apply, so any limit or cursor in the filter is ignored.
+func (b *BusinessToken) Export(ctx context.Context, filter *models.TokenFilter) (models.TokenIterator, error) {
+	if filter != nil {
+		unpaged := *filter
+		unpaged.Limit = 0
+		unpaged.Cursor = ""
+		filter = &unpaged
+	}
+	query, _, err := tokenQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = 0
+
+	return func(each func(token *models.Token) error) error {
+		err := b.dataLayer.IterateAll(ctx, query, each)
+		if err != nil {
+			return errors.Wrap(err, errExportTokens.Error())
+		}
+		return nil
+	}, nil
+}
+
+// expiresAt resolves the token's expiry from the request, falling back to the configured days valid.
+// The resulting ttl must be within the configured min and max ttl.
+func (b *BusinessToken) expiresAt(now time.Time, params *models.CreateToken) (time.Time, error) {
+	expiresAt := now.AddDate(0, 0, b.tokenDaysValid)
+	if params != nil {
+		if params.ExpiresIn != "" && params.ExpiresAt != nil {
+			return time.Time{}, ErrExpiresInAndExpiresAt
+		}
+		if params.ExpiresIn != "" {
+			expiresIn, err := time.ParseDuration(params.ExpiresIn)
+			if err != nil {
+				return time.Time{}, ErrInvalidExpiresIn
+			}
+			expiresAt = now.Add(expiresIn)
+		}
+		if params.ExpiresAt != nil {
+			expiresAt = *params.ExpiresAt
+		}
+	}
+
+	ttl := expiresAt.Sub(now)
+	if ttl < b.tokenMinTTL || ttl > b.tokenMaxTTL {
+		return time.Time{}, ErrTokenTTLOutOfBounds.Detail(
+			fmt.Sprintf("ttl must be between %v and %v", b.tokenMinTTL, b.tokenMaxTTL))
+	}
+	return expiresAt, nil
+}
+
+// newToken validates the creation params, and resolves the values to persist as of now
+func (b *BusinessToken) newToken(now time.Time, user *models.User, params *models.CreateToken) (*models.NewToken, error) {
+	expiresAt, err := b.expiresAt(now, params)
+	if err != nil {
+		return nil, err
+	}
+
+	newToken := models.NewToken{
+		CreatedBy: user.Id,
+		ExpiresAt: expiresAt,
+	}
+	if params != nil {
+		if params.MaxUses != nil && *params.MaxUses < 1 {
+			return nil, ErrInvalidMaxUses
+		}
+		errs, err := validation.ValidateStructParams(params)
+		if err != nil {
+			return nil, errors.Wrap(err, errValidateTokenParams.Error())
+		}
+		if len(errs) > 0 {
+			return nil, ErrInvalidTokenParams.Detail(strings.Join(errs, ","))
+		}
+		newToken.MaxUses = params.MaxUses
+		newToken.Label = params.Label
+		newToken.Note = params.Note
+		newToken.RecipientEmail = params.RecipientEmail
+		newToken.Scopes = params.Scopes
+		if params.NotBefore != nil && !params.NotBefore.Before(expiresAt) {
+			return nil, ErrNotBeforeAfterExpiry
+		}
+		newToken.NotBefore = params.NotBefore
+	}
+	return &newToken, nil
+}
+
+// Generate creates a token, unless it would take the user past their quota of active tokens
+func (b *BusinessToken) Generate(ctx context.Context, user *models.User, params *models.CreateToken) (string, error) {
+	newToken, err := b.newToken(time.Now(), user, params)
+	if err != nil {
+		return "", err
+	}
+	newToken.ActiveQuota, err = b.activeQuota(ctx, user.Id)
+	if err != nil {
+		return "", err
+	}
+
+	tokenKey, err := b.dataLayer.Generate(ctx, newToken, b.randomCharMinLength, b.randomCharMaxLength)
 	if err != nil {
 		return "", errors.Wrap(err, errGenerateToken.Error())
 	}
 	return tokenKey, nil
 }
 
+// GenerateBatch creates params.Count tokens sharing the same options, all or nothing,
+// so none are created when they would take the user past their quota of active tokens
+func (b *BusinessToken) GenerateBatch(ctx context.Context, user *models.User, params *models.CreateTokenBatch) ([]string, error) {
+	if params.Count < 1 || params.Count > b.tokenBatchMaxCount {
+		return nil, ErrInvalidBatchCount.Detail(
+			fmt.Sprintf("count must be between 1 and %v", b.tokenBatchMaxCount))
+	}
+
+	newToken, err := b.newToken(time.Now(), user, &params.CreateToken)
+	if err != nil {
+		return nil, err
+	}
+	newToken.ActiveQuota, err = b.activeQuota(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenKeys, err := b.dataLayer.GenerateBatch(ctx, newToken, params.Count, b.randomCharMinLength, b.randomCharMaxLength)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateTokenBatch.Error())
+	}
+	return tokenKeys, nil
+}
+
 func (b *BusinessToken) Revoke(ctx context.Context, key string) error {
-	err := b.dataLayer.RevokeToken(ctx, key)
+	if err := b.checkKey(key); err != nil {
+		return err
+	}
+	ref, err := b.dataLayer.RevokeToken(ctx, key)
 	if err != nil {
 		return errors.Wrap(err, errRevokeToken.Error())
 	}
+	if b.isSigned(key) {
+		b.revoked.add(ref.Id)
+	}
+	return nil
+}
+
+// RevokeById revokes the token by its id
+func (b *BusinessToken) RevokeById(ctx context.Context, id int) error {
+	_, err := b.dataLayer.RevokeTokenById(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errRevokeToken.Error())
+	}
+	if b.signer != nil {
+		b.revoked.add(id)
+	}
+	return nil
+}
+
+// Update changes the token's expiry, or revokes and reinstates it, and returns the updated token.
+// The new expiry must leave at least the min ttl from now, and keep the token's lifetime within the max ttl.
+// Reinstating an expired token doesn't make it usable again, unless its expiry is extended too.
+func (b *BusinessToken) Update(ctx context.Context, key string, params *models.UpdateToken) (*models.Token, error) {
+	if err := b.checkKey(key); err != nil {
+		return nil, err
+	}
+	if err := checkUpdateParams(params); err != nil {
+		return nil, err
+	}
+	if changesExpiry(params) && b.isSigned(key) {
+		return nil, ErrSignedTokenExpiry
+	}
+
+	token, err := b.dataLayer.GetToken(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetToken.Error())
+	}
+	return b.update(ctx, token, params)
+}
+
+// UpdateById updates the token by its id, as Update does.
+// A token's key can't be told from its id, so in the signed token mode, where the expiry is part of
+// the key, expiry changes are rejected.
+func (b *BusinessToken) UpdateById(ctx context.Context, id int, params *models.UpdateToken) (*models.Token, error) {
+	if err := checkUpdateParams(params); err != nil {
+		return nil, err
+	}
+	if changesExpiry(params) && b.signer != nil {
+		return nil, ErrSignedTokenExpiry
+	}
+
+	token, err := b.dataLayer.GetTokenById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetToken.Error())
+	}
+	return b.update(ctx, token, params)
+}
+
+// update applies the checked params to the token, and returns the updated token
+func (b *BusinessToken) update(ctx context.Context, token *models.Token, params *models.UpdateToken) (*models.Token, error) {
+	changes := models.TokenChanges{Revoked: params.Revoked}
+	if changesExpiry(params) {
+		expiresAt, err := b.updatedExpiresAt(time.Now(), token, params)
+		if err != nil {
+			return nil, err
+		}
+		changes.ExpiresAt = &expiresAt
+	}
+
+	err := b.dataLayer.UpdateToken(ctx, token.Id, &changes)
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateToken.Error())
+	}
+	if b.signer != nil && params.Revoked != nil {
+		if *params.Revoked {
+			b.revoked.add(token.Id)
+		} else {
+			b.revoked.remove(token.Id)
+		}
+	}
+
+	token, err = b.dataLayer.GetTokenById(ctx, token.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetToken.Error())
+	}
+	return token, nil
+}
+
+func checkUpdateParams(params *models.UpdateToken) error {
+	if params == nil || (params.ExtendBy == "" && params.ExpiresAt == nil && params.Revoked == nil) {
+		return ErrNoTokenChanges
+	}
+	if params.ExtendBy != "" && params.ExpiresAt != nil {
+		return ErrExtendByAndExpiresAt
+	}
 	return nil
 }
 
-func (b *BusinessToken) Validate(ctx context.Context, key string) error {
+func changesExpiry(params *models.UpdateToken) bool {
+	return params.ExtendBy != "" || params.ExpiresAt != nil
+}
+
+// updatedExpiresAt resolves the token's new expiry from the request, checked against the ttl bounds
+func (b *BusinessToken) updatedExpiresAt(now time.Time, token *models.Token, params *models.UpdateToken) (time.Time, error) {
+	expiresAt := token.ExpiresAt
+	if params.ExtendBy != "" {
+		extendBy, err := time.ParseDuration(params.ExtendBy)
+		if err != nil {
+			return time.Time{}, ErrInvalidExtendBy
+		}
+		expiresAt = expiresAt.Add(extendBy)
+	}
+	if params.ExpiresAt != nil {
+		expiresAt = *params.ExpiresAt
+	}
+
+	if expiresAt.Sub(now) < b.tokenMinTTL || expiresAt.Sub(token.CreatedAt) > b.tokenMaxTTL {
+		return time.Time{}, ErrTokenTTLOutOfBounds.Detail(
+			fmt.Sprintf("expiry must be at least %v from now, and at most %v after the token was created",
+				b.tokenMinTTL, b.tokenMaxTTL))
+	}
+	if token.NotBefore.Valid && !token.NotBefore.Time.Before(expiresAt) {
+		return time.Time{}, ErrNotBeforeAfterExpiry
+	}
+	return expiresAt, nil
+}
+
+// GetEvents returns the audit trail of the token's validations and redemptions
+func (b *BusinessToken) GetEvents(ctx context.Context, key string) ([]models.TokenEvent, error) {
+	if err := b.checkKey(key); err != nil {
+		return nil, err
+	}
+	token, err := b.dataLayer.GetToken(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetToken.Error())
+	}
+	return b.events(ctx, token.Id)
+}
+
+// GetEventsById returns the token's audit trail by its id
+func (b *BusinessToken) GetEventsById(ctx context.Context, id int) ([]models.TokenEvent, error) {
+	token, err := b.dataLayer.GetTokenById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetToken.Error())
+	}
+	return b.events(ctx, token.Id)
+}
+
+func (b *BusinessToken) events(ctx context.Context, tokenId int) ([]models.TokenEvent, error) {
+	events, err := b.dataLayer.GetTokenEvents(ctx, tokenId)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTokenEvents.Error())
+	}
+	return events, nil
+}
+
+// Validate checks the token is usable, and carries the scope unless it is empty, and returns the token's scopes.
+// Malformed keys are rejected before any lookup, and aren't recorded.
+// Signed tokens are validated offline, without a lookup.
+func (b *BusinessToken) Validate(ctx context.Context, key string, scope string,
+	meta *models.RequestMeta) (*models.TokenValidation, error) {
+	if b.isSigned(key) {
+		return b.validateSigned(ctx, key, scope)
+	}
+	if err := b.checkKey(key); err != nil {
+		return nil, err
+	}
+	token, err := b.usableToken(ctx, key)
+	var scopes []string
+	if err == nil {
+		scopes, err = b.dataLayer.GetTokenScopes(ctx, token.Id)
+		if err != nil {
+			err = errors.Wrap(err, errGetTokenScopes.Error())
+		} else {
+			err = checkScope(scopes, scope)
+		}
+	}
+	b.recordEvent(ctx, models.TokenEventActionValidate, token, err, meta)
+	if err != nil {
+		return nil, err
+	}
+	b.publish(ctx, models.TokenLifecycleValidated, tokenRef(token))
+	return &models.TokenValidation{Valid: true, ExpiresAt: token.ExpiresAt, Scopes: scopes}, nil
+}
+
+// checkScope returns ErrMissingScope unless the scope is empty, or one of the token's scopes
+func checkScope(scopes []string, scope string) error {
+	if scope == "" {
+		return nil
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return nil
+		}
+	}
+	return ErrMissingScope.Detail(fmt.Sprintf("%q", scope))
+}
+
+// Redeem consumes one use of the token. Tokens without max uses can be redeemed indefinitely.
+func (b *BusinessToken) Redeem(ctx context.Context, key string, meta *models.RequestMeta) error {
+	if err := b.checkKey(key); err != nil {
+		return err
+	}
+	token, err := b.usableToken(ctx, key)
+	if err == nil {
+		var redeemed bool
+		redeemed, err = b.dataLayer.RedeemToken(ctx, token.Id)
+		if err != nil {
+			err = errors.Wrap(err, errRedeemToken.Error())
+		} else if !redeemed {
+			err = ErrTokenExhausted
+		}
+	}
+	b.recordEvent(ctx, models.TokenEventActionRedeem, token, err, meta)
+	if err == nil {
+		b.publish(ctx, models.TokenLifecycleRedeemed, tokenRef(token))
+	}
+	return err
+}
+
+// eventOutcome maps the result of using a token to the outcome recorded in its audit trail
+func eventOutcome(err error) string {
+	switch {
+	case err == nil:
+		return models.TokenEventOutcomeValid
+	case errors.Is(err, models.ErrNotFound):
+		return models.TokenEventOutcomeNotFound
+	case errors.Is(err, ErrTokenRevoked):
+		return models.TokenEventOutcomeRevoked
+	case errors.Is(err, ErrTokenExpired):
+		return models.TokenEventOutcomeExpired
+	case errors.Is(err, ErrTokenExhausted):
+		return models.TokenEventOutcomeExhausted
+	case errors.Is(err, ErrMissingScope):
+		return models.TokenEventOutcomeMissingScope
+	case errors.Is(err, ErrTokenNotYetActive):
+		return models.TokenEventOutcomeNotYetActive
+	default:
+		return models.TokenEventOutcomeError
+	}
+}
+
+// recordEvent adds the token use to its audit trail.
+// Failing to record is logged, and never fails the use itself.
+func (b *BusinessToken) recordEvent(ctx context.Context, action string, token *models.Token, useErr error,
+	meta *models.RequestMeta) {
+	event := models.NewTokenEvent{
+		Action:  action,
+		Outcome: eventOutcome(useErr),
+	}
+	if token != nil {
+		event.TokenId = &token.Id
+	}
+	if meta != nil {
+		event.Ip = meta.Ip
+		event.UserAgent = meta.UserAgent
+		event.RequestId = meta.RequestId
+	}
+
+	err := b.dataLayer.CreateTokenEvent(ctx, &event)
+	if err != nil {
+		common.GetLogger(ctx).WithFields(logrus.Fields{
+			"err": errors.Wrap(err, errCreateTokenEvent.Error()),
+		}).Error("error_record_event")
+	}
+}
+
+// SweepExpired flags every token past its expiry as expired, so listings stop showing them as active.
+// Tokens are flagged one batch at a time, so the sweep never locks more than a batch of rows.
+// It runs periodically in the background, and failures are logged for the next run to retry.
+func (b *BusinessToken) SweepExpired(ctx context.Context) {
+	logger := common.GetLogger(ctx)
+	now := time.Now()
+	swept := 0
+	for {
+		expired, err := b.dataLayer.ExpireTokens(ctx, now, b.sweepBatchSize)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"err":     errors.Wrap(err, errExpireTokens.Error()),
+				"expired": swept,
+			}).Error("error_sweep_expired")
+			return
+		}
+		swept += len(expired)
+		if len(expired) < b.sweepBatchSize {
+			break
+		}
+	}
+	if swept > 0 {
+		logger.WithFields(logrus.Fields{
+			"expired": swept,
+		}).Info("sweep_expired")
+	}
+}
+
+// checkKey rejects keys that can't belong to any token, without a lookup.
+// Keys from before the prefix and checksum format pass only while legacy keys are accepted.
+// Signed tokens are checked by their signature instead.
+func (b *BusinessToken) checkKey(key string) error {
+	if b.isSigned(key) {
+		_, err := b.signedClaims(key)
+		return err
+	}
+	err := b.keyFormat.Check(key)
+	if errors.Is(err, keygen.ErrLegacyKey) && b.acceptLegacyKeys {
+		return nil
+	}
+	if err != nil {
+		return ErrMalformedKey.Detail(err.Error())
+	}
+	return nil
+}
+
+// usableToken fetches the token, and checks it is active, and neither revoked, expired, nor exhausted.
+// The token is still returned alongside these errors when it was found.
+func (b *BusinessToken) usableToken(ctx context.Context, key string) (*models.Token, error) {
 	logger := common.GetLogger(ctx)
 	token, err := b.dataLayer.GetToken(ctx, key)
 	if err != nil {
-		return errors.Wrap(err, errGetToken.Error())
+		return nil, errors.Wrap(err, errGetToken.Error())
 	}
 
 	if token.Revoked {
-		return errTokenRevoked
+		return token, ErrTokenRevoked
 	}
 	if token.Expired {
 		logger.WithFields(logrus.Fields{
-			"msg": fmt.Sprintf("Token: '%v', has already expired.", token.Key),
+			"msg": fmt.Sprintf("Token: '%v', has already expired.", token.Id),
 		}).Error("error_validate")
-		return errTokenExpired
+		return token, ErrTokenExpired
 	}
+	// The expired flag is set in bulk by the sweeper, so tokens past their expiry may not be flagged yet
 	if time.Now().Unix() > token.ExpiresAt.Unix() {
-		defer func() {
-			err = b.dataLayer.UpdateTokenToExpired(ctx, token)
-			if err != nil {
-				logger.WithFields(logrus.Fields{
-					"err": err,
-				}).Error("error_validate")
-			}
-		}()
-		logger.WithFields(logrus.Fields{
-			"err": errors.Wrap(err, errUpdateTokenToExpired.Error()),
-		}).Error("error_validate")
-		return errTokenDeterminedExpired
+		return token, ErrTokenExpired
+	}
+	if token.NotBefore.Valid && time.Now().Before(token.NotBefore.Time) {
+		return token, ErrTokenNotYetActive.Detail(fmt.Sprintf("usable from %v", token.NotBefore.Time.UTC().Format(time.RFC3339)))
+	}
+	if token.MaxUses.Valid && token.UseCount >= token.MaxUses.Int {
+		return token, ErrTokenExhausted
 	}
 
-	return nil
+	return token, nil
 }
 
-func NewBusinessToken(mysqlDataPersistence dataPersistence, tokenDaysValid int, randomCharMinLength int,
-	randomCharMaxLength int) *BusinessToken {
+// NewBusinessToken returns a new *BusinessToken instance. An active token quota of 0 leaves users
+// without their own quota uncapped.
+func NewBusinessToken(mysqlDataPersistence dataPersistence, tokenDaysValid int, tokenMinTTL time.Duration,
+	tokenMaxTTL time.Duration, randomCharMinLength int, randomCharMaxLength int, tokenBatchMaxCount int,
+	sweepBatchSize int, activeTokenQuota int, keyFormat keygen.Format, acceptLegacyKeys bool, signer *signing.Signer,
+	publishers ...eventPublisher) *BusinessToken {
 	return &BusinessToken{
 		dataLayer:           mysqlDataPersistence,
 		tokenDaysValid:      tokenDaysValid,
+		tokenMinTTL:         tokenMinTTL,
+		tokenMaxTTL:         tokenMaxTTL,
 		randomCharMinLength: randomCharMinLength,
 		randomCharMaxLength: randomCharMaxLength,
+		tokenBatchMaxCount:  tokenBatchMaxCount,
+		sweepBatchSize:      sweepBatchSize,
+		activeTokenQuota:    activeTokenQuota,
+		keyFormat:           keyFormat,
+		acceptLegacyKeys:    acceptLegacyKeys,
+		signer:              signer,
+		revoked:             newRevocationSet(),
+		publishers:          publishers,
 	}
 }
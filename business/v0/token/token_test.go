@@ -5,18 +5,29 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"platform_engineer_clone/business/v0/token/tokenfakes"
 	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
 	"testing"
 	"time"
 )
 
+var testKeyFormat = keygen.Format{Prefix: "inv_"}
+
+// validateErr validates the key without a scope, returning only the error
+func validateErr(businessToken *BusinessToken, key string) error {
+	_, err := businessToken.Validate(context.Background(), key, "", &models.RequestMeta{})
+	return err
+}
+
 func TestBusinessToken_Generate_HappyPath(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GenerateReturns("1234", nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3})
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
 	t.Run("Test Generate - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
 	})
@@ -26,8 +37,8 @@ func TestBusinessToken_Generate_FailPath(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GenerateReturns("", errGenerateToken)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3})
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, nil)
 	t.Run("Test Generate - Happy Path", func(t *testing.T) {
 		require.Error(t, err)
 
@@ -37,6 +48,125 @@ func TestBusinessToken_Generate_FailPath(t *testing.T) {
 	})
 }
 
+func TestBusinessToken_Generate_HappyPath_ExpiresIn(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		ExpiresIn: "48h",
+	})
+	t.Run("Test Generate - Happy Path Expires In", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Equal(t, 3, newToken.CreatedBy)
+		assert.WithinDuration(t, time.Now().Add(48*time.Hour), newToken.ExpiresAt, time.Minute)
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_DefaultsToDaysValid(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 3, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{})
+	t.Run("Test Generate - Happy Path Defaults To Days Valid", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 3), newToken.ExpiresAt, time.Minute)
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_NotBefore(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+	notBefore := time.Now().Add(24 * time.Hour)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		NotBefore: &notBefore,
+	})
+	t.Run("Test Generate - Happy Path Not Before", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Equal(t, &notBefore, newToken.NotBefore)
+	})
+}
+
+func TestBusinessToken_Validate_FailPath_NotYetActive(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        1,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().AddDate(0, 0, 7),
+		NotBefore: null.TimeFrom(time.Now().Add(time.Hour)),
+	}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	t.Run("Test Validate - Fail Path Not Yet Active", func(t *testing.T) {
+		assert.ErrorIs(t, validateErr(businessToken, "123456"), ErrTokenNotYetActive)
+	})
+
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        1,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().AddDate(0, 0, 7),
+		NotBefore: null.TimeFrom(time.Now().Add(-time.Minute)),
+	}, nil)
+	t.Run("Test Validate - Happy Path Once Active", func(t *testing.T) {
+		assert.NoError(t, validateErr(businessToken, "123456"))
+	})
+}
+
+func TestBusinessToken_Generate_FailPath_ExpiryValidation(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	tests := []struct {
+		name    string
+		params  *models.CreateToken
+		wantErr error
+	}{
+		{
+			name:    "Both Expires In And Expires At",
+			params:  &models.CreateToken{ExpiresIn: "24h", ExpiresAt: &expiresAt},
+			wantErr: ErrExpiresInAndExpiresAt,
+		},
+		{
+			name:    "Invalid Expires In",
+			params:  &models.CreateToken{ExpiresIn: "two days"},
+			wantErr: ErrInvalidExpiresIn,
+		},
+		{
+			name:    "Below Min TTL",
+			params:  &models.CreateToken{ExpiresIn: "1m"},
+			wantErr: ErrTokenTTLOutOfBounds,
+		},
+		{
+			name:    "Above Max TTL",
+			params:  &models.CreateToken{ExpiresIn: "1000h"},
+			wantErr: ErrTokenTTLOutOfBounds,
+		},
+		{
+			name:    "Not Before After Expiry",
+			params:  &models.CreateToken{ExpiresAt: &expiresAt, NotBefore: &expiresAt},
+			wantErr: ErrNotBeforeAfterExpiry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run("Test Generate - Fail Path "+tt.name, func(t *testing.T) {
+			fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+			businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+			_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, tt.params)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, fakeDataPersistence.GenerateCallCount())
+		})
+	}
+}
+
 func TestBusinessToken_GetAll_HappyPath(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetAllReturns([]models.Token{
@@ -45,8 +175,8 @@ func TestBusinessToken_GetAll_HappyPath(t *testing.T) {
 		},
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	_, err := businessToken.GetAll(context.Background())
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GetAll(context.Background(), &models.TokenFilter{})
 	t.Run("Test Get - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
 	})
@@ -60,8 +190,8 @@ func TestBusinessToken_GetAll_FailPath(t *testing.T) {
 		},
 	}, errGetTokens)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	_, err := businessToken.GetAll(context.Background())
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GetAll(context.Background(), &models.TokenFilter{})
 	t.Run("Test Get - Happy Path", func(t *testing.T) {
 		require.Error(t, err)
 
@@ -75,15 +205,15 @@ func TestBusinessToken_Revoke_Fail(t *testing.T) {
 	tokenKey := "123456"
 
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
-	fakeDataPersistence.RevokeTokenReturns(errTokenRevoked)
+	fakeDataPersistence.RevokeTokenReturns(nil, ErrTokenRevoked)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
 	err := businessToken.Revoke(context.Background(), tokenKey)
 	t.Run("Test Revoke - Fail Path", func(t *testing.T) {
 		require.Error(t, err)
 
 		errMsg := err.Error()
-		wantErrMsg := errTokenRevoked.Error()
+		wantErrMsg := ErrTokenRevoked.Error()
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
@@ -92,9 +222,9 @@ func TestBusinessToken_Revoke_HappyPath(t *testing.T) {
 	tokenKey := "123456"
 
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
-	fakeDataPersistence.RevokeTokenReturns(nil)
+	fakeDataPersistence.RevokeTokenReturns(&models.TokenRef{Id: 42}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
 	err := businessToken.Revoke(context.Background(), tokenKey)
 	t.Run("Test Revoke - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
@@ -103,45 +233,25 @@ func TestBusinessToken_Revoke_HappyPath(t *testing.T) {
 
 func TestBusinessToken_Validate_HappyPath(t *testing.T) {
 	tokenKey := "123456"
+	expiresAt := time.Now().Add(time.Hour)
 
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetTokenReturns(&models.Token{
 		Id:        1,
-		Key:       tokenKey,
+		KeyPrefix: tokenKey[:2],
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now(),
+		ExpiresAt: expiresAt,
 		Revoked:   false,
 		Expired:   false,
 		CreatedBy: "Demby",
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	validation, err := businessToken.Validate(context.Background(), tokenKey, "", &models.RequestMeta{})
 	t.Run("Test Validate - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
-	})
-}
-
-func TestBusinessToken_Validate_FailPath_UpdateTokenToExpired(t *testing.T) {
-	tokenKey := "123456"
-
-	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
-	fakeDataPersistence.GetTokenReturns(&models.Token{
-		Id:        1,
-		Key:       tokenKey,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now(),
-		Revoked:   false,
-		Expired:   false,
-		CreatedBy: "Demby",
-	}, errUpdateTokenToExpired)
-
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
-	t.Run("Test Validate - Update Token To Expired", func(t *testing.T) {
-		defer func() {
-			require.Error(t, err)
-		}()
+		assert.True(t, validation.Valid)
+		assert.Equal(t, expiresAt, validation.ExpiresAt)
 	})
 }
 
@@ -151,7 +261,7 @@ func TestBusinessToken_Validate_FailPath_GetToken(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetTokenReturns(&models.Token{
 		Id:        1,
-		Key:       tokenKey,
+		KeyPrefix: tokenKey[:2],
 		CreatedAt: time.Now(),
 		ExpiresAt: time.Now(),
 		Revoked:   false,
@@ -159,8 +269,8 @@ func TestBusinessToken_Validate_FailPath_GetToken(t *testing.T) {
 		CreatedBy: "Demby",
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), tokenKey, "", &models.RequestMeta{})
 	t.Run("Test Validate - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
 	})
@@ -172,7 +282,7 @@ func TestBusinessToken_Validate_FailPath_Revoked(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetTokenReturns(&models.Token{
 		Id:        1,
-		Key:       tokenKey,
+		KeyPrefix: tokenKey[:2],
 		CreatedAt: time.Now(),
 		ExpiresAt: time.Now(),
 		Revoked:   true,
@@ -180,13 +290,13 @@ func TestBusinessToken_Validate_FailPath_Revoked(t *testing.T) {
 		CreatedBy: "Demby",
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), tokenKey, "", &models.RequestMeta{})
 	t.Run("Test Validate - Fail Path Revoked", func(t *testing.T) {
 		require.Error(t, err)
 
 		errMsg := err.Error()
-		wantErrMsg := errTokenRevoked.Error()
+		wantErrMsg := ErrTokenRevoked.Error()
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
@@ -197,7 +307,7 @@ func TestBusinessToken_Validate_FailPath_Expired(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetTokenReturns(&models.Token{
 		Id:        1,
-		Key:       tokenKey,
+		KeyPrefix: tokenKey[:2],
 		CreatedAt: time.Now(),
 		ExpiresAt: time.Now(),
 		Revoked:   false,
@@ -205,13 +315,13 @@ func TestBusinessToken_Validate_FailPath_Expired(t *testing.T) {
 		CreatedBy: "Demby",
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), tokenKey, "", &models.RequestMeta{})
 	t.Run("Test Validate - Fail Path Expired", func(t *testing.T) {
 		require.Error(t, err)
 
 		errMsg := err.Error()
-		wantErrMsg := errTokenExpired.Error()
+		wantErrMsg := ErrTokenExpired.Error()
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
@@ -222,21 +332,961 @@ func TestBusinessToken_Validate_FailPath_DeterminedExpired(t *testing.T) {
 	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
 	fakeDataPersistence.GetTokenReturns(&models.Token{
 		Id:        1,
-		Key:       tokenKey,
+		KeyPrefix: tokenKey[:2],
 		ExpiresAt: time.Now().AddDate(0, 0, -1),
 		Revoked:   false,
 		Expired:   false,
 		CreatedBy: "Demby",
 	}, nil)
 
-	businessToken := NewBusinessToken(&fakeDataPersistence, 7, 6, 12)
-	err := businessToken.Validate(context.Background(), tokenKey)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), tokenKey, "", &models.RequestMeta{})
 	fmt.Println("err err err", err)
 	t.Run("Test Validate - Fail Path Determined Expired", func(t *testing.T) {
 		require.Error(t, err)
 
 		errMsg := err.Error()
-		wantErrMsg := errTokenDeterminedExpired.Error()
+		wantErrMsg := ErrTokenExpired.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestBusinessToken_Validate_FailPath_Exhausted(t *testing.T) {
+	maxUses := 1
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        1,
+		KeyPrefix: "12",
+		ExpiresAt: time.Now().AddDate(0, 0, 1),
+		MaxUses:   null.IntFrom(maxUses),
+		UseCount:  1,
+	}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), "123456", "", &models.RequestMeta{})
+	t.Run("Test Validate - Fail Path Exhausted", func(t *testing.T) {
+		require.ErrorIs(t, err, ErrTokenExhausted)
+	})
+}
+
+func TestBusinessToken_Generate_FailPath_InvalidMaxUses(t *testing.T) {
+	maxUses := 0
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		MaxUses: &maxUses,
+	})
+	t.Run("Test Generate - Fail Path Invalid Max Uses", func(t *testing.T) {
+		require.ErrorIs(t, err, ErrInvalidMaxUses)
+		assert.Equal(t, 0, fakeDataPersistence.GenerateCallCount())
+	})
+}
+
+func TestBusinessToken_Redeem_HappyPath(t *testing.T) {
+	maxUses := 2
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        4,
+		KeyPrefix: "12",
+		ExpiresAt: time.Now().AddDate(0, 0, 1),
+		MaxUses:   null.IntFrom(maxUses),
+		UseCount:  1,
+	}, nil)
+	fakeDataPersistence.RedeemTokenReturns(true, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.Redeem(context.Background(), "123456", &models.RequestMeta{})
+	t.Run("Test Redeem - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, id := fakeDataPersistence.RedeemTokenArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestBusinessToken_Redeem_FailPath_Exhausted(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        4,
+		KeyPrefix: "12",
+		ExpiresAt: time.Now().AddDate(0, 0, 1),
+	}, nil)
+	fakeDataPersistence.RedeemTokenReturns(false, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.Redeem(context.Background(), "123456", &models.RequestMeta{})
+	t.Run("Test Redeem - Fail Path Exhausted", func(t *testing.T) {
+		require.ErrorIs(t, err, ErrTokenExhausted)
+	})
+}
+
+func TestBusinessToken_Redeem_FailPath_RedeemToken(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{
+		Id:        4,
+		KeyPrefix: "12",
+		ExpiresAt: time.Now().AddDate(0, 0, 1),
+	}, nil)
+	fakeDataPersistence.RedeemTokenReturns(false, errRedeemToken)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.Redeem(context.Background(), "123456", &models.RequestMeta{})
+	t.Run("Test Redeem - Fail Path Redeem Token", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errRedeemToken.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestBusinessToken_Validate_RecordsEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       *models.Token
+		getTokenErr error
+		wantOutcome string
+		wantTokenId bool
+	}{
+		{
+			name:        "Valid",
+			token:       &models.Token{Id: 1, ExpiresAt: time.Now().AddDate(0, 0, 1)},
+			wantOutcome: models.TokenEventOutcomeValid,
+			wantTokenId: true,
+		},
+		{
+			name:        "Revoked",
+			token:       &models.Token{Id: 1, ExpiresAt: time.Now().AddDate(0, 0, 1), Revoked: true},
+			wantOutcome: models.TokenEventOutcomeRevoked,
+			wantTokenId: true,
+		},
+		{
+			name:        "Expired",
+			token:       &models.Token{Id: 1, ExpiresAt: time.Now().AddDate(0, 0, 1), Expired: true},
+			wantOutcome: models.TokenEventOutcomeExpired,
+			wantTokenId: true,
+		},
+		{
+			name:        "Not Yet Active",
+			token:       &models.Token{Id: 1, ExpiresAt: time.Now().AddDate(0, 0, 1), NotBefore: null.TimeFrom(time.Now().Add(time.Hour))},
+			wantOutcome: models.TokenEventOutcomeNotYetActive,
+			wantTokenId: true,
+		},
+		{
+			name:        "Not Found",
+			getTokenErr: fmt.Errorf("no results: %w", models.ErrNotFound),
+			wantOutcome: models.TokenEventOutcomeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run("Test Validate - Records Event "+tt.name, func(t *testing.T) {
+			fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+			fakeDataPersistence.GetTokenReturns(tt.token, tt.getTokenErr)
+
+			businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+			_, _ = businessToken.Validate(context.Background(), "123456", "", &models.RequestMeta{
+				Ip:        "127.0.0.1",
+				UserAgent: "curl/8.0",
+				RequestId: "abc",
+			})
+
+			require.Equal(t, 1, fakeDataPersistence.CreateTokenEventCallCount())
+			_, event := fakeDataPersistence.CreateTokenEventArgsForCall(0)
+			assert.Equal(t, models.TokenEventActionValidate, event.Action)
+			assert.Equal(t, tt.wantOutcome, event.Outcome)
+			assert.Equal(t, tt.wantTokenId, event.TokenId != nil)
+			assert.Equal(t, "127.0.0.1", event.Ip)
+			assert.Equal(t, "curl/8.0", event.UserAgent)
+			assert.Equal(t, "abc", event.RequestId)
+		})
+	}
+}
+
+func TestBusinessToken_Validate_HappyPath_RecordEventFails(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 1, ExpiresAt: time.Now().AddDate(0, 0, 1)}, nil)
+	fakeDataPersistence.CreateTokenEventReturns(errCreateTokenEvent)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), "123456", "", &models.RequestMeta{})
+	t.Run("Test Validate - Happy Path Record Event Fails", func(t *testing.T) {
+		require.NoError(t, err)
+	})
+}
+
+func TestBusinessToken_GetEvents_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 9}, nil)
+	fakeDataPersistence.GetTokenEventsReturns([]models.TokenEvent{{Id: 1}}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	events, err := businessToken.GetEvents(context.Background(), "123456")
+	t.Run("Test GetEvents - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		_, tokenId := fakeDataPersistence.GetTokenEventsArgsForCall(0)
+		assert.Equal(t, 9, tokenId)
+	})
+}
+
+func TestBusinessToken_GetEvents_FailPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 9}, nil)
+	fakeDataPersistence.GetTokenEventsReturns(nil, errGetTokenEvents)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GetEvents(context.Background(), "123456")
+	t.Run("Test GetEvents - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errGetTokenEvents.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_Labels(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		Label:          "ACME onboarding",
+		Note:           "Sent after the kickoff call",
+		RecipientEmail: "jane@acme.com",
+	})
+	t.Run("Test Generate - Happy Path Labels", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Equal(t, "ACME onboarding", newToken.Label)
+		assert.Equal(t, "Sent after the kickoff call", newToken.Note)
+		assert.Equal(t, "jane@acme.com", newToken.RecipientEmail)
+	})
+}
+
+func TestBusinessToken_Generate_FailPath_InvalidRecipientEmail(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		RecipientEmail: "not an email",
+	})
+	t.Run("Test Generate - Fail Path Invalid Recipient Email", func(t *testing.T) {
+		require.ErrorIs(t, err, ErrInvalidTokenParams)
+		assert.Contains(t, err.Error(), "recipient_email")
+		assert.Equal(t, 0, fakeDataPersistence.GenerateCallCount())
+	})
+}
+
+func TestBusinessToken_GetAll_HappyPath_NextCursor(t *testing.T) {
+	createdAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetAllReturns([]models.Token{
+		{Id: 3, CreatedAt: createdAt.Add(2 * time.Hour)},
+		{Id: 2, CreatedAt: createdAt.Add(time.Hour)},
+		{Id: 1, CreatedAt: createdAt},
+	}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	page, err := businessToken.GetAll(context.Background(), &models.TokenFilter{
+		Status:       models.TokenStatusActive,
+		CreatedAfter: "2024-05-01",
+		Limit:        2,
+	})
+	t.Run("Test GetAll - Happy Path Next Cursor", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, page.Tokens, 2)
+
+		_, query := fakeDataPersistence.GetAllArgsForCall(0)
+		assert.Equal(t, 3, query.Limit)
+		assert.Equal(t, models.TokenStatusActive, query.Status)
+		assert.Equal(t, models.TokenSortCreatedAt, query.SortBy)
+		assert.True(t, query.SortDesc)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *query.CreatedAfter)
+
+		cursor, err := decodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, 2, cursor.Id)
+		assert.True(t, createdAt.Add(time.Hour).Equal(cursor.Value))
+	})
+}
+
+func TestBusinessToken_GetAll_HappyPath_LastPage(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetAllReturns([]models.Token{{Id: 1}}, nil)
+
+	cursor := encodeCursor(&models.TokenQuery{SortBy: models.TokenSortExpiresAt},
+		&models.Token{Id: 7, ExpiresAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)})
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	page, err := businessToken.GetAll(context.Background(), &models.TokenFilter{
+		Sort:   models.TokenSortExpiresAt,
+		Cursor: cursor,
+	})
+	t.Run("Test GetAll - Happy Path Last Page", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, page.Tokens, 1)
+		assert.Empty(t, page.NextCursor)
+
+		_, query := fakeDataPersistence.GetAllArgsForCall(0)
+		assert.Equal(t, models.TokenSortExpiresAt, query.SortBy)
+		assert.False(t, query.SortDesc)
+		require.NotNil(t, query.After)
+		assert.Equal(t, 7, query.After.Id)
+	})
+}
+
+func TestBusinessToken_GetAll_FailPath_InvalidFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *models.TokenFilter
+	}{
+		{name: "Status", filter: &models.TokenFilter{Status: "pending"}},
+		{name: "Date", filter: &models.TokenFilter{ExpiresBefore: "01/06/2024"}},
+		{name: "Sort", filter: &models.TokenFilter{Sort: "-label"}},
+		{name: "Cursor", filter: &models.TokenFilter{Cursor: "not a cursor"}},
+		{name: "Limit", filter: &models.TokenFilter{Limit: maxPageSize + 1}},
+	}
+	for _, tt := range tests {
+		t.Run("Test GetAll - Fail Path Invalid "+tt.name, func(t *testing.T) {
+			fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+			businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+			_, err := businessToken.GetAll(context.Background(), tt.filter)
+			require.ErrorIs(t, err, ErrInvalidTokenFilter)
+			assert.Equal(t, 0, fakeDataPersistence.GetAllCallCount())
+		})
+	}
+}
+
+func TestBusinessToken_GenerateBatch_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns([]string{"1234", "5678"}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	keys, err := businessToken.GenerateBatch(context.Background(), &models.User{Id: 3}, &models.CreateTokenBatch{
+		Count:       2,
+		CreateToken: models.CreateToken{ExpiresIn: "48h", Label: "Launch event"},
+	})
+	t.Run("Test GenerateBatch - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1234", "5678"}, keys)
+
+		_, newToken, count, _, _ := fakeDataPersistence.GenerateBatchArgsForCall(0)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, 3, newToken.CreatedBy)
+		assert.Equal(t, "Launch event", newToken.Label)
+		assert.WithinDuration(t, time.Now().Add(48*time.Hour), newToken.ExpiresAt, time.Minute)
+	})
+}
+
+func TestBusinessToken_GenerateBatch_FailPath_Count(t *testing.T) {
+	for _, count := range []int{0, 501} {
+		t.Run(fmt.Sprintf("Test GenerateBatch - Fail Path Count %v", count), func(t *testing.T) {
+			fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+			businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+			_, err := businessToken.GenerateBatch(context.Background(), &models.User{Id: 3}, &models.CreateTokenBatch{
+				Count: count,
+			})
+			require.ErrorIs(t, err, ErrInvalidBatchCount)
+			assert.Equal(t, 0, fakeDataPersistence.GenerateBatchCallCount())
+		})
+	}
+}
+
+func TestBusinessToken_GenerateBatch_FailPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateBatchReturns(nil, errGenerateTokenBatch)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GenerateBatch(context.Background(), &models.User{Id: 3}, &models.CreateTokenBatch{
+		Count: 2,
+	})
+	t.Run("Test GenerateBatch - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errGenerateTokenBatch.Error()
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
+
+func TestBusinessToken_Export_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.IterateAllStub = func(ctx context.Context, query *models.TokenQuery, each func(token *models.Token) error) error {
+		for _, token := range []models.Token{{Id: 1}, {Id: 2}} {
+			if err := each(&token); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	tokens, err := businessToken.Export(context.Background(), &models.TokenFilter{
+		Status: models.TokenStatusActive,
+		Limit:  10,
+		Cursor: "ignored",
+	})
+	require.NoError(t, err)
+
+	var ids []int
+	err = tokens(func(token *models.Token) error {
+		ids = append(ids, token.Id)
+		return nil
+	})
+	t.Run("Test Export - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, ids)
+
+		_, query, _ := fakeDataPersistence.IterateAllArgsForCall(0)
+		assert.Equal(t, models.TokenStatusActive, query.Status)
+		assert.Equal(t, 0, query.Limit)
+		assert.Nil(t, query.After)
+	})
+}
+
+func TestBusinessToken_Export_FailPath_InvalidFilter(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Export(context.Background(), &models.TokenFilter{Sort: "label"})
+	t.Run("Test Export - Fail Path Invalid Filter", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrInvalidTokenFilter)
+		assert.Equal(t, 0, fakeDataPersistence.IterateAllCallCount())
+	})
+}
+
+func TestBusinessToken_Export_FailPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.IterateAllReturns(errGetTokens)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	tokens, err := businessToken.Export(context.Background(), nil)
+	require.NoError(t, err)
+
+	err = tokens(func(token *models.Token) error {
+		return nil
+	})
+	t.Run("Test Export - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errExportTokens.Error())
+	})
+}
+
+func TestBusinessToken_SweepExpired_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.ExpireTokensReturns([]models.TokenRef{{Id: 3}, {Id: 4}, {Id: 5}}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	before := time.Now()
+	businessToken.SweepExpired(context.Background())
+	t.Run("Test SweepExpired - Happy Path", func(t *testing.T) {
+		require.Equal(t, 1, fakeDataPersistence.ExpireTokensCallCount())
+
+		_, now, limit := fakeDataPersistence.ExpireTokensArgsForCall(0)
+		assert.False(t, now.Before(before))
+		assert.Equal(t, 500, limit)
+	})
+}
+
+func TestBusinessToken_SweepExpired_HappyPath_Batches(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.ExpireTokensReturnsOnCall(0, []models.TokenRef{{Id: 3}, {Id: 4}}, nil)
+	fakeDataPersistence.ExpireTokensReturnsOnCall(1, []models.TokenRef{{Id: 5}, {Id: 6}}, nil)
+	fakeDataPersistence.ExpireTokensReturnsOnCall(2, []models.TokenRef{{Id: 7}}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 2, 0, testKeyFormat, true, nil)
+	businessToken.SweepExpired(context.Background())
+	t.Run("Test SweepExpired - Happy Path Batches", func(t *testing.T) {
+		require.Equal(t, 3, fakeDataPersistence.ExpireTokensCallCount())
+
+		_, first, _ := fakeDataPersistence.ExpireTokensArgsForCall(0)
+		_, last, limit := fakeDataPersistence.ExpireTokensArgsForCall(2)
+		assert.Equal(t, first, last)
+		assert.Equal(t, 2, limit)
+	})
+}
+
+func TestBusinessToken_SweepExpired_FailPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.ExpireTokensReturns(nil, errExpireTokens)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	t.Run("Test SweepExpired - Fail Path", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			businessToken.SweepExpired(context.Background())
+		})
+		assert.Equal(t, 1, fakeDataPersistence.ExpireTokensCallCount())
+	})
+}
+
+func TestBusinessToken_RevokeById_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeTokenByIdReturns(&models.TokenRef{Id: 42}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.RevokeById(context.Background(), 4)
+	t.Run("Test RevokeById - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, id := fakeDataPersistence.RevokeTokenByIdArgsForCall(0)
+		assert.Equal(t, 4, id)
+	})
+}
+
+func TestBusinessToken_RevokeById_Fail(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeTokenByIdReturns(nil, ErrTokenRevoked)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.RevokeById(context.Background(), 4)
+	t.Run("Test RevokeById - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errRevokeToken.Error())
+	})
+}
+
+func TestBusinessToken_Validate_FailPath_MalformedKey(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	for _, key := range []string{"inv_3kf9x2abTYPO00", "inv_", "<script>"} {
+		_, err := businessToken.Validate(context.Background(), key, "", &models.RequestMeta{})
+		t.Run("Test Validate - Fail Path Malformed Key "+key, func(t *testing.T) {
+			assert.ErrorIs(t, err, ErrMalformedKey)
+		})
+	}
+	t.Run("Test Validate - Malformed Keys Skip The Database", func(t *testing.T) {
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenCallCount())
+		assert.Equal(t, 0, fakeDataPersistence.CreateTokenEventCallCount())
+	})
+}
+
+func TestBusinessToken_Validate_HappyPath_FormattedKey(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+	key := testKeyFormat.Wrap("3kf9x2ab")
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, nil)
+	_, err := businessToken.Validate(context.Background(), key, "", &models.RequestMeta{})
+	t.Run("Test Validate - Happy Path Formatted Key", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, gotKey := fakeDataPersistence.GetTokenArgsForCall(0)
+		assert.Equal(t, key, gotKey)
+	})
+}
+
+func TestBusinessToken_Validate_LegacyKeys(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+	accepting := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	rejecting := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, nil)
+	t.Run("Test Validate - Legacy Keys", func(t *testing.T) {
+		assert.NoError(t, validateErr(accepting, "a1b2c3"))
+		assert.ErrorIs(t, validateErr(rejecting, "a1b2c3"), ErrMalformedKey)
+		assert.Equal(t, 1, fakeDataPersistence.GetTokenCallCount())
+	})
+}
+
+func TestBusinessToken_Redeem_FailPath_MalformedKey(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	err := businessToken.Redeem(context.Background(), "inv_3kf9x2abTYPO00", &models.RequestMeta{})
+	t.Run("Test Redeem - Fail Path Malformed Key", func(t *testing.T) {
+		assert.ErrorIs(t, err, ErrMalformedKey)
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenCallCount())
+		assert.Equal(t, 0, fakeDataPersistence.RedeemTokenCallCount())
+	})
+}
+
+func testSigner(t *testing.T) *signing.Signer {
+	signer, err := signing.NewSigner("inv_", signing.AlgorithmEd25519,
+		[]string{"k1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="})
+	require.NoError(t, err)
+	return signer
+}
+
+func TestBusinessToken_Validate_Signed(t *testing.T) {
+	signer := testSigner(t)
+	valid, err := signer.Sign(signing.Claims{Id: 1, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	expired, err := signer.Sign(signing.Claims{Id: 2, ExpiresAt: time.Now().Add(-time.Hour).Unix()})
+	require.NoError(t, err)
+	revoked, err := signer.Sign(signing.Claims{Id: 3, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	scheduled, err := signer.Sign(signing.Claims{Id: 4, ExpiresAt: time.Now().Add(2 * time.Hour).Unix(),
+		NotBefore: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetRevokedTokenIdsReturns([]int{3}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	businessToken.RefreshRevoked(context.Background())
+
+	tests := []struct {
+		name string
+		key  string
+		err  error
+	}{
+		{name: "Valid", key: valid},
+		{name: "Expired", key: expired, err: ErrTokenExpired},
+		{name: "Revoked", key: revoked, err: ErrTokenRevoked},
+		{name: "Not Yet Active", key: scheduled, err: ErrTokenNotYetActive},
+		{name: "Tampered", key: valid + "x", err: ErrMalformedKey},
+	}
+	for _, test := range tests {
+		_, err := businessToken.Validate(context.Background(), test.key, "", &models.RequestMeta{})
+		t.Run("Test Validate Signed - "+test.name, func(t *testing.T) {
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+	t.Run("Test Validate Signed - Skips The Database", func(t *testing.T) {
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenCallCount())
+		assert.Equal(t, 0, fakeDataPersistence.CreateTokenEventCallCount())
+	})
+}
+
+func TestBusinessToken_Validate_Signed_StoredKeysStillLookedUp(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, testSigner(t))
+	_, err := businessToken.Validate(context.Background(), testKeyFormat.Wrap("3kf9x2ab"), "", &models.RequestMeta{})
+	t.Run("Test Validate Signed - Stored Keys Still Looked Up", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 1, fakeDataPersistence.GetTokenCallCount())
+	})
+}
+
+func TestBusinessToken_Revoke_Signed(t *testing.T) {
+	signer := testSigner(t)
+	byKey, err := signer.Sign(signing.Claims{Id: 1, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	byId, err := signer.Sign(signing.Claims{Id: 2, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.RevokeTokenReturns(&models.TokenRef{Id: 1}, nil)
+	fakeDataPersistence.RevokeTokenByIdReturns(&models.TokenRef{Id: 2}, nil)
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	require.NoError(t, businessToken.Revoke(context.Background(), byKey))
+	require.NoError(t, businessToken.RevokeById(context.Background(), 2))
+
+	t.Run("Test Revoke Signed - Applies Immediately", func(t *testing.T) {
+		assert.ErrorIs(t, validateErr(businessToken, byKey), ErrTokenRevoked)
+		assert.ErrorIs(t, validateErr(businessToken, byId), ErrTokenRevoked)
+	})
+}
+
+func TestBusinessToken_RefreshRevoked_FailPath_KeepsPreviousSet(t *testing.T) {
+	signer := testSigner(t)
+	revoked, err := signer.Sign(signing.Claims{Id: 3, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetRevokedTokenIdsReturnsOnCall(0, []int{3}, nil)
+	fakeDataPersistence.GetRevokedTokenIdsReturnsOnCall(1, nil, fmt.Errorf("connection lost"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	businessToken.RefreshRevoked(context.Background())
+	businessToken.RefreshRevoked(context.Background())
+	t.Run("Test RefreshRevoked - Fail Path Keeps Previous Set", func(t *testing.T) {
+		assert.ErrorIs(t, validateErr(businessToken, revoked), ErrTokenRevoked)
+	})
+}
+
+func TestBusinessToken_Update_HappyPath_Extend(t *testing.T) {
+	createdAt := time.Now().Add(-5 * 24 * time.Hour)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4, CreatedAt: createdAt, ExpiresAt: expiresAt}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Update(context.Background(), "a1b2c3", &models.UpdateToken{ExtendBy: "48h"})
+	t.Run("Test Update - Happy Path Extend", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Equal(t, 1, fakeDataPersistence.UpdateTokenCallCount())
+
+		_, id, changes := fakeDataPersistence.UpdateTokenArgsForCall(0)
+		assert.Equal(t, 4, id)
+		require.NotNil(t, changes.ExpiresAt)
+		assert.Equal(t, expiresAt.Add(48*time.Hour), *changes.ExpiresAt)
+		assert.Nil(t, changes.Revoked)
+	})
+}
+
+func TestBusinessToken_Update_HappyPath_Reinstate(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4, Revoked: true}, nil)
+
+	revoked := false
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Update(context.Background(), "a1b2c3", &models.UpdateToken{Revoked: &revoked})
+	t.Run("Test Update - Happy Path Reinstate", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, _, changes := fakeDataPersistence.UpdateTokenArgsForCall(0)
+		assert.Nil(t, changes.ExpiresAt)
+		require.NotNil(t, changes.Revoked)
+		assert.False(t, *changes.Revoked)
+	})
+}
+
+func TestBusinessToken_Update_FailPaths(t *testing.T) {
+	createdAt := time.Now().Add(-5 * 24 * time.Hour)
+	tooLate := createdAt.Add(31 * 24 * time.Hour)
+	tooSoon := time.Now().Add(10 * time.Minute)
+
+	tests := []struct {
+		name   string
+		params *models.UpdateToken
+		err    error
+	}{
+		{name: "No Changes", params: &models.UpdateToken{}, err: ErrNoTokenChanges},
+		{name: "Extend By And Expires At", params: &models.UpdateToken{ExtendBy: "48h", ExpiresAt: &tooLate}, err: ErrExtendByAndExpiresAt},
+		{name: "Invalid Extend By", params: &models.UpdateToken{ExtendBy: "two days"}, err: ErrInvalidExtendBy},
+		{name: "Beyond Max TTL", params: &models.UpdateToken{ExpiresAt: &tooLate}, err: ErrTokenTTLOutOfBounds},
+		{name: "Within Min TTL", params: &models.UpdateToken{ExpiresAt: &tooSoon}, err: ErrTokenTTLOutOfBounds},
+		{name: "Shortened Into The Past", params: &models.UpdateToken{ExtendBy: "-240h"}, err: ErrTokenTTLOutOfBounds},
+	}
+	for _, test := range tests {
+		fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+		fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4, CreatedAt: createdAt, ExpiresAt: createdAt.Add(7 * 24 * time.Hour)}, nil)
+
+		businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+		_, err := businessToken.Update(context.Background(), "a1b2c3", test.params)
+		t.Run("Test Update - Fail Path "+test.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, 0, fakeDataPersistence.UpdateTokenCallCount())
+		})
+	}
+}
+
+func TestBusinessToken_Update_FailPath_NotFound(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(nil, models.ErrNotFound)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Update(context.Background(), "a1b2c3", &models.UpdateToken{ExtendBy: "48h"})
+	t.Run("Test Update - Fail Path Not Found", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestBusinessToken_Update_Signed(t *testing.T) {
+	signer := testSigner(t)
+	key, err := signer.Sign(signing.Claims{Id: 4, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4}, nil)
+	fakeDataPersistence.GetRevokedTokenIdsReturns([]int{4}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	businessToken.RefreshRevoked(context.Background())
+
+	_, extendErr := businessToken.Update(context.Background(), key, &models.UpdateToken{ExtendBy: "48h"})
+	revoked := false
+	_, reinstateErr := businessToken.Update(context.Background(), key, &models.UpdateToken{Revoked: &revoked})
+	t.Run("Test Update Signed", func(t *testing.T) {
+		assert.ErrorIs(t, extendErr, ErrSignedTokenExpiry)
+		require.NoError(t, reinstateErr)
+		assert.NoError(t, validateErr(businessToken, key))
+	})
+}
+
+func TestBusinessToken_UpdateById_HappyPath(t *testing.T) {
+	createdAt := time.Now().Add(-5 * 24 * time.Hour)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenByIdReturnsOnCall(0, &models.Token{Id: 4, CreatedAt: createdAt, ExpiresAt: expiresAt}, nil)
+	fakeDataPersistence.GetTokenByIdReturnsOnCall(1, &models.Token{Id: 4, CreatedAt: createdAt, ExpiresAt: expiresAt.Add(48 * time.Hour)}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	updated, err := businessToken.UpdateById(context.Background(), 4, &models.UpdateToken{ExtendBy: "48h"})
+	t.Run("Test UpdateById - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt.Add(48*time.Hour), updated.ExpiresAt)
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenCallCount())
+
+		_, id := fakeDataPersistence.GetTokenByIdArgsForCall(0)
+		assert.Equal(t, 4, id)
+		_, id, changes := fakeDataPersistence.UpdateTokenArgsForCall(0)
+		assert.Equal(t, 4, id)
+		require.NotNil(t, changes.ExpiresAt)
+		assert.Equal(t, expiresAt.Add(48*time.Hour), *changes.ExpiresAt)
+	})
+}
+
+func TestBusinessToken_UpdateById_FailPath_NotFound(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenByIdReturns(nil, models.ErrNotFound)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.UpdateById(context.Background(), 9, &models.UpdateToken{ExtendBy: "48h"})
+	t.Run("Test UpdateById - Fail Path Not Found", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.Equal(t, 0, fakeDataPersistence.UpdateTokenCallCount())
+	})
+}
+
+func TestBusinessToken_UpdateById_Signed(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenByIdReturns(&models.Token{Id: 4}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, testSigner(t))
+	_, extendErr := businessToken.UpdateById(context.Background(), 4, &models.UpdateToken{ExtendBy: "48h"})
+	revoked := true
+	_, revokeErr := businessToken.UpdateById(context.Background(), 4, &models.UpdateToken{Revoked: &revoked})
+	t.Run("Test UpdateById Signed", func(t *testing.T) {
+		assert.ErrorIs(t, extendErr, ErrSignedTokenExpiry)
+		require.NoError(t, revokeErr)
+		assert.True(t, businessToken.revoked.has(4))
+	})
+}
+
+func TestBusinessToken_GetEventsById_HappyPath(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenByIdReturns(&models.Token{Id: 9}, nil)
+	fakeDataPersistence.GetTokenEventsReturns([]models.TokenEvent{{Id: 1}}, nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	events, err := businessToken.GetEventsById(context.Background(), 9)
+	t.Run("Test GetEventsById - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		_, tokenId := fakeDataPersistence.GetTokenEventsArgsForCall(0)
+		assert.Equal(t, 9, tokenId)
+	})
+}
+
+func TestBusinessToken_GetEventsById_NotFound(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenByIdReturns(nil, models.ErrNotFound)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.GetEventsById(context.Background(), 9)
+	t.Run("Test GetEventsById - Not Found", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenEventsCallCount())
+	})
+}
+
+func TestBusinessToken_Generate_HappyPath_Scopes(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GenerateReturns("1234", nil)
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{
+		Scopes: []string{"beta:analytics", "org:42"},
+	})
+	t.Run("Test Generate - Happy Path Scopes", func(t *testing.T) {
+		require.NoError(t, err)
+
+		_, newToken, _, _ := fakeDataPersistence.GenerateArgsForCall(0)
+		assert.Equal(t, []string{"beta:analytics", "org:42"}, newToken.Scopes)
+	})
+}
+
+func TestBusinessToken_Generate_FailPath_InvalidScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+	}{
+		{name: "Uppercase", scopes: []string{"Beta"}},
+		{name: "Empty", scopes: []string{""}},
+		{name: "Spaces", scopes: []string{"beta analytics"}},
+		{name: "Duplicate", scopes: []string{"org:42", "org:42"}},
+	}
+	for _, test := range tests {
+		fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+
+		businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+		_, err := businessToken.Generate(context.Background(), &models.User{Id: 3}, &models.CreateToken{Scopes: test.scopes})
+		t.Run("Test Generate - Fail Path Invalid Scopes "+test.name, func(t *testing.T) {
+			require.ErrorIs(t, err, ErrInvalidTokenParams)
+			assert.Contains(t, err.Error(), "scopes")
+			assert.Equal(t, 0, fakeDataPersistence.GenerateCallCount())
+		})
+	}
+}
+
+func TestBusinessToken_Validate_Scopes(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		err   error
+	}{
+		{name: "No Scope Requested"},
+		{name: "Carries Scope", scope: "org:42"},
+		{name: "Missing Scope", scope: "org:43", err: ErrMissingScope},
+	}
+	for _, test := range tests {
+		fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+		fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+		fakeDataPersistence.GetTokenScopesReturns([]string{"beta:analytics", "org:42"}, nil)
+
+		businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+		validation, err := businessToken.Validate(context.Background(), "a1b2c3", test.scope, &models.RequestMeta{})
+		t.Run("Test Validate Scopes - "+test.name, func(t *testing.T) {
+			_, event := fakeDataPersistence.CreateTokenEventArgsForCall(0)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Nil(t, validation)
+				assert.Equal(t, models.TokenEventOutcomeMissingScope, event.Outcome)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, validation.Valid)
+			assert.Equal(t, []string{"beta:analytics", "org:42"}, validation.Scopes)
+			assert.Equal(t, models.TokenEventOutcomeValid, event.Outcome)
+		})
+	}
+}
+
+func TestBusinessToken_Validate_FailPath_GetScopes(t *testing.T) {
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	fakeDataPersistence.GetTokenReturns(&models.Token{Id: 4, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	fakeDataPersistence.GetTokenScopesReturns(nil, fmt.Errorf("connection lost"))
+
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, true, nil)
+	_, err := businessToken.Validate(context.Background(), "a1b2c3", "", &models.RequestMeta{})
+	t.Run("Test Validate - Fail Path Get Scopes", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errGetTokenScopes.Error())
+	})
+}
+
+func TestBusinessToken_Validate_Signed_Scopes(t *testing.T) {
+	signer := testSigner(t)
+	key, err := signer.Sign(signing.Claims{Id: 4, ExpiresAt: time.Now().Add(time.Hour).Unix(), Scopes: []string{"org:42"}})
+	require.NoError(t, err)
+
+	fakeDataPersistence := tokenfakes.FakeDataPersistence{}
+	businessToken := NewBusinessToken(&fakeDataPersistence, 7, time.Hour, 30*24*time.Hour, 6, 12, 500, 500, 0, testKeyFormat, false, signer)
+	validation, err := businessToken.Validate(context.Background(), key, "org:42", &models.RequestMeta{})
+	_, missingErr := businessToken.Validate(context.Background(), key, "org:43", &models.RequestMeta{})
+	t.Run("Test Validate Signed - Scopes", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"org:42"}, validation.Scopes)
+		assert.ErrorIs(t, missingErr, ErrMissingScope)
+		assert.Equal(t, 0, fakeDataPersistence.GetTokenScopesCallCount())
+	})
+}
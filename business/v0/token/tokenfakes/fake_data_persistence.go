@@ -4,16 +4,59 @@ package tokenfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"platform_engineer_clone/models"
 )
 
 type FakeDataPersistence struct {
-	GenerateStub        func(context.Context, int, int, int) (string, error)
+	CountActiveTokensStub        func(context.Context, int, time.Time) (int, error)
+	countActiveTokensMutex       sync.RWMutex
+	countActiveTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	countActiveTokensReturns struct {
+		result1 int
+		result2 error
+	}
+	countActiveTokensReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	CreateTokenEventStub        func(context.Context, *models.NewTokenEvent) error
+	createTokenEventMutex       sync.RWMutex
+	createTokenEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.NewTokenEvent
+	}
+	createTokenEventReturns struct {
+		result1 error
+	}
+	createTokenEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExpireTokensStub        func(context.Context, time.Time, int) ([]models.TokenRef, error)
+	expireTokensMutex       sync.RWMutex
+	expireTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	expireTokensReturns struct {
+		result1 []models.TokenRef
+		result2 error
+	}
+	expireTokensReturnsOnCall map[int]struct {
+		result1 []models.TokenRef
+		result2 error
+	}
+	GenerateStub        func(context.Context, *models.NewToken, int, int) (string, error)
 	generateMutex       sync.RWMutex
 	generateArgsForCall []struct {
 		arg1 context.Context
-		arg2 int
+		arg2 *models.NewToken
 		arg3 int
 		arg4 int
 	}
@@ -25,10 +68,28 @@ type FakeDataPersistence struct {
 		result1 string
 		result2 error
 	}
-	GetAllStub        func(context.Context) ([]models.Token, error)
+	GenerateBatchStub        func(context.Context, *models.NewToken, int, int, int) ([]string, error)
+	generateBatchMutex       sync.RWMutex
+	generateBatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.NewToken
+		arg3 int
+		arg4 int
+		arg5 int
+	}
+	generateBatchReturns struct {
+		result1 []string
+		result2 error
+	}
+	generateBatchReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetAllStub        func(context.Context, *models.TokenQuery) ([]models.Token, error)
 	getAllMutex       sync.RWMutex
 	getAllArgsForCall []struct {
 		arg1 context.Context
+		arg2 *models.TokenQuery
 	}
 	getAllReturns struct {
 		result1 []models.Token
@@ -38,6 +99,20 @@ type FakeDataPersistence struct {
 		result1 []models.Token
 		result2 error
 	}
+	GetRevokedTokenIdsStub        func(context.Context, time.Time) ([]int, error)
+	getRevokedTokenIdsMutex       sync.RWMutex
+	getRevokedTokenIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getRevokedTokenIdsReturns struct {
+		result1 []int
+		result2 error
+	}
+	getRevokedTokenIdsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	GetTokenStub        func(context.Context, string) (*models.Token, error)
 	getTokenMutex       sync.RWMutex
 	getTokenArgsForCall []struct {
@@ -52,40 +127,362 @@ type FakeDataPersistence struct {
 		result1 *models.Token
 		result2 error
 	}
-	RevokeTokenStub        func(context.Context, string) error
+	GetTokenByIdStub        func(context.Context, int) (*models.Token, error)
+	getTokenByIdMutex       sync.RWMutex
+	getTokenByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getTokenByIdReturns struct {
+		result1 *models.Token
+		result2 error
+	}
+	getTokenByIdReturnsOnCall map[int]struct {
+		result1 *models.Token
+		result2 error
+	}
+	GetTokenEventsStub        func(context.Context, int) ([]models.TokenEvent, error)
+	getTokenEventsMutex       sync.RWMutex
+	getTokenEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getTokenEventsReturns struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	getTokenEventsReturnsOnCall map[int]struct {
+		result1 []models.TokenEvent
+		result2 error
+	}
+	GetTokenScopesStub        func(context.Context, int) ([]string, error)
+	getTokenScopesMutex       sync.RWMutex
+	getTokenScopesArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getTokenScopesReturns struct {
+		result1 []string
+		result2 error
+	}
+	getTokenScopesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetUserTokenQuotaStub        func(context.Context, int) (*int, error)
+	getUserTokenQuotaMutex       sync.RWMutex
+	getUserTokenQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getUserTokenQuotaReturns struct {
+		result1 *int
+		result2 error
+	}
+	getUserTokenQuotaReturnsOnCall map[int]struct {
+		result1 *int
+		result2 error
+	}
+	IterateAllStub        func(context.Context, *models.TokenQuery, func(token *models.Token) error) error
+	iterateAllMutex       sync.RWMutex
+	iterateAllArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.TokenQuery
+		arg3 func(token *models.Token) error
+	}
+	iterateAllReturns struct {
+		result1 error
+	}
+	iterateAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RedeemTokenStub        func(context.Context, int) (bool, error)
+	redeemTokenMutex       sync.RWMutex
+	redeemTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	redeemTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	redeemTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeCampaignStub        func(context.Context, int) error
+	revokeCampaignMutex       sync.RWMutex
+	revokeCampaignArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	revokeCampaignReturns struct {
+		result1 error
+	}
+	revokeCampaignReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeCampaignTokensStub        func(context.Context, int, int, int) ([]models.TokenRef, error)
+	revokeCampaignTokensMutex       sync.RWMutex
+	revokeCampaignTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 int
+	}
+	revokeCampaignTokensReturns struct {
+		result1 []models.TokenRef
+		result2 error
+	}
+	revokeCampaignTokensReturnsOnCall map[int]struct {
+		result1 []models.TokenRef
+		result2 error
+	}
+	RevokeTokenStub        func(context.Context, string) (*models.TokenRef, error)
 	revokeTokenMutex       sync.RWMutex
 	revokeTokenArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 	}
 	revokeTokenReturns struct {
-		result1 error
+		result1 *models.TokenRef
+		result2 error
 	}
 	revokeTokenReturnsOnCall map[int]struct {
-		result1 error
+		result1 *models.TokenRef
+		result2 error
+	}
+	RevokeTokenByIdStub        func(context.Context, int) (*models.TokenRef, error)
+	revokeTokenByIdMutex       sync.RWMutex
+	revokeTokenByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	revokeTokenByIdReturns struct {
+		result1 *models.TokenRef
+		result2 error
+	}
+	revokeTokenByIdReturnsOnCall map[int]struct {
+		result1 *models.TokenRef
+		result2 error
 	}
-	UpdateTokenToExpiredStub        func(context.Context, *models.Token) error
-	updateTokenToExpiredMutex       sync.RWMutex
-	updateTokenToExpiredArgsForCall []struct {
+	UpdateTokenStub        func(context.Context, int, *models.TokenChanges) error
+	updateTokenMutex       sync.RWMutex
+	updateTokenArgsForCall []struct {
 		arg1 context.Context
-		arg2 *models.Token
+		arg2 int
+		arg3 *models.TokenChanges
 	}
-	updateTokenToExpiredReturns struct {
+	updateTokenReturns struct {
 		result1 error
 	}
-	updateTokenToExpiredReturnsOnCall map[int]struct {
+	updateTokenReturnsOnCall map[int]struct {
 		result1 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDataPersistence) Generate(arg1 context.Context, arg2 int, arg3 int, arg4 int) (string, error) {
+func (fake *FakeDataPersistence) CountActiveTokens(arg1 context.Context, arg2 int, arg3 time.Time) (int, error) {
+	fake.countActiveTokensMutex.Lock()
+	ret, specificReturn := fake.countActiveTokensReturnsOnCall[len(fake.countActiveTokensArgsForCall)]
+	fake.countActiveTokensArgsForCall = append(fake.countActiveTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.CountActiveTokensStub
+	fakeReturns := fake.countActiveTokensReturns
+	fake.recordInvocation("CountActiveTokens", []interface{}{arg1, arg2, arg3})
+	fake.countActiveTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) CountActiveTokensCallCount() int {
+	fake.countActiveTokensMutex.RLock()
+	defer fake.countActiveTokensMutex.RUnlock()
+	return len(fake.countActiveTokensArgsForCall)
+}
+
+func (fake *FakeDataPersistence) CountActiveTokensCalls(stub func(context.Context, int, time.Time) (int, error)) {
+	fake.countActiveTokensMutex.Lock()
+	defer fake.countActiveTokensMutex.Unlock()
+	fake.CountActiveTokensStub = stub
+}
+
+func (fake *FakeDataPersistence) CountActiveTokensArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.countActiveTokensMutex.RLock()
+	defer fake.countActiveTokensMutex.RUnlock()
+	argsForCall := fake.countActiveTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) CountActiveTokensReturns(result1 int, result2 error) {
+	fake.countActiveTokensMutex.Lock()
+	defer fake.countActiveTokensMutex.Unlock()
+	fake.CountActiveTokensStub = nil
+	fake.countActiveTokensReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) CountActiveTokensReturnsOnCall(i int, result1 int, result2 error) {
+	fake.countActiveTokensMutex.Lock()
+	defer fake.countActiveTokensMutex.Unlock()
+	fake.CountActiveTokensStub = nil
+	if fake.countActiveTokensReturnsOnCall == nil {
+		fake.countActiveTokensReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.countActiveTokensReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) CreateTokenEvent(arg1 context.Context, arg2 *models.NewTokenEvent) error {
+	fake.createTokenEventMutex.Lock()
+	ret, specificReturn := fake.createTokenEventReturnsOnCall[len(fake.createTokenEventArgsForCall)]
+	fake.createTokenEventArgsForCall = append(fake.createTokenEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.NewTokenEvent
+	}{arg1, arg2})
+	stub := fake.CreateTokenEventStub
+	fakeReturns := fake.createTokenEventReturns
+	fake.recordInvocation("CreateTokenEvent", []interface{}{arg1, arg2})
+	fake.createTokenEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) CreateTokenEventCallCount() int {
+	fake.createTokenEventMutex.RLock()
+	defer fake.createTokenEventMutex.RUnlock()
+	return len(fake.createTokenEventArgsForCall)
+}
+
+func (fake *FakeDataPersistence) CreateTokenEventCalls(stub func(context.Context, *models.NewTokenEvent) error) {
+	fake.createTokenEventMutex.Lock()
+	defer fake.createTokenEventMutex.Unlock()
+	fake.CreateTokenEventStub = stub
+}
+
+func (fake *FakeDataPersistence) CreateTokenEventArgsForCall(i int) (context.Context, *models.NewTokenEvent) {
+	fake.createTokenEventMutex.RLock()
+	defer fake.createTokenEventMutex.RUnlock()
+	argsForCall := fake.createTokenEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) CreateTokenEventReturns(result1 error) {
+	fake.createTokenEventMutex.Lock()
+	defer fake.createTokenEventMutex.Unlock()
+	fake.CreateTokenEventStub = nil
+	fake.createTokenEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) CreateTokenEventReturnsOnCall(i int, result1 error) {
+	fake.createTokenEventMutex.Lock()
+	defer fake.createTokenEventMutex.Unlock()
+	fake.CreateTokenEventStub = nil
+	if fake.createTokenEventReturnsOnCall == nil {
+		fake.createTokenEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createTokenEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) ExpireTokens(arg1 context.Context, arg2 time.Time, arg3 int) ([]models.TokenRef, error) {
+	fake.expireTokensMutex.Lock()
+	ret, specificReturn := fake.expireTokensReturnsOnCall[len(fake.expireTokensArgsForCall)]
+	fake.expireTokensArgsForCall = append(fake.expireTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ExpireTokensStub
+	fakeReturns := fake.expireTokensReturns
+	fake.recordInvocation("ExpireTokens", []interface{}{arg1, arg2, arg3})
+	fake.expireTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) ExpireTokensCallCount() int {
+	fake.expireTokensMutex.RLock()
+	defer fake.expireTokensMutex.RUnlock()
+	return len(fake.expireTokensArgsForCall)
+}
+
+func (fake *FakeDataPersistence) ExpireTokensCalls(stub func(context.Context, time.Time, int) ([]models.TokenRef, error)) {
+	fake.expireTokensMutex.Lock()
+	defer fake.expireTokensMutex.Unlock()
+	fake.ExpireTokensStub = stub
+}
+
+func (fake *FakeDataPersistence) ExpireTokensArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.expireTokensMutex.RLock()
+	defer fake.expireTokensMutex.RUnlock()
+	argsForCall := fake.expireTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) ExpireTokensReturns(result1 []models.TokenRef, result2 error) {
+	fake.expireTokensMutex.Lock()
+	defer fake.expireTokensMutex.Unlock()
+	fake.ExpireTokensStub = nil
+	fake.expireTokensReturns = struct {
+		result1 []models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) ExpireTokensReturnsOnCall(i int, result1 []models.TokenRef, result2 error) {
+	fake.expireTokensMutex.Lock()
+	defer fake.expireTokensMutex.Unlock()
+	fake.ExpireTokensStub = nil
+	if fake.expireTokensReturnsOnCall == nil {
+		fake.expireTokensReturnsOnCall = make(map[int]struct {
+			result1 []models.TokenRef
+			result2 error
+		})
+	}
+	fake.expireTokensReturnsOnCall[i] = struct {
+		result1 []models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) Generate(arg1 context.Context, arg2 *models.NewToken, arg3 int, arg4 int) (string, error) {
 	fake.generateMutex.Lock()
 	ret, specificReturn := fake.generateReturnsOnCall[len(fake.generateArgsForCall)]
 	fake.generateArgsForCall = append(fake.generateArgsForCall, struct {
 		arg1 context.Context
-		arg2 int
+		arg2 *models.NewToken
 		arg3 int
 		arg4 int
 	}{arg1, arg2, arg3, arg4})
@@ -108,13 +505,13 @@ func (fake *FakeDataPersistence) GenerateCallCount() int {
 	return len(fake.generateArgsForCall)
 }
 
-func (fake *FakeDataPersistence) GenerateCalls(stub func(context.Context, int, int, int) (string, error)) {
+func (fake *FakeDataPersistence) GenerateCalls(stub func(context.Context, *models.NewToken, int, int) (string, error)) {
 	fake.generateMutex.Lock()
 	defer fake.generateMutex.Unlock()
 	fake.GenerateStub = stub
 }
 
-func (fake *FakeDataPersistence) GenerateArgsForCall(i int) (context.Context, int, int, int) {
+func (fake *FakeDataPersistence) GenerateArgsForCall(i int) (context.Context, *models.NewToken, int, int) {
 	fake.generateMutex.RLock()
 	defer fake.generateMutex.RUnlock()
 	argsForCall := fake.generateArgsForCall[i]
@@ -147,18 +544,87 @@ func (fake *FakeDataPersistence) GenerateReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
-func (fake *FakeDataPersistence) GetAll(arg1 context.Context) ([]models.Token, error) {
+func (fake *FakeDataPersistence) GenerateBatch(arg1 context.Context, arg2 *models.NewToken, arg3 int, arg4 int, arg5 int) ([]string, error) {
+	fake.generateBatchMutex.Lock()
+	ret, specificReturn := fake.generateBatchReturnsOnCall[len(fake.generateBatchArgsForCall)]
+	fake.generateBatchArgsForCall = append(fake.generateBatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.NewToken
+		arg3 int
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GenerateBatchStub
+	fakeReturns := fake.generateBatchReturns
+	fake.recordInvocation("GenerateBatch", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.generateBatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GenerateBatchCallCount() int {
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
+	return len(fake.generateBatchArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GenerateBatchCalls(stub func(context.Context, *models.NewToken, int, int, int) ([]string, error)) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = stub
+}
+
+func (fake *FakeDataPersistence) GenerateBatchArgsForCall(i int) (context.Context, *models.NewToken, int, int, int) {
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
+	argsForCall := fake.generateBatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeDataPersistence) GenerateBatchReturns(result1 []string, result2 error) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = nil
+	fake.generateBatchReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GenerateBatchReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.generateBatchMutex.Lock()
+	defer fake.generateBatchMutex.Unlock()
+	fake.GenerateBatchStub = nil
+	if fake.generateBatchReturnsOnCall == nil {
+		fake.generateBatchReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.generateBatchReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetAll(arg1 context.Context, arg2 *models.TokenQuery) ([]models.Token, error) {
 	fake.getAllMutex.Lock()
 	ret, specificReturn := fake.getAllReturnsOnCall[len(fake.getAllArgsForCall)]
 	fake.getAllArgsForCall = append(fake.getAllArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 *models.TokenQuery
+	}{arg1, arg2})
 	stub := fake.GetAllStub
 	fakeReturns := fake.getAllReturns
-	fake.recordInvocation("GetAll", []interface{}{arg1})
+	fake.recordInvocation("GetAll", []interface{}{arg1, arg2})
 	fake.getAllMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -172,17 +638,17 @@ func (fake *FakeDataPersistence) GetAllCallCount() int {
 	return len(fake.getAllArgsForCall)
 }
 
-func (fake *FakeDataPersistence) GetAllCalls(stub func(context.Context) ([]models.Token, error)) {
+func (fake *FakeDataPersistence) GetAllCalls(stub func(context.Context, *models.TokenQuery) ([]models.Token, error)) {
 	fake.getAllMutex.Lock()
 	defer fake.getAllMutex.Unlock()
 	fake.GetAllStub = stub
 }
 
-func (fake *FakeDataPersistence) GetAllArgsForCall(i int) context.Context {
+func (fake *FakeDataPersistence) GetAllArgsForCall(i int) (context.Context, *models.TokenQuery) {
 	fake.getAllMutex.RLock()
 	defer fake.getAllMutex.RUnlock()
 	argsForCall := fake.getAllArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeDataPersistence) GetAllReturns(result1 []models.Token, result2 error) {
@@ -211,6 +677,71 @@ func (fake *FakeDataPersistence) GetAllReturnsOnCall(i int, result1 []models.Tok
 	}{result1, result2}
 }
 
+func (fake *FakeDataPersistence) GetRevokedTokenIds(arg1 context.Context, arg2 time.Time) ([]int, error) {
+	fake.getRevokedTokenIdsMutex.Lock()
+	ret, specificReturn := fake.getRevokedTokenIdsReturnsOnCall[len(fake.getRevokedTokenIdsArgsForCall)]
+	fake.getRevokedTokenIdsArgsForCall = append(fake.getRevokedTokenIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetRevokedTokenIdsStub
+	fakeReturns := fake.getRevokedTokenIdsReturns
+	fake.recordInvocation("GetRevokedTokenIds", []interface{}{arg1, arg2})
+	fake.getRevokedTokenIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetRevokedTokenIdsCallCount() int {
+	fake.getRevokedTokenIdsMutex.RLock()
+	defer fake.getRevokedTokenIdsMutex.RUnlock()
+	return len(fake.getRevokedTokenIdsArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetRevokedTokenIdsCalls(stub func(context.Context, time.Time) ([]int, error)) {
+	fake.getRevokedTokenIdsMutex.Lock()
+	defer fake.getRevokedTokenIdsMutex.Unlock()
+	fake.GetRevokedTokenIdsStub = stub
+}
+
+func (fake *FakeDataPersistence) GetRevokedTokenIdsArgsForCall(i int) (context.Context, time.Time) {
+	fake.getRevokedTokenIdsMutex.RLock()
+	defer fake.getRevokedTokenIdsMutex.RUnlock()
+	argsForCall := fake.getRevokedTokenIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetRevokedTokenIdsReturns(result1 []int, result2 error) {
+	fake.getRevokedTokenIdsMutex.Lock()
+	defer fake.getRevokedTokenIdsMutex.Unlock()
+	fake.GetRevokedTokenIdsStub = nil
+	fake.getRevokedTokenIdsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetRevokedTokenIdsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.getRevokedTokenIdsMutex.Lock()
+	defer fake.getRevokedTokenIdsMutex.Unlock()
+	fake.GetRevokedTokenIdsStub = nil
+	if fake.getRevokedTokenIdsReturnsOnCall == nil {
+		fake.getRevokedTokenIdsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.getRevokedTokenIdsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDataPersistence) GetToken(arg1 context.Context, arg2 string) (*models.Token, error) {
 	fake.getTokenMutex.Lock()
 	ret, specificReturn := fake.getTokenReturnsOnCall[len(fake.getTokenArgsForCall)]
@@ -276,14 +807,531 @@ func (fake *FakeDataPersistence) GetTokenReturnsOnCall(i int, result1 *models.To
 	}{result1, result2}
 }
 
-func (fake *FakeDataPersistence) RevokeToken(arg1 context.Context, arg2 string) error {
-	fake.revokeTokenMutex.Lock()
-	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
-	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+func (fake *FakeDataPersistence) GetTokenById(arg1 context.Context, arg2 int) (*models.Token, error) {
+	fake.getTokenByIdMutex.Lock()
+	ret, specificReturn := fake.getTokenByIdReturnsOnCall[len(fake.getTokenByIdArgsForCall)]
+	fake.getTokenByIdArgsForCall = append(fake.getTokenByIdArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 int
 	}{arg1, arg2})
-	stub := fake.RevokeTokenStub
+	stub := fake.GetTokenByIdStub
+	fakeReturns := fake.getTokenByIdReturns
+	fake.recordInvocation("GetTokenById", []interface{}{arg1, arg2})
+	fake.getTokenByIdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetTokenByIdCallCount() int {
+	fake.getTokenByIdMutex.RLock()
+	defer fake.getTokenByIdMutex.RUnlock()
+	return len(fake.getTokenByIdArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetTokenByIdCalls(stub func(context.Context, int) (*models.Token, error)) {
+	fake.getTokenByIdMutex.Lock()
+	defer fake.getTokenByIdMutex.Unlock()
+	fake.GetTokenByIdStub = stub
+}
+
+func (fake *FakeDataPersistence) GetTokenByIdArgsForCall(i int) (context.Context, int) {
+	fake.getTokenByIdMutex.RLock()
+	defer fake.getTokenByIdMutex.RUnlock()
+	argsForCall := fake.getTokenByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetTokenByIdReturns(result1 *models.Token, result2 error) {
+	fake.getTokenByIdMutex.Lock()
+	defer fake.getTokenByIdMutex.Unlock()
+	fake.GetTokenByIdStub = nil
+	fake.getTokenByIdReturns = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetTokenByIdReturnsOnCall(i int, result1 *models.Token, result2 error) {
+	fake.getTokenByIdMutex.Lock()
+	defer fake.getTokenByIdMutex.Unlock()
+	fake.GetTokenByIdStub = nil
+	if fake.getTokenByIdReturnsOnCall == nil {
+		fake.getTokenByIdReturnsOnCall = make(map[int]struct {
+			result1 *models.Token
+			result2 error
+		})
+	}
+	fake.getTokenByIdReturnsOnCall[i] = struct {
+		result1 *models.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetTokenEvents(arg1 context.Context, arg2 int) ([]models.TokenEvent, error) {
+	fake.getTokenEventsMutex.Lock()
+	ret, specificReturn := fake.getTokenEventsReturnsOnCall[len(fake.getTokenEventsArgsForCall)]
+	fake.getTokenEventsArgsForCall = append(fake.getTokenEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetTokenEventsStub
+	fakeReturns := fake.getTokenEventsReturns
+	fake.recordInvocation("GetTokenEvents", []interface{}{arg1, arg2})
+	fake.getTokenEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetTokenEventsCallCount() int {
+	fake.getTokenEventsMutex.RLock()
+	defer fake.getTokenEventsMutex.RUnlock()
+	return len(fake.getTokenEventsArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetTokenEventsCalls(stub func(context.Context, int) ([]models.TokenEvent, error)) {
+	fake.getTokenEventsMutex.Lock()
+	defer fake.getTokenEventsMutex.Unlock()
+	fake.GetTokenEventsStub = stub
+}
+
+func (fake *FakeDataPersistence) GetTokenEventsArgsForCall(i int) (context.Context, int) {
+	fake.getTokenEventsMutex.RLock()
+	defer fake.getTokenEventsMutex.RUnlock()
+	argsForCall := fake.getTokenEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetTokenEventsReturns(result1 []models.TokenEvent, result2 error) {
+	fake.getTokenEventsMutex.Lock()
+	defer fake.getTokenEventsMutex.Unlock()
+	fake.GetTokenEventsStub = nil
+	fake.getTokenEventsReturns = struct {
+		result1 []models.TokenEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetTokenEventsReturnsOnCall(i int, result1 []models.TokenEvent, result2 error) {
+	fake.getTokenEventsMutex.Lock()
+	defer fake.getTokenEventsMutex.Unlock()
+	fake.GetTokenEventsStub = nil
+	if fake.getTokenEventsReturnsOnCall == nil {
+		fake.getTokenEventsReturnsOnCall = make(map[int]struct {
+			result1 []models.TokenEvent
+			result2 error
+		})
+	}
+	fake.getTokenEventsReturnsOnCall[i] = struct {
+		result1 []models.TokenEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetTokenScopes(arg1 context.Context, arg2 int) ([]string, error) {
+	fake.getTokenScopesMutex.Lock()
+	ret, specificReturn := fake.getTokenScopesReturnsOnCall[len(fake.getTokenScopesArgsForCall)]
+	fake.getTokenScopesArgsForCall = append(fake.getTokenScopesArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetTokenScopesStub
+	fakeReturns := fake.getTokenScopesReturns
+	fake.recordInvocation("GetTokenScopes", []interface{}{arg1, arg2})
+	fake.getTokenScopesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetTokenScopesCallCount() int {
+	fake.getTokenScopesMutex.RLock()
+	defer fake.getTokenScopesMutex.RUnlock()
+	return len(fake.getTokenScopesArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetTokenScopesCalls(stub func(context.Context, int) ([]string, error)) {
+	fake.getTokenScopesMutex.Lock()
+	defer fake.getTokenScopesMutex.Unlock()
+	fake.GetTokenScopesStub = stub
+}
+
+func (fake *FakeDataPersistence) GetTokenScopesArgsForCall(i int) (context.Context, int) {
+	fake.getTokenScopesMutex.RLock()
+	defer fake.getTokenScopesMutex.RUnlock()
+	argsForCall := fake.getTokenScopesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetTokenScopesReturns(result1 []string, result2 error) {
+	fake.getTokenScopesMutex.Lock()
+	defer fake.getTokenScopesMutex.Unlock()
+	fake.GetTokenScopesStub = nil
+	fake.getTokenScopesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetTokenScopesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getTokenScopesMutex.Lock()
+	defer fake.getTokenScopesMutex.Unlock()
+	fake.GetTokenScopesStub = nil
+	if fake.getTokenScopesReturnsOnCall == nil {
+		fake.getTokenScopesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getTokenScopesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuota(arg1 context.Context, arg2 int) (*int, error) {
+	fake.getUserTokenQuotaMutex.Lock()
+	ret, specificReturn := fake.getUserTokenQuotaReturnsOnCall[len(fake.getUserTokenQuotaArgsForCall)]
+	fake.getUserTokenQuotaArgsForCall = append(fake.getUserTokenQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetUserTokenQuotaStub
+	fakeReturns := fake.getUserTokenQuotaReturns
+	fake.recordInvocation("GetUserTokenQuota", []interface{}{arg1, arg2})
+	fake.getUserTokenQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuotaCallCount() int {
+	fake.getUserTokenQuotaMutex.RLock()
+	defer fake.getUserTokenQuotaMutex.RUnlock()
+	return len(fake.getUserTokenQuotaArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuotaCalls(stub func(context.Context, int) (*int, error)) {
+	fake.getUserTokenQuotaMutex.Lock()
+	defer fake.getUserTokenQuotaMutex.Unlock()
+	fake.GetUserTokenQuotaStub = stub
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuotaArgsForCall(i int) (context.Context, int) {
+	fake.getUserTokenQuotaMutex.RLock()
+	defer fake.getUserTokenQuotaMutex.RUnlock()
+	argsForCall := fake.getUserTokenQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuotaReturns(result1 *int, result2 error) {
+	fake.getUserTokenQuotaMutex.Lock()
+	defer fake.getUserTokenQuotaMutex.Unlock()
+	fake.GetUserTokenQuotaStub = nil
+	fake.getUserTokenQuotaReturns = struct {
+		result1 *int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetUserTokenQuotaReturnsOnCall(i int, result1 *int, result2 error) {
+	fake.getUserTokenQuotaMutex.Lock()
+	defer fake.getUserTokenQuotaMutex.Unlock()
+	fake.GetUserTokenQuotaStub = nil
+	if fake.getUserTokenQuotaReturnsOnCall == nil {
+		fake.getUserTokenQuotaReturnsOnCall = make(map[int]struct {
+			result1 *int
+			result2 error
+		})
+	}
+	fake.getUserTokenQuotaReturnsOnCall[i] = struct {
+		result1 *int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) IterateAll(arg1 context.Context, arg2 *models.TokenQuery, arg3 func(token *models.Token) error) error {
+	fake.iterateAllMutex.Lock()
+	ret, specificReturn := fake.iterateAllReturnsOnCall[len(fake.iterateAllArgsForCall)]
+	fake.iterateAllArgsForCall = append(fake.iterateAllArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.TokenQuery
+		arg3 func(token *models.Token) error
+	}{arg1, arg2, arg3})
+	stub := fake.IterateAllStub
+	fakeReturns := fake.iterateAllReturns
+	fake.recordInvocation("IterateAll", []interface{}{arg1, arg2, arg3})
+	fake.iterateAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) IterateAllCallCount() int {
+	fake.iterateAllMutex.RLock()
+	defer fake.iterateAllMutex.RUnlock()
+	return len(fake.iterateAllArgsForCall)
+}
+
+func (fake *FakeDataPersistence) IterateAllCalls(stub func(context.Context, *models.TokenQuery, func(token *models.Token) error) error) {
+	fake.iterateAllMutex.Lock()
+	defer fake.iterateAllMutex.Unlock()
+	fake.IterateAllStub = stub
+}
+
+func (fake *FakeDataPersistence) IterateAllArgsForCall(i int) (context.Context, *models.TokenQuery, func(token *models.Token) error) {
+	fake.iterateAllMutex.RLock()
+	defer fake.iterateAllMutex.RUnlock()
+	argsForCall := fake.iterateAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) IterateAllReturns(result1 error) {
+	fake.iterateAllMutex.Lock()
+	defer fake.iterateAllMutex.Unlock()
+	fake.IterateAllStub = nil
+	fake.iterateAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) IterateAllReturnsOnCall(i int, result1 error) {
+	fake.iterateAllMutex.Lock()
+	defer fake.iterateAllMutex.Unlock()
+	fake.IterateAllStub = nil
+	if fake.iterateAllReturnsOnCall == nil {
+		fake.iterateAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.iterateAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) RedeemToken(arg1 context.Context, arg2 int) (bool, error) {
+	fake.redeemTokenMutex.Lock()
+	ret, specificReturn := fake.redeemTokenReturnsOnCall[len(fake.redeemTokenArgsForCall)]
+	fake.redeemTokenArgsForCall = append(fake.redeemTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RedeemTokenStub
+	fakeReturns := fake.redeemTokenReturns
+	fake.recordInvocation("RedeemToken", []interface{}{arg1, arg2})
+	fake.redeemTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) RedeemTokenCallCount() int {
+	fake.redeemTokenMutex.RLock()
+	defer fake.redeemTokenMutex.RUnlock()
+	return len(fake.redeemTokenArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RedeemTokenCalls(stub func(context.Context, int) (bool, error)) {
+	fake.redeemTokenMutex.Lock()
+	defer fake.redeemTokenMutex.Unlock()
+	fake.RedeemTokenStub = stub
+}
+
+func (fake *FakeDataPersistence) RedeemTokenArgsForCall(i int) (context.Context, int) {
+	fake.redeemTokenMutex.RLock()
+	defer fake.redeemTokenMutex.RUnlock()
+	argsForCall := fake.redeemTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) RedeemTokenReturns(result1 bool, result2 error) {
+	fake.redeemTokenMutex.Lock()
+	defer fake.redeemTokenMutex.Unlock()
+	fake.RedeemTokenStub = nil
+	fake.redeemTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RedeemTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.redeemTokenMutex.Lock()
+	defer fake.redeemTokenMutex.Unlock()
+	fake.RedeemTokenStub = nil
+	if fake.redeemTokenReturnsOnCall == nil {
+		fake.redeemTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.redeemTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RevokeCampaign(arg1 context.Context, arg2 int) error {
+	fake.revokeCampaignMutex.Lock()
+	ret, specificReturn := fake.revokeCampaignReturnsOnCall[len(fake.revokeCampaignArgsForCall)]
+	fake.revokeCampaignArgsForCall = append(fake.revokeCampaignArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RevokeCampaignStub
+	fakeReturns := fake.revokeCampaignReturns
+	fake.recordInvocation("RevokeCampaign", []interface{}{arg1, arg2})
+	fake.revokeCampaignMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignCallCount() int {
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	return len(fake.revokeCampaignArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignCalls(stub func(context.Context, int) error) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = stub
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignArgsForCall(i int) (context.Context, int) {
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	argsForCall := fake.revokeCampaignArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignReturns(result1 error) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = nil
+	fake.revokeCampaignReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignReturnsOnCall(i int, result1 error) {
+	fake.revokeCampaignMutex.Lock()
+	defer fake.revokeCampaignMutex.Unlock()
+	fake.RevokeCampaignStub = nil
+	if fake.revokeCampaignReturnsOnCall == nil {
+		fake.revokeCampaignReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeCampaignReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokens(arg1 context.Context, arg2 int, arg3 int, arg4 int) ([]models.TokenRef, error) {
+	fake.revokeCampaignTokensMutex.Lock()
+	ret, specificReturn := fake.revokeCampaignTokensReturnsOnCall[len(fake.revokeCampaignTokensArgsForCall)]
+	fake.revokeCampaignTokensArgsForCall = append(fake.revokeCampaignTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeCampaignTokensStub
+	fakeReturns := fake.revokeCampaignTokensReturns
+	fake.recordInvocation("RevokeCampaignTokens", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeCampaignTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokensCallCount() int {
+	fake.revokeCampaignTokensMutex.RLock()
+	defer fake.revokeCampaignTokensMutex.RUnlock()
+	return len(fake.revokeCampaignTokensArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokensCalls(stub func(context.Context, int, int, int) ([]models.TokenRef, error)) {
+	fake.revokeCampaignTokensMutex.Lock()
+	defer fake.revokeCampaignTokensMutex.Unlock()
+	fake.RevokeCampaignTokensStub = stub
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokensArgsForCall(i int) (context.Context, int, int, int) {
+	fake.revokeCampaignTokensMutex.RLock()
+	defer fake.revokeCampaignTokensMutex.RUnlock()
+	argsForCall := fake.revokeCampaignTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokensReturns(result1 []models.TokenRef, result2 error) {
+	fake.revokeCampaignTokensMutex.Lock()
+	defer fake.revokeCampaignTokensMutex.Unlock()
+	fake.RevokeCampaignTokensStub = nil
+	fake.revokeCampaignTokensReturns = struct {
+		result1 []models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RevokeCampaignTokensReturnsOnCall(i int, result1 []models.TokenRef, result2 error) {
+	fake.revokeCampaignTokensMutex.Lock()
+	defer fake.revokeCampaignTokensMutex.Unlock()
+	fake.RevokeCampaignTokensStub = nil
+	if fake.revokeCampaignTokensReturnsOnCall == nil {
+		fake.revokeCampaignTokensReturnsOnCall = make(map[int]struct {
+			result1 []models.TokenRef
+			result2 error
+		})
+	}
+	fake.revokeCampaignTokensReturnsOnCall[i] = struct {
+		result1 []models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RevokeToken(arg1 context.Context, arg2 string) (*models.TokenRef, error) {
+	fake.revokeTokenMutex.Lock()
+	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
+	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeTokenStub
 	fakeReturns := fake.revokeTokenReturns
 	fake.recordInvocation("RevokeToken", []interface{}{arg1, arg2})
 	fake.revokeTokenMutex.Unlock()
@@ -291,9 +1339,9 @@ func (fake *FakeDataPersistence) RevokeToken(arg1 context.Context, arg2 string)
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeDataPersistence) RevokeTokenCallCount() int {
@@ -302,7 +1350,7 @@ func (fake *FakeDataPersistence) RevokeTokenCallCount() int {
 	return len(fake.revokeTokenArgsForCall)
 }
 
-func (fake *FakeDataPersistence) RevokeTokenCalls(stub func(context.Context, string) error) {
+func (fake *FakeDataPersistence) RevokeTokenCalls(stub func(context.Context, string) (*models.TokenRef, error)) {
 	fake.revokeTokenMutex.Lock()
 	defer fake.revokeTokenMutex.Unlock()
 	fake.RevokeTokenStub = stub
@@ -315,87 +1363,156 @@ func (fake *FakeDataPersistence) RevokeTokenArgsForCall(i int) (context.Context,
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDataPersistence) RevokeTokenReturns(result1 error) {
+func (fake *FakeDataPersistence) RevokeTokenReturns(result1 *models.TokenRef, result2 error) {
 	fake.revokeTokenMutex.Lock()
 	defer fake.revokeTokenMutex.Unlock()
 	fake.RevokeTokenStub = nil
 	fake.revokeTokenReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.TokenRef
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDataPersistence) RevokeTokenReturnsOnCall(i int, result1 error) {
+func (fake *FakeDataPersistence) RevokeTokenReturnsOnCall(i int, result1 *models.TokenRef, result2 error) {
 	fake.revokeTokenMutex.Lock()
 	defer fake.revokeTokenMutex.Unlock()
 	fake.RevokeTokenStub = nil
 	if fake.revokeTokenReturnsOnCall == nil {
 		fake.revokeTokenReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 *models.TokenRef
+			result2 error
 		})
 	}
 	fake.revokeTokenReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 *models.TokenRef
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpired(arg1 context.Context, arg2 *models.Token) error {
-	fake.updateTokenToExpiredMutex.Lock()
-	ret, specificReturn := fake.updateTokenToExpiredReturnsOnCall[len(fake.updateTokenToExpiredArgsForCall)]
-	fake.updateTokenToExpiredArgsForCall = append(fake.updateTokenToExpiredArgsForCall, struct {
+func (fake *FakeDataPersistence) RevokeTokenById(arg1 context.Context, arg2 int) (*models.TokenRef, error) {
+	fake.revokeTokenByIdMutex.Lock()
+	ret, specificReturn := fake.revokeTokenByIdReturnsOnCall[len(fake.revokeTokenByIdArgsForCall)]
+	fake.revokeTokenByIdArgsForCall = append(fake.revokeTokenByIdArgsForCall, struct {
 		arg1 context.Context
-		arg2 *models.Token
+		arg2 int
 	}{arg1, arg2})
-	stub := fake.UpdateTokenToExpiredStub
-	fakeReturns := fake.updateTokenToExpiredReturns
-	fake.recordInvocation("UpdateTokenToExpired", []interface{}{arg1, arg2})
-	fake.updateTokenToExpiredMutex.Unlock()
+	stub := fake.RevokeTokenByIdStub
+	fakeReturns := fake.revokeTokenByIdReturns
+	fake.recordInvocation("RevokeTokenById", []interface{}{arg1, arg2})
+	fake.revokeTokenByIdMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) RevokeTokenByIdCallCount() int {
+	fake.revokeTokenByIdMutex.RLock()
+	defer fake.revokeTokenByIdMutex.RUnlock()
+	return len(fake.revokeTokenByIdArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RevokeTokenByIdCalls(stub func(context.Context, int) (*models.TokenRef, error)) {
+	fake.revokeTokenByIdMutex.Lock()
+	defer fake.revokeTokenByIdMutex.Unlock()
+	fake.RevokeTokenByIdStub = stub
+}
+
+func (fake *FakeDataPersistence) RevokeTokenByIdArgsForCall(i int) (context.Context, int) {
+	fake.revokeTokenByIdMutex.RLock()
+	defer fake.revokeTokenByIdMutex.RUnlock()
+	argsForCall := fake.revokeTokenByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) RevokeTokenByIdReturns(result1 *models.TokenRef, result2 error) {
+	fake.revokeTokenByIdMutex.Lock()
+	defer fake.revokeTokenByIdMutex.Unlock()
+	fake.RevokeTokenByIdStub = nil
+	fake.revokeTokenByIdReturns = struct {
+		result1 *models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RevokeTokenByIdReturnsOnCall(i int, result1 *models.TokenRef, result2 error) {
+	fake.revokeTokenByIdMutex.Lock()
+	defer fake.revokeTokenByIdMutex.Unlock()
+	fake.RevokeTokenByIdStub = nil
+	if fake.revokeTokenByIdReturnsOnCall == nil {
+		fake.revokeTokenByIdReturnsOnCall = make(map[int]struct {
+			result1 *models.TokenRef
+			result2 error
+		})
+	}
+	fake.revokeTokenByIdReturnsOnCall[i] = struct {
+		result1 *models.TokenRef
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) UpdateToken(arg1 context.Context, arg2 int, arg3 *models.TokenChanges) error {
+	fake.updateTokenMutex.Lock()
+	ret, specificReturn := fake.updateTokenReturnsOnCall[len(fake.updateTokenArgsForCall)]
+	fake.updateTokenArgsForCall = append(fake.updateTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.TokenChanges
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTokenStub
+	fakeReturns := fake.updateTokenReturns
+	fake.recordInvocation("UpdateToken", []interface{}{arg1, arg2, arg3})
+	fake.updateTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
 	if specificReturn {
 		return ret.result1
 	}
 	return fakeReturns.result1
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpiredCallCount() int {
-	fake.updateTokenToExpiredMutex.RLock()
-	defer fake.updateTokenToExpiredMutex.RUnlock()
-	return len(fake.updateTokenToExpiredArgsForCall)
+func (fake *FakeDataPersistence) UpdateTokenCallCount() int {
+	fake.updateTokenMutex.RLock()
+	defer fake.updateTokenMutex.RUnlock()
+	return len(fake.updateTokenArgsForCall)
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpiredCalls(stub func(context.Context, *models.Token) error) {
-	fake.updateTokenToExpiredMutex.Lock()
-	defer fake.updateTokenToExpiredMutex.Unlock()
-	fake.UpdateTokenToExpiredStub = stub
+func (fake *FakeDataPersistence) UpdateTokenCalls(stub func(context.Context, int, *models.TokenChanges) error) {
+	fake.updateTokenMutex.Lock()
+	defer fake.updateTokenMutex.Unlock()
+	fake.UpdateTokenStub = stub
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpiredArgsForCall(i int) (context.Context, *models.Token) {
-	fake.updateTokenToExpiredMutex.RLock()
-	defer fake.updateTokenToExpiredMutex.RUnlock()
-	argsForCall := fake.updateTokenToExpiredArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDataPersistence) UpdateTokenArgsForCall(i int) (context.Context, int, *models.TokenChanges) {
+	fake.updateTokenMutex.RLock()
+	defer fake.updateTokenMutex.RUnlock()
+	argsForCall := fake.updateTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpiredReturns(result1 error) {
-	fake.updateTokenToExpiredMutex.Lock()
-	defer fake.updateTokenToExpiredMutex.Unlock()
-	fake.UpdateTokenToExpiredStub = nil
-	fake.updateTokenToExpiredReturns = struct {
+func (fake *FakeDataPersistence) UpdateTokenReturns(result1 error) {
+	fake.updateTokenMutex.Lock()
+	defer fake.updateTokenMutex.Unlock()
+	fake.UpdateTokenStub = nil
+	fake.updateTokenReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeDataPersistence) UpdateTokenToExpiredReturnsOnCall(i int, result1 error) {
-	fake.updateTokenToExpiredMutex.Lock()
-	defer fake.updateTokenToExpiredMutex.Unlock()
-	fake.UpdateTokenToExpiredStub = nil
-	if fake.updateTokenToExpiredReturnsOnCall == nil {
-		fake.updateTokenToExpiredReturnsOnCall = make(map[int]struct {
+func (fake *FakeDataPersistence) UpdateTokenReturnsOnCall(i int, result1 error) {
+	fake.updateTokenMutex.Lock()
+	defer fake.updateTokenMutex.Unlock()
+	fake.UpdateTokenStub = nil
+	if fake.updateTokenReturnsOnCall == nil {
+		fake.updateTokenReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.updateTokenToExpiredReturnsOnCall[i] = struct {
+	fake.updateTokenReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -403,16 +1520,44 @@ func (fake *FakeDataPersistence) UpdateTokenToExpiredReturnsOnCall(i int, result
 func (fake *FakeDataPersistence) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.countActiveTokensMutex.RLock()
+	defer fake.countActiveTokensMutex.RUnlock()
+	fake.createTokenEventMutex.RLock()
+	defer fake.createTokenEventMutex.RUnlock()
+	fake.expireTokensMutex.RLock()
+	defer fake.expireTokensMutex.RUnlock()
 	fake.generateMutex.RLock()
 	defer fake.generateMutex.RUnlock()
+	fake.generateBatchMutex.RLock()
+	defer fake.generateBatchMutex.RUnlock()
 	fake.getAllMutex.RLock()
 	defer fake.getAllMutex.RUnlock()
+	fake.getRevokedTokenIdsMutex.RLock()
+	defer fake.getRevokedTokenIdsMutex.RUnlock()
 	fake.getTokenMutex.RLock()
 	defer fake.getTokenMutex.RUnlock()
+	fake.getTokenByIdMutex.RLock()
+	defer fake.getTokenByIdMutex.RUnlock()
+	fake.getTokenEventsMutex.RLock()
+	defer fake.getTokenEventsMutex.RUnlock()
+	fake.getTokenScopesMutex.RLock()
+	defer fake.getTokenScopesMutex.RUnlock()
+	fake.getUserTokenQuotaMutex.RLock()
+	defer fake.getUserTokenQuotaMutex.RUnlock()
+	fake.iterateAllMutex.RLock()
+	defer fake.iterateAllMutex.RUnlock()
+	fake.redeemTokenMutex.RLock()
+	defer fake.redeemTokenMutex.RUnlock()
+	fake.revokeCampaignMutex.RLock()
+	defer fake.revokeCampaignMutex.RUnlock()
+	fake.revokeCampaignTokensMutex.RLock()
+	defer fake.revokeCampaignTokensMutex.RUnlock()
 	fake.revokeTokenMutex.RLock()
 	defer fake.revokeTokenMutex.RUnlock()
-	fake.updateTokenToExpiredMutex.RLock()
-	defer fake.updateTokenToExpiredMutex.RUnlock()
+	fake.revokeTokenByIdMutex.RLock()
+	defer fake.revokeTokenByIdMutex.RUnlock()
+	fake.updateTokenMutex.RLock()
+	defer fake.updateTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
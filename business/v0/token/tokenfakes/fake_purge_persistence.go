@@ -0,0 +1,129 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package tokenfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"platform_engineer_clone/models"
+)
+
+type FakePurgePersistence struct {
+	PurgeTokensStub        func(context.Context, time.Time, int, int, func(tokens []models.ArchivedToken) error) (int64, int, error)
+	purgeTokensMutex       sync.RWMutex
+	purgeTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 int
+		arg5 func(tokens []models.ArchivedToken) error
+	}
+	purgeTokensReturns struct {
+		result1 int64
+		result2 int
+		result3 error
+	}
+	purgeTokensReturnsOnCall map[int]struct {
+		result1 int64
+		result2 int
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePurgePersistence) PurgeTokens(arg1 context.Context, arg2 time.Time, arg3 int, arg4 int, arg5 func(tokens []models.ArchivedToken) error) (int64, int, error) {
+	fake.purgeTokensMutex.Lock()
+	ret, specificReturn := fake.purgeTokensReturnsOnCall[len(fake.purgeTokensArgsForCall)]
+	fake.purgeTokensArgsForCall = append(fake.purgeTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 int
+		arg5 func(tokens []models.ArchivedToken) error
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.PurgeTokensStub
+	fakeReturns := fake.purgeTokensReturns
+	fake.recordInvocation("PurgeTokens", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.purgeTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePurgePersistence) PurgeTokensCallCount() int {
+	fake.purgeTokensMutex.RLock()
+	defer fake.purgeTokensMutex.RUnlock()
+	return len(fake.purgeTokensArgsForCall)
+}
+
+func (fake *FakePurgePersistence) PurgeTokensCalls(stub func(context.Context, time.Time, int, int, func(tokens []models.ArchivedToken) error) (int64, int, error)) {
+	fake.purgeTokensMutex.Lock()
+	defer fake.purgeTokensMutex.Unlock()
+	fake.PurgeTokensStub = stub
+}
+
+func (fake *FakePurgePersistence) PurgeTokensArgsForCall(i int) (context.Context, time.Time, int, int, func(tokens []models.ArchivedToken) error) {
+	fake.purgeTokensMutex.RLock()
+	defer fake.purgeTokensMutex.RUnlock()
+	argsForCall := fake.purgeTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePurgePersistence) PurgeTokensReturns(result1 int64, result2 int, result3 error) {
+	fake.purgeTokensMutex.Lock()
+	defer fake.purgeTokensMutex.Unlock()
+	fake.PurgeTokensStub = nil
+	fake.purgeTokensReturns = struct {
+		result1 int64
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePurgePersistence) PurgeTokensReturnsOnCall(i int, result1 int64, result2 int, result3 error) {
+	fake.purgeTokensMutex.Lock()
+	defer fake.purgeTokensMutex.Unlock()
+	fake.PurgeTokensStub = nil
+	if fake.purgeTokensReturnsOnCall == nil {
+		fake.purgeTokensReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 int
+			result3 error
+		})
+	}
+	fake.purgeTokensReturnsOnCall[i] = struct {
+		result1 int64
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePurgePersistence) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.purgeTokensMutex.RLock()
+	defer fake.purgeTokensMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePurgePersistence) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/signing"
+	"strconv"
+	"time"
+)
+
+// The headers every delivery is sent with. Receivers verify the signature over "<timestamp>.<body>",
+// and can use the delivery id to drop the duplicates at least once delivery allows.
+const (
+	HeaderDeliveryId = "X-Webhook-Id"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+)
+
+// maxErrorLength fits the last error into its column
+const maxErrorLength = 1024
+
+var (
+	errClaimDeliveries = errors.New("error, claiming due webhook deliveries fails")
+	errRecordAttempt   = errors.New("error, recording webhook delivery attempt fails")
+)
+
+// DeliverPending sends the due deliveries, and records the outcome of each.
+// It runs periodically in the background, and failures are logged for the next run to retry.
+func (b *BusinessWebhook) DeliverPending(ctx context.Context) {
+	logger := common.GetLogger(ctx)
+	// Deliveries are sent one after another, so the batch is leased for as long as sending all of them may take
+	lease := b.timeout * time.Duration(b.batchSize+1)
+	due, err := b.dataLayer.ClaimDueDeliveries(ctx, time.Now(), b.batchSize, lease)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"err": errors.Wrap(err, errClaimDeliveries.Error()),
+		}).Error("error_deliver_webhooks")
+		return
+	}
+
+	delivered := 0
+	for i := range due {
+		attempt := b.deliver(ctx, &due[i])
+		if attempt.Status == models.WebhookDeliveryDelivered {
+			delivered++
+		}
+		if err = b.dataLayer.RecordDeliveryAttempt(ctx, due[i].Id, attempt); err != nil {
+			logger.WithFields(logrus.Fields{
+				"err":         errors.Wrap(err, errRecordAttempt.Error()),
+				"delivery_id": due[i].Id,
+			}).Error("error_deliver_webhooks")
+		}
+	}
+	if len(due) > 0 {
+		logger.WithFields(logrus.Fields{
+			"due":       len(due),
+			"delivered": delivered,
+		}).Info("deliver_webhooks")
+	}
+}
+
+// deliver posts the signed payload, and returns the outcome to record.
+// Any 2xx response acknowledges the delivery, and anything else is retried.
+func (b *BusinessWebhook) deliver(ctx context.Context, delivery *models.DueWebhookDelivery) *models.WebhookDeliveryAttempt {
+	now := time.Now()
+	attempt := models.WebhookDeliveryAttempt{
+		Attempts:    delivery.Attempts + 1,
+		AttemptedAt: now,
+	}
+
+	status, err := b.post(ctx, delivery, now)
+	if status != 0 {
+		attempt.ResponseStatus = &status
+	}
+	switch {
+	case err == nil:
+		attempt.Status = models.WebhookDeliveryDelivered
+		attempt.NextAttemptAt = now
+		return &attempt
+	case attempt.Attempts >= b.maxAttempts:
+		attempt.Status = models.WebhookDeliveryFailed
+		attempt.NextAttemptAt = now
+	default:
+		attempt.Status = models.WebhookDeliveryPending
+		attempt.NextAttemptAt = now.Add(b.backoff(attempt.Attempts))
+	}
+	attempt.Error = err.Error()
+	if len(attempt.Error) > maxErrorLength {
+		attempt.Error = attempt.Error[:maxErrorLength]
+	}
+	return &attempt
+}
+
+// post sends the delivery, and returns the response status, which is 0 when no response was received
+func (b *BusinessWebhook) post(ctx context.Context, delivery *models.DueWebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDeliveryId, strconv.Itoa(delivery.Id))
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, signing.SignPayload(delivery.Secret, now, body))
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// Drain a little of the body, so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("receiver responded %v", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+// backoff returns how long to wait after the given number of failed attempts,
+// doubling from the base with every attempt, up to the max
+func (b *BusinessWebhook) backoff(attempts int) time.Duration {
+	backoff := b.backoffBase
+	for i := 1; i < attempts && backoff < b.backoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > b.backoffMax {
+		return b.backoffMax
+	}
+	return backoff
+}
@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"platform_engineer_clone/business/v0/webhook/webhookfakes"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/signing"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBusinessWebhook_DeliverPending_HappyPath(t *testing.T) {
+	var verified bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		verified = r.Header.Get(HeaderDeliveryId) == "7" &&
+			r.Header.Get(HeaderEvent) == models.TokenLifecycleCreated &&
+			signing.VerifyPayload("whsec_test", time.Unix(ts, 0), body, r.Header.Get(HeaderSignature))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.ClaimDueDeliveriesReturns([]models.DueWebhookDelivery{{
+		Id: 7, EventType: models.TokenLifecycleCreated, Payload: `{"type":"token.created"}`, Url: receiver.URL, Secret: "whsec_test",
+	}}, nil)
+
+	newTestWebhook(&fakeDataPersistence).DeliverPending(context.Background())
+	t.Run("Test DeliverPending - Happy Path", func(t *testing.T) {
+		assert.True(t, verified)
+		_, _, limit, _ := fakeDataPersistence.ClaimDueDeliveriesArgsForCall(0)
+		assert.Equal(t, 50, limit)
+
+		require.Equal(t, 1, fakeDataPersistence.RecordDeliveryAttemptCallCount())
+		_, id, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(0)
+		assert.Equal(t, 7, id)
+		assert.Equal(t, models.WebhookDeliveryDelivered, attempt.Status)
+		assert.Equal(t, 1, attempt.Attempts)
+		assert.Equal(t, http.StatusNoContent, *attempt.ResponseStatus)
+		assert.Empty(t, attempt.Error)
+	})
+}
+
+func TestBusinessWebhook_DeliverPending_Retries(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.ClaimDueDeliveriesReturns([]models.DueWebhookDelivery{
+		{Id: 7, Attempts: 1, Payload: "{}", Url: receiver.URL, Secret: "whsec_test"},
+		{Id: 8, Attempts: 2, Payload: "{}", Url: receiver.URL, Secret: "whsec_test"},
+	}, nil)
+
+	newTestWebhook(&fakeDataPersistence).DeliverPending(context.Background())
+	t.Run("Test DeliverPending - Retries With Backoff", func(t *testing.T) {
+		require.Equal(t, 2, fakeDataPersistence.RecordDeliveryAttemptCallCount())
+		_, _, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(0)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Equal(t, 2, attempt.Attempts)
+		assert.Equal(t, http.StatusServiceUnavailable, *attempt.ResponseStatus)
+		assert.Contains(t, attempt.Error, "503")
+		assert.WithinDuration(t, attempt.AttemptedAt.Add(2*time.Minute), attempt.NextAttemptAt, time.Second)
+	})
+
+	t.Run("Test DeliverPending - Fails After Max Attempts", func(t *testing.T) {
+		_, _, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(1)
+		assert.Equal(t, models.WebhookDeliveryFailed, attempt.Status)
+		assert.Equal(t, 3, attempt.Attempts)
+	})
+}
+
+func TestBusinessWebhook_DeliverPending_Unreachable(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.ClaimDueDeliveriesReturns([]models.DueWebhookDelivery{{Id: 7, Payload: "{}", Url: url}}, nil)
+
+	newTestWebhook(&fakeDataPersistence).DeliverPending(context.Background())
+	t.Run("Test DeliverPending - Unreachable", func(t *testing.T) {
+		_, _, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(0)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Nil(t, attempt.ResponseStatus)
+		assert.NotEmpty(t, attempt.Error)
+	})
+}
+
+func TestBusinessWebhook_DeliverPending_NonPublicAddressRefused(t *testing.T) {
+	var received bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.ClaimDueDeliveriesReturns([]models.DueWebhookDelivery{{Id: 7, Payload: "{}", Url: receiver.URL}}, nil)
+
+	// Delivers only to public addresses, unlike newTestWebhook
+	NewBusinessWebhook(&fakeDataPersistence, 50, 3, time.Minute, time.Hour, time.Second).DeliverPending(context.Background())
+	t.Run("Test DeliverPending - Non Public Address Refused", func(t *testing.T) {
+		assert.False(t, received)
+		_, _, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(0)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Nil(t, attempt.ResponseStatus)
+		assert.Contains(t, attempt.Error, "isn't a public address")
+	})
+}
+
+func TestBusinessWebhook_DeliverPending_RedirectNotFollowed(t *testing.T) {
+	var redirected bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hooks", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	})
+	receiver := httptest.NewServer(mux)
+	defer receiver.Close()
+
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.ClaimDueDeliveriesReturns([]models.DueWebhookDelivery{{Id: 7, Payload: "{}", Url: receiver.URL + "/hooks"}}, nil)
+
+	newTestWebhook(&fakeDataPersistence).DeliverPending(context.Background())
+	t.Run("Test DeliverPending - Redirect Not Followed", func(t *testing.T) {
+		assert.False(t, redirected)
+		_, _, attempt := fakeDataPersistence.RecordDeliveryAttemptArgsForCall(0)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Equal(t, http.StatusTemporaryRedirect, *attempt.ResponseStatus)
+	})
+}
+
+func TestBusinessWebhook_Backoff(t *testing.T) {
+	businessWebhook := NewBusinessWebhook(nil, 50, 8, 30*time.Second, 5*time.Minute, time.Second)
+	t.Run("Test Backoff - Doubles Up To The Max", func(t *testing.T) {
+		assert.Equal(t, 30*time.Second, businessWebhook.backoff(1))
+		assert.Equal(t, time.Minute, businessWebhook.backoff(2))
+		assert.Equal(t, 4*time.Minute, businessWebhook.backoff(4))
+		assert.Equal(t, 5*time.Minute, businessWebhook.backoff(5))
+		assert.Equal(t, 5*time.Minute, businessWebhook.backoff(40))
+	})
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// hostResolver resolves a webhook's host, to check it's public before subscribing it
+type hostResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// nonPublicNets are the reserved ranges that net.IP doesn't already tell apart, such as carrier-grade NAT,
+// and NAT64, which could reach a non public IPv4 address
+var nonPublicNets = parseCIDRs(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// publicIP tells if the IP is reachable on the internet, rather than loopback, link-local or private
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, ipNet := range nonPublicNets {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkHost rejects webhook urls whose host isn't public, or resolves to any address that isn't
+func (b *BusinessWebhook) checkHost(ctx context.Context, rawUrl string) error {
+	webhookUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return ErrInvalidWebhookParams.Detail(err.Error())
+	}
+	host := webhookUrl.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !publicIP(ip) {
+			return ErrWebhookHostNotAllowed.Detail(fmt.Sprintf("%v isn't a public address", host))
+		}
+		return nil
+	}
+
+	addrs, err := b.resolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return ErrWebhookHostNotAllowed.Detail(fmt.Sprintf("%v doesn't resolve", host))
+	}
+	for _, addr := range addrs {
+		if !publicIP(addr.IP) {
+			return ErrWebhookHostNotAllowed.Detail(fmt.Sprintf("%v resolves to %v, which isn't a public address", host, addr.IP))
+		}
+	}
+	return nil
+}
+
+// dialPublicOnly is the net.Dialer Control refusing to connect to addresses that aren't public.
+// It checks the address actually dialed, so a host resolving elsewhere since it was subscribed is caught too.
+func dialPublicOnly(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+		return fmt.Errorf("refusing to connect to %v, which isn't a public address", host)
+	}
+	return nil
+}
+
+// newDeliveryClient returns the client deliveries are posted with. Connections are checked with control,
+// proxies from the environment aren't used, as they would connect on the client's behalf, and redirects
+// aren't followed, so receivers can't send deliveries elsewhere. A redirect fails the delivery like any non 2xx.
+func newDeliveryClient(timeout time.Duration, control func(network, address string, c syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
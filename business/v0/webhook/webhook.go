@@ -0,0 +1,215 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/friendsofgo/errors"
+	"net"
+	"net/http"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/error_handling"
+	"platform_engineer_clone/src/utils/validation"
+	"strings"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . dataPersistence
+type dataPersistence interface {
+	CreateWebhook(ctx context.Context, newWebhook *models.NewWebhook) (*models.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]models.Webhook, error)
+	GetWebhook(ctx context.Context, id int) (*models.Webhook, error)
+	UpdateWebhook(ctx context.Context, id int, changes *models.WebhookChanges) error
+	DeleteWebhook(ctx context.Context, id int) error
+	EnqueueDeliveries(ctx context.Context, eventType string, payload string, now time.Time) (int64, error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]models.DueWebhookDelivery, error)
+	RecordDeliveryAttempt(ctx context.Context, id int, attempt *models.WebhookDeliveryAttempt) error
+	GetDeliveries(ctx context.Context, webhookId int, limit int) ([]models.WebhookDelivery, error)
+}
+
+// secretPrefix makes webhook secrets recognisable, e.g. when they leak into logs or repositories
+const secretPrefix = "whsec_"
+
+// deliveriesLimit caps how many of a webhook's deliveries are listed, most recent first
+const deliveriesLimit = 100
+
+// BusinessWebhook manages webhooks, queues token lifecycle events for them, and delivers them signed.
+// Deliveries are persisted before they are sent, so they survive restarts, and failed
+// deliveries are retried with an exponential backoff until they run out of attempts.
+type BusinessWebhook struct {
+	dataLayer   dataPersistence
+	resolver    hostResolver
+	client      *http.Client
+	batchSize   int
+	maxAttempts int
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	timeout     time.Duration
+}
+
+// These errors are caused by the request, and carry the status and code the API renders them with
+var (
+	ErrInvalidWebhookParams  = error_handling.BadRequest("invalid_webhook_params", "error, invalid webhook params")
+	ErrNoWebhookChanges      = error_handling.BadRequest("no_webhook_changes", "error, at least one of url, events or active must be provided")
+	ErrWebhookHostNotAllowed = error_handling.BadRequest("webhook_host_not_allowed", "error, webhook url must point to a public host")
+)
+
+var (
+	errCreateWebhook         = errors.New("error, creating webhook fails")
+	errDeleteWebhook         = errors.New("error, deleting webhook fails")
+	errEncodeEvent           = errors.New("error, encoding event fails")
+	errEnqueueDeliveries     = errors.New("error, enqueueing webhook deliveries fails")
+	errGenerateSecret        = errors.New("error, generating webhook secret fails")
+	errGetDeliveries         = errors.New("error, get webhook deliveries fails")
+	errGetWebhook            = errors.New("error, get webhook fails")
+	errGetWebhooks           = errors.New("error, get all webhooks fails")
+	errUpdateWebhook         = errors.New("error, updating webhook fails")
+	errValidateWebhookParams = errors.New("error, validating webhook params fails")
+)
+
+// Create subscribes the URL to the events, and returns the webhook with the secret its deliveries are signed with.
+// The secret is only ever returned here. URLs pointing at loopback, link-local or private addresses are rejected.
+func (b *BusinessWebhook) Create(ctx context.Context, user *models.User, params *models.CreateWebhook) (*models.CreatedWebhook, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	if err := b.checkHost(ctx, params.Url); err != nil {
+		return nil, err
+	}
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := b.dataLayer.CreateWebhook(ctx, &models.NewWebhook{
+		Url:       params.Url,
+		Secret:    secret,
+		Events:    params.Events,
+		CreatedBy: user.Id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateWebhook.Error())
+	}
+	return &models.CreatedWebhook{Webhook: *webhook, Secret: secret}, nil
+}
+
+// GetAll returns every webhook, without their secrets
+func (b *BusinessWebhook) GetAll(ctx context.Context) ([]models.Webhook, error) {
+	webhooks, err := b.dataLayer.GetWebhooks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetWebhooks.Error())
+	}
+	return webhooks, nil
+}
+
+// Update changes the webhook's url or events, or pauses and resumes it, and returns the updated webhook.
+// Deliveries queued while a webhook is paused are sent once it is resumed.
+func (b *BusinessWebhook) Update(ctx context.Context, id int, params *models.UpdateWebhook) (*models.Webhook, error) {
+	if params == nil || (params.Url == nil && params.Events == nil && params.Active == nil) {
+		return nil, ErrNoWebhookChanges
+	}
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	if params.Url != nil {
+		if err := b.checkHost(ctx, *params.Url); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := b.dataLayer.GetWebhook(ctx, id); err != nil {
+		return nil, errors.Wrap(err, errGetWebhook.Error())
+	}
+
+	err := b.dataLayer.UpdateWebhook(ctx, id, &models.WebhookChanges{
+		Url:    params.Url,
+		Events: params.Events,
+		Active: params.Active,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateWebhook.Error())
+	}
+
+	webhook, err := b.dataLayer.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetWebhook.Error())
+	}
+	return webhook, nil
+}
+
+// Delete removes the webhook, along with its pending deliveries
+func (b *BusinessWebhook) Delete(ctx context.Context, id int) error {
+	err := b.dataLayer.DeleteWebhook(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errDeleteWebhook.Error())
+	}
+	return nil
+}
+
+// GetDeliveries returns the webhook's most recent deliveries, newest first
+func (b *BusinessWebhook) GetDeliveries(ctx context.Context, id int) ([]models.WebhookDelivery, error) {
+	if _, err := b.dataLayer.GetWebhook(ctx, id); err != nil {
+		return nil, errors.Wrap(err, errGetWebhook.Error())
+	}
+	deliveries, err := b.dataLayer.GetDeliveries(ctx, id, deliveriesLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetDeliveries.Error())
+	}
+	return deliveries, nil
+}
+
+// Name identifies the webhooks among the outbox sinks
+func (b *BusinessWebhook) Name() string {
+	return "webhooks"
+}
+
+// Publish queues the event for every active webhook subscribed to it.
+// It only writes to the queue, and the deliveries are sent by DeliverPending.
+func (b *BusinessWebhook) Publish(ctx context.Context, event *models.TokenLifecycleEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, errEncodeEvent.Error())
+	}
+	_, err = b.dataLayer.EnqueueDeliveries(ctx, event.Type, string(payload), time.Now())
+	if err != nil {
+		return errors.Wrap(err, errEnqueueDeliveries.Error())
+	}
+	return nil
+}
+
+func validateParams(params interface{}) error {
+	errs, err := validation.ValidateStructParams(params)
+	if err != nil {
+		return errors.Wrap(err, errValidateWebhookParams.Error())
+	}
+	if len(errs) > 0 {
+		return ErrInvalidWebhookParams.Detail(strings.Join(errs, ","))
+	}
+	return nil
+}
+
+// generateSecret returns a random 256 bit secret, hex encoded
+func generateSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, errGenerateSecret.Error())
+	}
+	return secretPrefix + hex.EncodeToString(secret), nil
+}
+
+// NewBusinessWebhook returns a new *BusinessWebhook instance. Each delivery is given up to timeout to be
+// acknowledged, and is retried after backoffBase doubling with every attempt, up to backoffMax,
+// until it is marked failed after maxAttempts. Deliveries are only ever posted to public addresses.
+func NewBusinessWebhook(dataLayer dataPersistence, batchSize int, maxAttempts int, backoffBase time.Duration,
+	backoffMax time.Duration, timeout time.Duration) *BusinessWebhook {
+	return &BusinessWebhook{
+		dataLayer:   dataLayer,
+		resolver:    net.DefaultResolver,
+		client:      newDeliveryClient(timeout, dialPublicOnly),
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		backoffBase: backoffBase,
+		backoffMax:  backoffMax,
+		timeout:     timeout,
+	}
+}
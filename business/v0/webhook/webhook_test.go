@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"platform_engineer_clone/business/v0/webhook/webhookfakes"
+	"platform_engineer_clone/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testResolver resolves the hosts it knows, and no other
+type testResolver map[string][]net.IPAddr
+
+func (r testResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+// newTestWebhook resolves hooks.acme.com to a public address, and delivers to any address,
+// so deliveries can be posted to receivers listening on loopback
+func newTestWebhook(fake *webhookfakes.FakeDataPersistence) *BusinessWebhook {
+	businessWebhook := NewBusinessWebhook(fake, 50, 3, time.Minute, time.Hour, time.Second)
+	businessWebhook.resolver = testResolver{
+		"hooks.acme.com":    {{IP: net.ParseIP("203.0.113.10")}},
+		"internal.acme.com": {{IP: net.ParseIP("203.0.113.11")}, {IP: net.ParseIP("192.168.1.10")}},
+	}
+	businessWebhook.client = newDeliveryClient(time.Second, nil)
+	return businessWebhook
+}
+
+func TestBusinessWebhook_Create_HappyPath(t *testing.T) {
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	fakeDataPersistence.CreateWebhookReturns(&models.Webhook{Id: 4, Url: "https://hooks.acme.com", Active: true}, nil)
+
+	businessWebhook := newTestWebhook(&fakeDataPersistence)
+	created, err := businessWebhook.Create(context.Background(), &models.User{Id: 1}, &models.CreateWebhook{
+		Url:    "https://hooks.acme.com",
+		Events: []string{models.TokenLifecycleCreated},
+	})
+	t.Run("Test Create - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, created.Id)
+		assert.True(t, strings.HasPrefix(created.Secret, secretPrefix))
+		assert.Len(t, created.Secret, len(secretPrefix)+64)
+
+		_, newWebhook := fakeDataPersistence.CreateWebhookArgsForCall(0)
+		assert.Equal(t, created.Secret, newWebhook.Secret)
+		assert.Equal(t, 1, newWebhook.CreatedBy)
+	})
+}
+
+func TestBusinessWebhook_Create_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params models.CreateWebhook
+	}{
+		{name: "missing url", params: models.CreateWebhook{}},
+		{name: "relative url", params: models.CreateWebhook{Url: "/hooks"}},
+		{name: "unsupported scheme", params: models.CreateWebhook{Url: "ftp://hooks.acme.com"}},
+		{name: "unknown event", params: models.CreateWebhook{Url: "https://hooks.acme.com", Events: []string{"token.deleted"}}},
+		{name: "duplicate event", params: models.CreateWebhook{Url: "https://hooks.acme.com",
+			Events: []string{models.TokenLifecycleCreated, models.TokenLifecycleCreated}}},
+	}
+	for _, tt := range tests {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		_, err := newTestWebhook(&fakeDataPersistence).Create(context.Background(), &models.User{Id: 1}, &tt.params)
+		t.Run("Test Create - Invalid Params - "+tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, ErrInvalidWebhookParams)
+			assert.Equal(t, 0, fakeDataPersistence.CreateWebhookCallCount())
+		})
+	}
+}
+
+func TestBusinessWebhook_Create_HostNotAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "metadata endpoint", url: "http://169.254.169.254/latest/meta-data"},
+		{name: "loopback", url: "http://127.0.0.1:8080/hooks"},
+		{name: "ipv6 loopback", url: "http://[::1]/hooks"},
+		{name: "private", url: "https://10.0.0.5/hooks"},
+		{name: "carrier-grade nat", url: "https://100.64.0.1/hooks"},
+		{name: "resolves to private", url: "https://internal.acme.com/hooks"},
+		{name: "doesn't resolve", url: "https://unknown.acme.com/hooks"},
+	}
+	for _, tt := range tests {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		_, err := newTestWebhook(&fakeDataPersistence).Create(context.Background(), &models.User{Id: 1},
+			&models.CreateWebhook{Url: tt.url})
+		t.Run("Test Create - Host Not Allowed - "+tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, ErrWebhookHostNotAllowed)
+			assert.Equal(t, 0, fakeDataPersistence.CreateWebhookCallCount())
+		})
+	}
+}
+
+func TestBusinessWebhook_Update(t *testing.T) {
+	t.Run("Test Update - No Changes", func(t *testing.T) {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		_, err := newTestWebhook(&fakeDataPersistence).Update(context.Background(), 4, &models.UpdateWebhook{})
+		assert.ErrorIs(t, err, ErrNoWebhookChanges)
+	})
+
+	t.Run("Test Update - Not Found", func(t *testing.T) {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		fakeDataPersistence.GetWebhookReturns(nil, models.ErrNotFound)
+		active := false
+		_, err := newTestWebhook(&fakeDataPersistence).Update(context.Background(), 4, &models.UpdateWebhook{Active: &active})
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.Equal(t, 0, fakeDataPersistence.UpdateWebhookCallCount())
+	})
+
+	t.Run("Test Update - Happy Path", func(t *testing.T) {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		fakeDataPersistence.GetWebhookReturns(&models.Webhook{Id: 4, Active: false}, nil)
+		active := false
+		events := []string{models.TokenLifecycleRevoked}
+		webhook, err := newTestWebhook(&fakeDataPersistence).Update(context.Background(), 4,
+			&models.UpdateWebhook{Active: &active, Events: &events})
+		require.NoError(t, err)
+		assert.False(t, webhook.Active)
+
+		_, id, changes := fakeDataPersistence.UpdateWebhookArgsForCall(0)
+		assert.Equal(t, 4, id)
+		assert.Equal(t, &events, changes.Events)
+		assert.Nil(t, changes.Url)
+	})
+
+	t.Run("Test Update - Host Not Allowed", func(t *testing.T) {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		url := "http://169.254.169.254/latest/meta-data"
+		_, err := newTestWebhook(&fakeDataPersistence).Update(context.Background(), 4, &models.UpdateWebhook{Url: &url})
+		assert.ErrorIs(t, err, ErrWebhookHostNotAllowed)
+		assert.Equal(t, 0, fakeDataPersistence.UpdateWebhookCallCount())
+	})
+
+	t.Run("Test Update - Invalid Events", func(t *testing.T) {
+		fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+		events := []string{"token.deleted"}
+		_, err := newTestWebhook(&fakeDataPersistence).Update(context.Background(), 4, &models.UpdateWebhook{Events: &events})
+		assert.ErrorIs(t, err, ErrInvalidWebhookParams)
+	})
+}
+
+func TestBusinessWebhook_Publish_HappyPath(t *testing.T) {
+	fakeDataPersistence := webhookfakes.FakeDataPersistence{}
+	occurredAt := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	err := newTestWebhook(&fakeDataPersistence).Publish(context.Background(), &models.TokenLifecycleEvent{
+		Type:       models.TokenLifecycleRevoked,
+		Token:      models.TokenRef{Id: 42, KeyPrefix: "inv_3k"},
+		OccurredAt: occurredAt,
+	})
+	t.Run("Test Publish - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Equal(t, 1, fakeDataPersistence.EnqueueDeliveriesCallCount())
+		_, eventType, payload, _ := fakeDataPersistence.EnqueueDeliveriesArgsForCall(0)
+		assert.Equal(t, models.TokenLifecycleRevoked, eventType)
+
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(payload), &event))
+		assert.Equal(t, "token.revoked", event["type"])
+		assert.Equal(t, map[string]interface{}{"id": float64(42), "key_prefix": "inv_3k"}, event["token"])
+		assert.Equal(t, "2024-06-01T09:30:00Z", event["occurred_at"])
+	})
+}
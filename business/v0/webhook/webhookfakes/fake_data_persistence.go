@@ -0,0 +1,763 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package webhookfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"platform_engineer_clone/models"
+)
+
+type FakeDataPersistence struct {
+	ClaimDueDeliveriesStub        func(context.Context, time.Time, int, time.Duration) ([]models.DueWebhookDelivery, error)
+	claimDueDeliveriesMutex       sync.RWMutex
+	claimDueDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 time.Duration
+	}
+	claimDueDeliveriesReturns struct {
+		result1 []models.DueWebhookDelivery
+		result2 error
+	}
+	claimDueDeliveriesReturnsOnCall map[int]struct {
+		result1 []models.DueWebhookDelivery
+		result2 error
+	}
+	CreateWebhookStub        func(context.Context, *models.NewWebhook) (*models.Webhook, error)
+	createWebhookMutex       sync.RWMutex
+	createWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.NewWebhook
+	}
+	createWebhookReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	createWebhookReturnsOnCall map[int]struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	DeleteWebhookStub        func(context.Context, int) error
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteWebhookReturns struct {
+		result1 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnqueueDeliveriesStub        func(context.Context, string, string, time.Time) (int64, error)
+	enqueueDeliveriesMutex       sync.RWMutex
+	enqueueDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	enqueueDeliveriesReturns struct {
+		result1 int64
+		result2 error
+	}
+	enqueueDeliveriesReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetDeliveriesStub        func(context.Context, int, int) ([]models.WebhookDelivery, error)
+	getDeliveriesMutex       sync.RWMutex
+	getDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}
+	getDeliveriesReturns struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}
+	getDeliveriesReturnsOnCall map[int]struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}
+	GetWebhookStub        func(context.Context, int) (*models.Webhook, error)
+	getWebhookMutex       sync.RWMutex
+	getWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getWebhookReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	getWebhookReturnsOnCall map[int]struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	GetWebhooksStub        func(context.Context) ([]models.Webhook, error)
+	getWebhooksMutex       sync.RWMutex
+	getWebhooksArgsForCall []struct {
+		arg1 context.Context
+	}
+	getWebhooksReturns struct {
+		result1 []models.Webhook
+		result2 error
+	}
+	getWebhooksReturnsOnCall map[int]struct {
+		result1 []models.Webhook
+		result2 error
+	}
+	RecordDeliveryAttemptStub        func(context.Context, int, *models.WebhookDeliveryAttempt) error
+	recordDeliveryAttemptMutex       sync.RWMutex
+	recordDeliveryAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.WebhookDeliveryAttempt
+	}
+	recordDeliveryAttemptReturns struct {
+		result1 error
+	}
+	recordDeliveryAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateWebhookStub        func(context.Context, int, *models.WebhookChanges) error
+	updateWebhookMutex       sync.RWMutex
+	updateWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.WebhookChanges
+	}
+	updateWebhookReturns struct {
+		result1 error
+	}
+	updateWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveries(arg1 context.Context, arg2 time.Time, arg3 int, arg4 time.Duration) ([]models.DueWebhookDelivery, error) {
+	fake.claimDueDeliveriesMutex.Lock()
+	ret, specificReturn := fake.claimDueDeliveriesReturnsOnCall[len(fake.claimDueDeliveriesArgsForCall)]
+	fake.claimDueDeliveriesArgsForCall = append(fake.claimDueDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimDueDeliveriesStub
+	fakeReturns := fake.claimDueDeliveriesReturns
+	fake.recordInvocation("ClaimDueDeliveries", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimDueDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveriesCallCount() int {
+	fake.claimDueDeliveriesMutex.RLock()
+	defer fake.claimDueDeliveriesMutex.RUnlock()
+	return len(fake.claimDueDeliveriesArgsForCall)
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveriesCalls(stub func(context.Context, time.Time, int, time.Duration) ([]models.DueWebhookDelivery, error)) {
+	fake.claimDueDeliveriesMutex.Lock()
+	defer fake.claimDueDeliveriesMutex.Unlock()
+	fake.ClaimDueDeliveriesStub = stub
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveriesArgsForCall(i int) (context.Context, time.Time, int, time.Duration) {
+	fake.claimDueDeliveriesMutex.RLock()
+	defer fake.claimDueDeliveriesMutex.RUnlock()
+	argsForCall := fake.claimDueDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveriesReturns(result1 []models.DueWebhookDelivery, result2 error) {
+	fake.claimDueDeliveriesMutex.Lock()
+	defer fake.claimDueDeliveriesMutex.Unlock()
+	fake.ClaimDueDeliveriesStub = nil
+	fake.claimDueDeliveriesReturns = struct {
+		result1 []models.DueWebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) ClaimDueDeliveriesReturnsOnCall(i int, result1 []models.DueWebhookDelivery, result2 error) {
+	fake.claimDueDeliveriesMutex.Lock()
+	defer fake.claimDueDeliveriesMutex.Unlock()
+	fake.ClaimDueDeliveriesStub = nil
+	if fake.claimDueDeliveriesReturnsOnCall == nil {
+		fake.claimDueDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []models.DueWebhookDelivery
+			result2 error
+		})
+	}
+	fake.claimDueDeliveriesReturnsOnCall[i] = struct {
+		result1 []models.DueWebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) CreateWebhook(arg1 context.Context, arg2 *models.NewWebhook) (*models.Webhook, error) {
+	fake.createWebhookMutex.Lock()
+	ret, specificReturn := fake.createWebhookReturnsOnCall[len(fake.createWebhookArgsForCall)]
+	fake.createWebhookArgsForCall = append(fake.createWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.NewWebhook
+	}{arg1, arg2})
+	stub := fake.CreateWebhookStub
+	fakeReturns := fake.createWebhookReturns
+	fake.recordInvocation("CreateWebhook", []interface{}{arg1, arg2})
+	fake.createWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) CreateWebhookCallCount() int {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	return len(fake.createWebhookArgsForCall)
+}
+
+func (fake *FakeDataPersistence) CreateWebhookCalls(stub func(context.Context, *models.NewWebhook) (*models.Webhook, error)) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = stub
+}
+
+func (fake *FakeDataPersistence) CreateWebhookArgsForCall(i int) (context.Context, *models.NewWebhook) {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	argsForCall := fake.createWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) CreateWebhookReturns(result1 *models.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	fake.createWebhookReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) CreateWebhookReturnsOnCall(i int, result1 *models.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	if fake.createWebhookReturnsOnCall == nil {
+		fake.createWebhookReturnsOnCall = make(map[int]struct {
+			result1 *models.Webhook
+			result2 error
+		})
+	}
+	fake.createWebhookReturnsOnCall[i] = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) DeleteWebhook(arg1 context.Context, arg2 int) error {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteWebhookStub
+	fakeReturns := fake.deleteWebhookReturns
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1, arg2})
+	fake.deleteWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeDataPersistence) DeleteWebhookCalls(stub func(context.Context, int) error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeDataPersistence) DeleteWebhookArgsForCall(i int) (context.Context, int) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) DeleteWebhookReturns(result1 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) DeleteWebhookReturnsOnCall(i int, result1 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveries(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) (int64, error) {
+	fake.enqueueDeliveriesMutex.Lock()
+	ret, specificReturn := fake.enqueueDeliveriesReturnsOnCall[len(fake.enqueueDeliveriesArgsForCall)]
+	fake.enqueueDeliveriesArgsForCall = append(fake.enqueueDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EnqueueDeliveriesStub
+	fakeReturns := fake.enqueueDeliveriesReturns
+	fake.recordInvocation("EnqueueDeliveries", []interface{}{arg1, arg2, arg3, arg4})
+	fake.enqueueDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveriesCallCount() int {
+	fake.enqueueDeliveriesMutex.RLock()
+	defer fake.enqueueDeliveriesMutex.RUnlock()
+	return len(fake.enqueueDeliveriesArgsForCall)
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveriesCalls(stub func(context.Context, string, string, time.Time) (int64, error)) {
+	fake.enqueueDeliveriesMutex.Lock()
+	defer fake.enqueueDeliveriesMutex.Unlock()
+	fake.EnqueueDeliveriesStub = stub
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveriesArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.enqueueDeliveriesMutex.RLock()
+	defer fake.enqueueDeliveriesMutex.RUnlock()
+	argsForCall := fake.enqueueDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveriesReturns(result1 int64, result2 error) {
+	fake.enqueueDeliveriesMutex.Lock()
+	defer fake.enqueueDeliveriesMutex.Unlock()
+	fake.EnqueueDeliveriesStub = nil
+	fake.enqueueDeliveriesReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) EnqueueDeliveriesReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.enqueueDeliveriesMutex.Lock()
+	defer fake.enqueueDeliveriesMutex.Unlock()
+	fake.EnqueueDeliveriesStub = nil
+	if fake.enqueueDeliveriesReturnsOnCall == nil {
+		fake.enqueueDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.enqueueDeliveriesReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetDeliveries(arg1 context.Context, arg2 int, arg3 int) ([]models.WebhookDelivery, error) {
+	fake.getDeliveriesMutex.Lock()
+	ret, specificReturn := fake.getDeliveriesReturnsOnCall[len(fake.getDeliveriesArgsForCall)]
+	fake.getDeliveriesArgsForCall = append(fake.getDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetDeliveriesStub
+	fakeReturns := fake.getDeliveriesReturns
+	fake.recordInvocation("GetDeliveries", []interface{}{arg1, arg2, arg3})
+	fake.getDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetDeliveriesCallCount() int {
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	return len(fake.getDeliveriesArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetDeliveriesCalls(stub func(context.Context, int, int) ([]models.WebhookDelivery, error)) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = stub
+}
+
+func (fake *FakeDataPersistence) GetDeliveriesArgsForCall(i int) (context.Context, int, int) {
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	argsForCall := fake.getDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) GetDeliveriesReturns(result1 []models.WebhookDelivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	fake.getDeliveriesReturns = struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetDeliveriesReturnsOnCall(i int, result1 []models.WebhookDelivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	if fake.getDeliveriesReturnsOnCall == nil {
+		fake.getDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []models.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.getDeliveriesReturnsOnCall[i] = struct {
+		result1 []models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetWebhook(arg1 context.Context, arg2 int) (*models.Webhook, error) {
+	fake.getWebhookMutex.Lock()
+	ret, specificReturn := fake.getWebhookReturnsOnCall[len(fake.getWebhookArgsForCall)]
+	fake.getWebhookArgsForCall = append(fake.getWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetWebhookStub
+	fakeReturns := fake.getWebhookReturns
+	fake.recordInvocation("GetWebhook", []interface{}{arg1, arg2})
+	fake.getWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetWebhookCallCount() int {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	return len(fake.getWebhookArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetWebhookCalls(stub func(context.Context, int) (*models.Webhook, error)) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = stub
+}
+
+func (fake *FakeDataPersistence) GetWebhookArgsForCall(i int) (context.Context, int) {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	argsForCall := fake.getWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDataPersistence) GetWebhookReturns(result1 *models.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	fake.getWebhookReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetWebhookReturnsOnCall(i int, result1 *models.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	if fake.getWebhookReturnsOnCall == nil {
+		fake.getWebhookReturnsOnCall = make(map[int]struct {
+			result1 *models.Webhook
+			result2 error
+		})
+	}
+	fake.getWebhookReturnsOnCall[i] = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetWebhooks(arg1 context.Context) ([]models.Webhook, error) {
+	fake.getWebhooksMutex.Lock()
+	ret, specificReturn := fake.getWebhooksReturnsOnCall[len(fake.getWebhooksArgsForCall)]
+	fake.getWebhooksArgsForCall = append(fake.getWebhooksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetWebhooksStub
+	fakeReturns := fake.getWebhooksReturns
+	fake.recordInvocation("GetWebhooks", []interface{}{arg1})
+	fake.getWebhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataPersistence) GetWebhooksCallCount() int {
+	fake.getWebhooksMutex.RLock()
+	defer fake.getWebhooksMutex.RUnlock()
+	return len(fake.getWebhooksArgsForCall)
+}
+
+func (fake *FakeDataPersistence) GetWebhooksCalls(stub func(context.Context) ([]models.Webhook, error)) {
+	fake.getWebhooksMutex.Lock()
+	defer fake.getWebhooksMutex.Unlock()
+	fake.GetWebhooksStub = stub
+}
+
+func (fake *FakeDataPersistence) GetWebhooksArgsForCall(i int) context.Context {
+	fake.getWebhooksMutex.RLock()
+	defer fake.getWebhooksMutex.RUnlock()
+	argsForCall := fake.getWebhooksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDataPersistence) GetWebhooksReturns(result1 []models.Webhook, result2 error) {
+	fake.getWebhooksMutex.Lock()
+	defer fake.getWebhooksMutex.Unlock()
+	fake.GetWebhooksStub = nil
+	fake.getWebhooksReturns = struct {
+		result1 []models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) GetWebhooksReturnsOnCall(i int, result1 []models.Webhook, result2 error) {
+	fake.getWebhooksMutex.Lock()
+	defer fake.getWebhooksMutex.Unlock()
+	fake.GetWebhooksStub = nil
+	if fake.getWebhooksReturnsOnCall == nil {
+		fake.getWebhooksReturnsOnCall = make(map[int]struct {
+			result1 []models.Webhook
+			result2 error
+		})
+	}
+	fake.getWebhooksReturnsOnCall[i] = struct {
+		result1 []models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttempt(arg1 context.Context, arg2 int, arg3 *models.WebhookDeliveryAttempt) error {
+	fake.recordDeliveryAttemptMutex.Lock()
+	ret, specificReturn := fake.recordDeliveryAttemptReturnsOnCall[len(fake.recordDeliveryAttemptArgsForCall)]
+	fake.recordDeliveryAttemptArgsForCall = append(fake.recordDeliveryAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.WebhookDeliveryAttempt
+	}{arg1, arg2, arg3})
+	stub := fake.RecordDeliveryAttemptStub
+	fakeReturns := fake.recordDeliveryAttemptReturns
+	fake.recordInvocation("RecordDeliveryAttempt", []interface{}{arg1, arg2, arg3})
+	fake.recordDeliveryAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttemptCallCount() int {
+	fake.recordDeliveryAttemptMutex.RLock()
+	defer fake.recordDeliveryAttemptMutex.RUnlock()
+	return len(fake.recordDeliveryAttemptArgsForCall)
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttemptCalls(stub func(context.Context, int, *models.WebhookDeliveryAttempt) error) {
+	fake.recordDeliveryAttemptMutex.Lock()
+	defer fake.recordDeliveryAttemptMutex.Unlock()
+	fake.RecordDeliveryAttemptStub = stub
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttemptArgsForCall(i int) (context.Context, int, *models.WebhookDeliveryAttempt) {
+	fake.recordDeliveryAttemptMutex.RLock()
+	defer fake.recordDeliveryAttemptMutex.RUnlock()
+	argsForCall := fake.recordDeliveryAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttemptReturns(result1 error) {
+	fake.recordDeliveryAttemptMutex.Lock()
+	defer fake.recordDeliveryAttemptMutex.Unlock()
+	fake.RecordDeliveryAttemptStub = nil
+	fake.recordDeliveryAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) RecordDeliveryAttemptReturnsOnCall(i int, result1 error) {
+	fake.recordDeliveryAttemptMutex.Lock()
+	defer fake.recordDeliveryAttemptMutex.Unlock()
+	fake.RecordDeliveryAttemptStub = nil
+	if fake.recordDeliveryAttemptReturnsOnCall == nil {
+		fake.recordDeliveryAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordDeliveryAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) UpdateWebhook(arg1 context.Context, arg2 int, arg3 *models.WebhookChanges) error {
+	fake.updateWebhookMutex.Lock()
+	ret, specificReturn := fake.updateWebhookReturnsOnCall[len(fake.updateWebhookArgsForCall)]
+	fake.updateWebhookArgsForCall = append(fake.updateWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *models.WebhookChanges
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateWebhookStub
+	fakeReturns := fake.updateWebhookReturns
+	fake.recordInvocation("UpdateWebhook", []interface{}{arg1, arg2, arg3})
+	fake.updateWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataPersistence) UpdateWebhookCallCount() int {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	return len(fake.updateWebhookArgsForCall)
+}
+
+func (fake *FakeDataPersistence) UpdateWebhookCalls(stub func(context.Context, int, *models.WebhookChanges) error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = stub
+}
+
+func (fake *FakeDataPersistence) UpdateWebhookArgsForCall(i int) (context.Context, int, *models.WebhookChanges) {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	argsForCall := fake.updateWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataPersistence) UpdateWebhookReturns(result1 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	fake.updateWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) UpdateWebhookReturnsOnCall(i int, result1 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	if fake.updateWebhookReturnsOnCall == nil {
+		fake.updateWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataPersistence) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.claimDueDeliveriesMutex.RLock()
+	defer fake.claimDueDeliveriesMutex.RUnlock()
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.enqueueDeliveriesMutex.RLock()
+	defer fake.enqueueDeliveriesMutex.RUnlock()
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	fake.getWebhooksMutex.RLock()
+	defer fake.getWebhooksMutex.RUnlock()
+	fake.recordDeliveryAttemptMutex.RLock()
+	defer fake.recordDeliveryAttemptMutex.RUnlock()
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDataPersistence) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -11,16 +12,109 @@ import (
 	"os"
 	"os/signal"
 	"platform_engineer_clone/api"
+	"platform_engineer_clone/api/helpers"
+	"platform_engineer_clone/business/v0/eventlog"
 	"platform_engineer_clone/dependency_injection/dic"
 	"platform_engineer_clone/src/config"
+	"platform_engineer_clone/src/utils/scheduler"
 	"strconv"
+	"sync"
 	"syscall"
 )
 
+// initWorkers starts the background jobs, which run until the context is cancelled
+func initWorkers(ctx context.Context, wg *sync.WaitGroup, ctn *dic.Container, cfg *config.Config) {
+	businessToken, err := ctn.SafeGetBusinessToken()
+	if err != nil {
+		log.Fatalf("error trying to fetch the business token from the container: %v", err.Error())
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scheduler.Every(ctx, cfg.App.TokenExpirySweepInterval, businessToken.SweepExpired)
+	}()
+
+	if cfg.App.TokenPurgeInterval > 0 {
+		tokenPurger, err := ctn.SafeGetBusinessTokenPurger()
+		if err != nil {
+			log.Fatalf("error trying to fetch the business token purger from the container: %v", err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.TokenPurgeInterval, tokenPurger.PurgeExpired)
+		}()
+	}
+
+	// Webhook deliveries are queued either way, and sent once an instance runs with an interval
+	if cfg.App.WebhookDeliveryInterval > 0 {
+		businessWebhook, err := ctn.SafeGetBusinessWebhook()
+		if err != nil {
+			log.Fatalf("error trying to fetch the business webhook from the container: %v", err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.WebhookDeliveryInterval, businessWebhook.DeliverPending)
+		}()
+	}
+
+	// Outbox events are written along with the token changes either way, and relayed while the interval is set
+	if cfg.App.OutboxRelayInterval > 0 {
+		outboxRelay, err := ctn.SafeGetBusinessOutboxRelay()
+		if err != nil {
+			log.Fatalf("error trying to fetch the business outbox relay from the container: %v", err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.OutboxRelayInterval, outboxRelay.Relay)
+		}()
+	}
+
+	// Event log lines are written as events are published, and synced periodically in the interval fsync mode
+	if cfg.App.EventLogFile != "" && cfg.App.EventLogFsync == eventlog.FsyncInterval {
+		eventLog, err := ctn.SafeGetBusinessEventLog()
+		if err != nil {
+			log.Fatalf("error trying to fetch the business event log from the container: %v", err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.EventLogFsyncInterval, eventLog.Sync)
+		}()
+	}
+
+	// Expired idempotency keys are ignored either way, and deleted while the interval is set
+	if cfg.App.IdempotencyPurgeInterval > 0 {
+		businessIdempotency, err := ctn.SafeGetBusinessIdempotency()
+		if err != nil {
+			log.Fatalf("error trying to fetch the business idempotency from the container: %v", err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.IdempotencyPurgeInterval, businessIdempotency.PurgeExpired)
+		}()
+	}
+
+	// Signed tokens are validated against the revoked set, which has to be loaded before serving
+	if cfg.App.TokenMode == config.TokenModeSigned {
+		businessToken.RefreshRevoked(ctx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduler.Every(ctx, cfg.App.TokenRevocationRefreshInterval, businessToken.RefreshRevoked)
+		}()
+	}
+}
+
 // initAPI boots our REST API connections
 func initAPI(ctn *dic.Container, cfg *config.Config) {
 	app := fiber.New(fiber.Config{
-		BodyLimit: 20971520,
+		BodyLimit:    20971520,
+		ErrorHandler: helpers.ErrorHandler,
 	})
 
 	app.Use(requestid.New())
@@ -42,6 +136,8 @@ func initAPI(ctn *dic.Container, cfg *config.Config) {
 	go func() {
 		<-shutdown
 		fmt.Println("Gracefully shutting down")
+		// Open event streams never finish by themselves, so they are ended for the server to shut down
+		ctn.GetBusinessStream().Close()
 		err := app.Shutdown()
 		if err != nil {
 			fmt.Println("Shutting down error", err)
@@ -66,5 +162,18 @@ func main() {
 		log.Fatalf("error trying to fetch the config from the container: %v", err.Error())
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	initWorkers(ctx, &wg, ctn, cfg)
+
 	initAPI(ctn, cfg)
+
+	// The API has shut down, stop the workers and wait for any run in progress to finish
+	cancel()
+	wg.Wait()
+
+	// Nothing publishes events anymore, so the event log can be synced and closed
+	if err = ctn.GetBusinessEventLog().Close(); err != nil {
+		log.Printf("error closing the event log: %v", err.Error())
+	}
 }
@@ -0,0 +1,46 @@
+// Command hash_token_keys migrates tokens created before keys were stored hashed.
+// It hashes every plaintext key under APP_TOKEN_KEY_SECRET, keeps its prefix, and clears the plaintext.
+// Run it after databese/migrations/001_hash_token_keys.sql, and before 002_drop_plaintext_token_keys.sql.
+// It is safe to re-run, only rows still holding a plaintext key are touched.
+package main
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"log"
+	"platform_engineer_clone/dependency_injection/dic"
+	"platform_engineer_clone/src/utils/common"
+)
+
+const batchSize = 500
+
+func main() {
+	ctx := context.Background()
+	logger := common.GetLogger(ctx)
+	builder, err := dic.NewBuilder()
+	if err != nil {
+		log.Fatalf("error trying to initialize the builder: %v", err.Error())
+	}
+	ctn := builder.Build()
+
+	persistenceToken, err := ctn.SafeGetMysqlTokenPersistence()
+	if err != nil {
+		log.Fatalf("error getting the mysql_token_persistence from the container: %v", err.Error())
+	}
+
+	total := 0
+	for {
+		hashed, err := persistenceToken.HashPlaintextKeys(ctx, batchSize)
+		total += hashed
+		if err != nil {
+			log.Fatalf("error hashing plaintext keys, %v hashed so far: %v", total, err.Error())
+		}
+		if hashed < batchSize {
+			break
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"hashed": total,
+	}).Info("hash_token_keys")
+}
@@ -0,0 +1,37 @@
+// Command purge_tokens deletes tokens expired for longer than APP_TOKEN_RETENTION_DAYS, revoked or not,
+// along with their events, appending them to APP_TOKEN_PURGE_ARCHIVE_FILE first when it is set.
+// Tokens are deleted APP_TOKEN_PURGE_BATCH_SIZE at a time, so the table is never locked for long.
+// The API runs the same purge periodically when APP_TOKEN_PURGE_INTERVAL is set.
+package main
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"log"
+	"platform_engineer_clone/dependency_injection/dic"
+	"platform_engineer_clone/src/utils/common"
+)
+
+func main() {
+	ctx := context.Background()
+	logger := common.GetLogger(ctx)
+	builder, err := dic.NewBuilder()
+	if err != nil {
+		log.Fatalf("error trying to initialize the builder: %v", err.Error())
+	}
+	ctn := builder.Build()
+
+	tokenPurger, err := ctn.SafeGetBusinessTokenPurger()
+	if err != nil {
+		log.Fatalf("error getting the business_token_purger from the container: %v", err.Error())
+	}
+
+	purged, err := tokenPurger.Purge(ctx)
+	if err != nil {
+		log.Fatalf("error purging tokens, %v purged so far: %v", purged, err.Error())
+	}
+
+	logger.WithFields(logrus.Fields{
+		"purged": purged,
+	}).Info("purge_tokens")
+}
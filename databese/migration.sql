@@ -7,22 +7,147 @@ CREATE TABLE `user` (
                         `email` varchar(320) NOT NULL,
                         `password` varchar(255) NOT NULL,
                         `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                        `active_token_quota` int NULL DEFAULT NULL,
                         PRIMARY KEY (`id`),
                         UNIQUE KEY `user_email_uindex` (`email`),
                         UNIQUE KEY `user_name_uindex` (`name`)
 );
 
+DROP TABLE IF EXISTS `campaign`;
+CREATE TABLE `campaign` (
+                            `id` int NOT NULL AUTO_INCREMENT,
+                            `name` varchar(255) NOT NULL,
+                            `description` varchar(1024) DEFAULT NULL,
+                            `default_ttl_seconds` int DEFAULT NULL,
+                            `default_max_uses` int DEFAULT NULL,
+                            `starts_at` timestamp NULL DEFAULT NULL,
+                            `ends_at` timestamp NULL DEFAULT NULL,
+                            `revoked_at` timestamp NULL DEFAULT NULL,
+                            `created_by` int NOT NULL,
+                            `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            PRIMARY KEY (`id`),
+                            KEY `campaign_user_id_fk` (`created_by`),
+                            CONSTRAINT `campaign_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`)
+);
+
 DROP TABLE IF EXISTS `token`;
 CREATE TABLE `token` (
                          `id` int NOT NULL AUTO_INCREMENT,
-                         `key` varchar(12) NOT NULL,
+                         `key_hash` char(64) NOT NULL,
+                         `key_prefix` varchar(16) NOT NULL,
                          `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
                          `revoked` tinyint(1) NOT NULL DEFAULT '0',
                          `expired` tinyint(1) NOT NULL DEFAULT '0',
                          `created_by` int NOT NULL,
                          `expires_at` timestamp NOT NULL,
+                         `max_uses` int DEFAULT NULL,
+                         `use_count` int NOT NULL DEFAULT '0',
+                         `label` varchar(255) DEFAULT NULL,
+                         `note` varchar(1024) DEFAULT NULL,
+                         `recipient_email` varchar(320) DEFAULT NULL,
+                         `not_before` timestamp NULL DEFAULT NULL,
+                         `campaign_id` int DEFAULT NULL,
                          PRIMARY KEY (`id`),
-                         UNIQUE KEY `token_name_uindex` (`key`),
+                         UNIQUE KEY `token_key_hash_uindex` (`key_hash`),
                          KEY `token_user_id_fk` (`created_by`),
-                         CONSTRAINT `token_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`)
-);
\ No newline at end of file
+                         KEY `token_label_index` (`label`),
+                         KEY `token_created_at_index` (`created_at`, `id`),
+                         KEY `token_expires_at_index` (`expires_at`, `id`),
+                         KEY `token_recipient_email_index` (`recipient_email`),
+                         KEY `token_campaign_id_fk` (`campaign_id`, `id`),
+                         CONSTRAINT `token_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`),
+                         CONSTRAINT `token_campaign_id_fk` FOREIGN KEY (`campaign_id`) REFERENCES `campaign` (`id`) ON DELETE SET NULL
+);
+DROP TABLE IF EXISTS `token_event`;
+CREATE TABLE `token_event` (
+                               `id` int NOT NULL AUTO_INCREMENT,
+                               `token_id` int DEFAULT NULL,
+                               `action` varchar(16) NOT NULL,
+                               `outcome` varchar(16) NOT NULL,
+                               `ip` varchar(45) NOT NULL,
+                               `user_agent` varchar(512) NOT NULL,
+                               `request_id` varchar(64) NOT NULL,
+                               `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               PRIMARY KEY (`id`),
+                               KEY `token_event_token_id_fk` (`token_id`),
+                               CONSTRAINT `token_event_token_id_fk` FOREIGN KEY (`token_id`) REFERENCES `token` (`id`) ON DELETE CASCADE
+);
+DROP TABLE IF EXISTS `token_scope`;
+CREATE TABLE `token_scope` (
+                               `token_id` int NOT NULL,
+                               `scope` varchar(64) NOT NULL,
+                               PRIMARY KEY (`token_id`, `scope`),
+                               CONSTRAINT `token_scope_token_id_fk` FOREIGN KEY (`token_id`) REFERENCES `token` (`id`) ON DELETE CASCADE
+);
+DROP TABLE IF EXISTS `webhook`;
+CREATE TABLE `webhook` (
+                           `id` int NOT NULL AUTO_INCREMENT,
+                           `url` varchar(2048) NOT NULL,
+                           `secret` varchar(128) NOT NULL,
+                           `events` varchar(255) NOT NULL DEFAULT '',
+                           `active` tinyint(1) NOT NULL DEFAULT '1',
+                           `created_by` int NOT NULL,
+                           `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                           PRIMARY KEY (`id`),
+                           KEY `webhook_user_id_fk` (`created_by`),
+                           CONSTRAINT `webhook_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`)
+);
+DROP TABLE IF EXISTS `webhook_delivery`;
+CREATE TABLE `webhook_delivery` (
+                                    `id` int NOT NULL AUTO_INCREMENT,
+                                    `webhook_id` int NOT NULL,
+                                    `event_type` varchar(32) NOT NULL,
+                                    `payload` text NOT NULL,
+                                    `status` varchar(16) NOT NULL DEFAULT 'pending',
+                                    `attempts` int NOT NULL DEFAULT '0',
+                                    `next_attempt_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    `last_attempt_at` timestamp NULL DEFAULT NULL,
+                                    `response_status` int DEFAULT NULL,
+                                    `last_error` varchar(1024) DEFAULT NULL,
+                                    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    `delivered_at` timestamp NULL DEFAULT NULL,
+                                    PRIMARY KEY (`id`),
+                                    KEY `webhook_delivery_due_index` (`status`, `next_attempt_at`),
+                                    KEY `webhook_delivery_webhook_id_fk` (`webhook_id`, `id`),
+                                    CONSTRAINT `webhook_delivery_webhook_id_fk` FOREIGN KEY (`webhook_id`) REFERENCES `webhook` (`id`) ON DELETE CASCADE
+);
+DROP TABLE IF EXISTS `outbox`;
+CREATE TABLE `outbox` (
+                          `id` int NOT NULL AUTO_INCREMENT,
+                          `token_id` int NOT NULL,
+                          `event_type` varchar(32) NOT NULL,
+                          `payload` text NOT NULL,
+                          `attempts` int NOT NULL DEFAULT '0',
+                          `last_error` varchar(1024) DEFAULT NULL,
+                          `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          `sent_at` timestamp NULL DEFAULT NULL,
+                          `failed_at` timestamp NULL DEFAULT NULL,
+                          `claimed_until` timestamp NULL DEFAULT NULL,
+                          PRIMARY KEY (`id`),
+                          KEY `outbox_pending_index` (`sent_at`, `failed_at`, `id`)
+);
+DROP TABLE IF EXISTS `outbox_delivery`;
+CREATE TABLE `outbox_delivery` (
+                                   `outbox_id` int NOT NULL,
+                                   `sink` varchar(32) NOT NULL,
+                                   `delivered_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   PRIMARY KEY (`outbox_id`, `sink`),
+                                   CONSTRAINT `outbox_delivery_outbox_id_fk` FOREIGN KEY (`outbox_id`) REFERENCES `outbox` (`id`) ON DELETE CASCADE
+);
+DROP TABLE IF EXISTS `idempotency_key`;
+CREATE TABLE `idempotency_key` (
+                                   `id` int NOT NULL AUTO_INCREMENT,
+                                   `user_id` int NOT NULL,
+                                   `scope` varchar(255) NOT NULL,
+                                   `idempotency_key` varchar(255) NOT NULL,
+                                   `request_hash` char(64) NOT NULL,
+                                   `status_code` int DEFAULT NULL,
+                                   `content_type` varchar(255) DEFAULT NULL,
+                                   `response_body` mediumblob,
+                                   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   `expires_at` timestamp NOT NULL,
+                                   PRIMARY KEY (`id`),
+                                   UNIQUE KEY `idempotency_key_uindex` (`user_id`, `scope`, `idempotency_key`),
+                                   KEY `idempotency_key_expires_at_index` (`expires_at`),
+                                   CONSTRAINT `idempotency_key_user_id_fk` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+);
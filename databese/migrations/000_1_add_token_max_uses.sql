@@ -0,0 +1,6 @@
+-- Tokens can be limited to a number of uses, counted by redeeming them
+USE platform_engineer;
+
+ALTER TABLE `token`
+    ADD `max_uses` int DEFAULT NULL,
+    ADD `use_count` int NOT NULL DEFAULT '0';
@@ -0,0 +1,10 @@
+-- Step 1 of hashing token keys at rest, run before deploying the hashed key release.
+-- Step 2 is "go run ./cmd/hash_token_keys", which fills key_hash and key_prefix,
+-- and clears the plaintext key. Step 3 is 002_drop_plaintext_token_keys.sql.
+USE platform_engineer;
+
+ALTER TABLE `token`
+    MODIFY `key` varchar(12) DEFAULT NULL,
+    ADD COLUMN `key_hash` char(64) DEFAULT NULL AFTER `key`,
+    ADD COLUMN `key_prefix` varchar(8) DEFAULT NULL AFTER `key_hash`,
+    ADD UNIQUE KEY `token_key_hash_uindex` (`key_hash`);
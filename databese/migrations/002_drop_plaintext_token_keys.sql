@@ -0,0 +1,9 @@
+-- Step 3 of hashing token keys at rest, run once "go run ./cmd/hash_token_keys"
+-- reports no plaintext keys left.
+USE platform_engineer;
+
+ALTER TABLE `token`
+    DROP INDEX `token_name_uindex`,
+    DROP COLUMN `key`,
+    MODIFY `key_hash` char(64) NOT NULL,
+    MODIFY `key_prefix` varchar(8) NOT NULL;
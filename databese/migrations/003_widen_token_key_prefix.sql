@@ -0,0 +1,5 @@
+-- Keys now start with a configurable prefix such as "inv_", which is kept in the visible key prefix
+USE platform_engineer;
+
+ALTER TABLE `token`
+    MODIFY `key_prefix` varchar(16) NOT NULL;
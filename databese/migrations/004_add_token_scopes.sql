@@ -0,0 +1,9 @@
+-- Tokens carry scopes, checked when validating with ?scope=
+USE platform_engineer;
+
+CREATE TABLE `token_scope` (
+                               `token_id` int NOT NULL,
+                               `scope` varchar(64) NOT NULL,
+                               PRIMARY KEY (`token_id`, `scope`),
+                               CONSTRAINT `token_scope_token_id_fk` FOREIGN KEY (`token_id`) REFERENCES `token` (`id`) ON DELETE CASCADE
+);
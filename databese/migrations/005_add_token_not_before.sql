@@ -0,0 +1,5 @@
+-- Tokens can be scheduled, only becoming usable from not_before
+USE platform_engineer;
+
+ALTER TABLE `token`
+    ADD `not_before` timestamp NULL DEFAULT NULL;
@@ -0,0 +1,34 @@
+-- Webhook subscriptions, and their deliveries, which double as the retry queue and the delivery log
+USE platform_engineer;
+
+CREATE TABLE `webhook` (
+                           `id` int NOT NULL AUTO_INCREMENT,
+                           `url` varchar(2048) NOT NULL,
+                           `secret` varchar(128) NOT NULL,
+                           `events` varchar(255) NOT NULL DEFAULT '',
+                           `active` tinyint(1) NOT NULL DEFAULT '1',
+                           `created_by` int NOT NULL,
+                           `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                           PRIMARY KEY (`id`),
+                           KEY `webhook_user_id_fk` (`created_by`),
+                           CONSTRAINT `webhook_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`)
+);
+
+CREATE TABLE `webhook_delivery` (
+                                    `id` int NOT NULL AUTO_INCREMENT,
+                                    `webhook_id` int NOT NULL,
+                                    `event_type` varchar(32) NOT NULL,
+                                    `payload` text NOT NULL,
+                                    `status` varchar(16) NOT NULL DEFAULT 'pending',
+                                    `attempts` int NOT NULL DEFAULT '0',
+                                    `next_attempt_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    `last_attempt_at` timestamp NULL DEFAULT NULL,
+                                    `response_status` int DEFAULT NULL,
+                                    `last_error` varchar(1024) DEFAULT NULL,
+                                    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    `delivered_at` timestamp NULL DEFAULT NULL,
+                                    PRIMARY KEY (`id`),
+                                    KEY `webhook_delivery_due_index` (`status`, `next_attempt_at`),
+                                    KEY `webhook_delivery_webhook_id_fk` (`webhook_id`, `id`),
+                                    CONSTRAINT `webhook_delivery_webhook_id_fk` FOREIGN KEY (`webhook_id`) REFERENCES `webhook` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+-- Token lifecycle events, written in the same transaction as the token change they record,
+-- and relayed to their sinks in id order. Rows are kept once sent, until they age out.
+-- There is no foreign key to the token, so events outlive purged tokens.
+USE platform_engineer;
+
+CREATE TABLE `outbox` (
+                          `id` int NOT NULL AUTO_INCREMENT,
+                          `token_id` int NOT NULL,
+                          `event_type` varchar(32) NOT NULL,
+                          `payload` text NOT NULL,
+                          `attempts` int NOT NULL DEFAULT '0',
+                          `last_error` varchar(1024) DEFAULT NULL,
+                          `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          `sent_at` timestamp NULL DEFAULT NULL,
+                          PRIMARY KEY (`id`),
+                          KEY `outbox_pending_index` (`sent_at`, `id`)
+);
@@ -0,0 +1,20 @@
+-- Idempotency keys sent with mutating requests, along with the response to replay for them.
+-- A row without a status code is a request still being handled. Rows are deleted once they expire.
+USE platform_engineer;
+
+CREATE TABLE `idempotency_key` (
+                                   `id` int NOT NULL AUTO_INCREMENT,
+                                   `user_id` int NOT NULL,
+                                   `scope` varchar(255) NOT NULL,
+                                   `idempotency_key` varchar(255) NOT NULL,
+                                   `request_hash` char(64) NOT NULL,
+                                   `status_code` int DEFAULT NULL,
+                                   `content_type` varchar(255) DEFAULT NULL,
+                                   `response_body` mediumblob,
+                                   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   `expires_at` timestamp NOT NULL,
+                                   PRIMARY KEY (`id`),
+                                   UNIQUE KEY `idempotency_key_uindex` (`user_id`, `scope`, `idempotency_key`),
+                                   KEY `idempotency_key_expires_at_index` (`expires_at`),
+                                   CONSTRAINT `idempotency_key_user_id_fk` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+);
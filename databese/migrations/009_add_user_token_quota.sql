@@ -0,0 +1,5 @@
+-- Users can be given their own cap on active tokens, overriding the configured one
+USE platform_engineer;
+
+ALTER TABLE `user`
+    ADD `active_token_quota` int NULL DEFAULT NULL;
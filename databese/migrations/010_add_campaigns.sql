@@ -0,0 +1,23 @@
+-- Campaigns group tokens minted with shared defaults. Deleting a campaign leaves its tokens ungrouped.
+USE platform_engineer;
+
+CREATE TABLE `campaign` (
+                            `id` int NOT NULL AUTO_INCREMENT,
+                            `name` varchar(255) NOT NULL,
+                            `description` varchar(1024) DEFAULT NULL,
+                            `default_ttl_seconds` int DEFAULT NULL,
+                            `default_max_uses` int DEFAULT NULL,
+                            `starts_at` timestamp NULL DEFAULT NULL,
+                            `ends_at` timestamp NULL DEFAULT NULL,
+                            `revoked_at` timestamp NULL DEFAULT NULL,
+                            `created_by` int NOT NULL,
+                            `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            PRIMARY KEY (`id`),
+                            KEY `campaign_user_id_fk` (`created_by`),
+                            CONSTRAINT `campaign_user_id_fk` FOREIGN KEY (`created_by`) REFERENCES `user` (`id`)
+);
+
+ALTER TABLE `token`
+    ADD `campaign_id` int DEFAULT NULL,
+    ADD KEY `token_campaign_id_fk` (`campaign_id`, `id`),
+    ADD CONSTRAINT `token_campaign_id_fk` FOREIGN KEY (`campaign_id`) REFERENCES `campaign` (`id`) ON DELETE SET NULL;
@@ -0,0 +1,16 @@
+-- Outbox events failing every attempt are parked, so they stop holding back the events after them,
+-- and the sinks that accepted an event are recorded, so a retry skips them.
+USE platform_engineer;
+
+ALTER TABLE `outbox`
+    ADD `failed_at` timestamp NULL DEFAULT NULL,
+    DROP KEY `outbox_pending_index`,
+    ADD KEY `outbox_pending_index` (`sent_at`, `failed_at`, `id`);
+
+CREATE TABLE `outbox_delivery` (
+                                   `outbox_id` int NOT NULL,
+                                   `sink` varchar(32) NOT NULL,
+                                   `delivered_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   PRIMARY KEY (`outbox_id`, `sink`),
+                                   CONSTRAINT `outbox_delivery_outbox_id_fk` FOREIGN KEY (`outbox_id`) REFERENCES `outbox` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+-- Validations and redemptions of tokens are recorded as events
+USE platform_engineer;
+
+CREATE TABLE `token_event` (
+                               `id` int NOT NULL AUTO_INCREMENT,
+                               `token_id` int DEFAULT NULL,
+                               `action` varchar(16) NOT NULL,
+                               `outcome` varchar(16) NOT NULL,
+                               `ip` varchar(45) NOT NULL,
+                               `user_agent` varchar(512) NOT NULL,
+                               `request_id` varchar(64) NOT NULL,
+                               `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               PRIMARY KEY (`id`),
+                               KEY `token_event_token_id_fk` (`token_id`),
+                               CONSTRAINT `token_event_token_id_fk` FOREIGN KEY (`token_id`) REFERENCES `token` (`id`) ON DELETE CASCADE
+);
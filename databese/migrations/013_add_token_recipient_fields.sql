@@ -0,0 +1,9 @@
+-- Tokens carry a label, a note and the email of their recipient, which listings filter on
+USE platform_engineer;
+
+ALTER TABLE `token`
+    ADD `label` varchar(255) DEFAULT NULL,
+    ADD `note` varchar(1024) DEFAULT NULL,
+    ADD `recipient_email` varchar(320) DEFAULT NULL,
+    ADD KEY `token_label_index` (`label`),
+    ADD KEY `token_recipient_email_index` (`recipient_email`);
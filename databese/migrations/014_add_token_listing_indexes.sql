@@ -0,0 +1,6 @@
+-- Listings sort and page through tokens by created_at or expires_at, then id
+USE platform_engineer;
+
+ALTER TABLE `token`
+    ADD KEY `token_created_at_index` (`created_at`, `id`),
+    ADD KEY `token_expires_at_index` (`expires_at`, `id`);
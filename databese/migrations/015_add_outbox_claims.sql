@@ -0,0 +1,6 @@
+-- Relays claim the outbox events they publish for a lease, rather than keep them locked while publishing,
+-- so writers adding events aren't held up by the sinks.
+USE platform_engineer;
+
+ALTER TABLE `outbox`
+    ADD `claimed_until` timestamp NULL DEFAULT NULL;
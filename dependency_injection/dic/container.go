@@ -10,13 +10,26 @@ import (
 
 	providerPkg "platform_engineer_clone/dependency_injection/provider"
 
+	campaign1 "platform_engineer_clone/api/v0/campaign"
 	middlewares "platform_engineer_clone/api/v0/middlewares"
+	stream1 "platform_engineer_clone/api/v0/stream"
 	token1 "platform_engineer_clone/api/v0/token"
+	webhook1 "platform_engineer_clone/api/v0/webhook"
+	campaign "platform_engineer_clone/business/v0/campaign"
+	eventlog "platform_engineer_clone/business/v0/eventlog"
+	idempotency "platform_engineer_clone/business/v0/idempotency"
+	outbox1 "platform_engineer_clone/business/v0/outbox"
+	stream "platform_engineer_clone/business/v0/stream"
 	token "platform_engineer_clone/business/v0/token"
+	webhook "platform_engineer_clone/business/v0/webhook"
 	config "platform_engineer_clone/src/config"
 	mysql "platform_engineer_clone/src/persistence/mysql"
+	campaign2 "platform_engineer_clone/src/persistence/mysql/v0/campaign"
+	idempotency1 "platform_engineer_clone/src/persistence/mysql/v0/idempotency"
+	outbox "platform_engineer_clone/src/persistence/mysql/v0/outbox"
 	token2 "platform_engineer_clone/src/persistence/mysql/v0/token"
 	user "platform_engineer_clone/src/persistence/mysql/v0/user"
+	webhook2 "platform_engineer_clone/src/persistence/mysql/v0/webhook"
 )
 
 // C retrieves a Container from an interface.
@@ -221,6 +234,266 @@ func (c *Container) IsClosed() bool {
 	return c.ctn.IsClosed()
 }
 
+// SafeGetApiCampaign retrieves the "api_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_campaign"
+//	type: *campaign1.APICampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*campaign.BusinessCampaign) ["business_campaign"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetApiCampaign() (*campaign1.APICampaign, error) {
+	i, err := c.ctn.SafeGet("api_campaign")
+	if err != nil {
+		var eo *campaign1.APICampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign1.APICampaign)
+	if !ok {
+		return o, errors.New("could get 'api_campaign' because the object could not be cast to *campaign1.APICampaign")
+	}
+	return o, nil
+}
+
+// GetApiCampaign retrieves the "api_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_campaign"
+//	type: *campaign1.APICampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*campaign.BusinessCampaign) ["business_campaign"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetApiCampaign() *campaign1.APICampaign {
+	o, err := c.SafeGetApiCampaign()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiCampaign retrieves the "api_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_campaign"
+//	type: *campaign1.APICampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*campaign.BusinessCampaign) ["business_campaign"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetApiCampaign() (*campaign1.APICampaign, error) {
+	i, err := c.ctn.UnscopedSafeGet("api_campaign")
+	if err != nil {
+		var eo *campaign1.APICampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign1.APICampaign)
+	if !ok {
+		return o, errors.New("could get 'api_campaign' because the object could not be cast to *campaign1.APICampaign")
+	}
+	return o, nil
+}
+
+// UnscopedGetApiCampaign retrieves the "api_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_campaign"
+//	type: *campaign1.APICampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*campaign.BusinessCampaign) ["business_campaign"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetApiCampaign() *campaign1.APICampaign {
+	o, err := c.UnscopedSafeGetApiCampaign()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiCampaign retrieves the "api_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_campaign"
+//	type: *campaign1.APICampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*campaign.BusinessCampaign) ["business_campaign"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetApiCampaign method.
+// If the container can not be retrieved, it panics.
+func ApiCampaign(i interface{}) *campaign1.APICampaign {
+	return C(i).GetApiCampaign()
+}
+
+// SafeGetApiIdempotency retrieves the "api_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_idempotency"
+//	type: *middlewares.Idempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*idempotency.BusinessIdempotency) ["business_idempotency"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetApiIdempotency() (*middlewares.Idempotency, error) {
+	i, err := c.ctn.SafeGet("api_idempotency")
+	if err != nil {
+		var eo *middlewares.Idempotency
+		return eo, err
+	}
+	o, ok := i.(*middlewares.Idempotency)
+	if !ok {
+		return o, errors.New("could get 'api_idempotency' because the object could not be cast to *middlewares.Idempotency")
+	}
+	return o, nil
+}
+
+// GetApiIdempotency retrieves the "api_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_idempotency"
+//	type: *middlewares.Idempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*idempotency.BusinessIdempotency) ["business_idempotency"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetApiIdempotency() *middlewares.Idempotency {
+	o, err := c.SafeGetApiIdempotency()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiIdempotency retrieves the "api_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_idempotency"
+//	type: *middlewares.Idempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*idempotency.BusinessIdempotency) ["business_idempotency"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetApiIdempotency() (*middlewares.Idempotency, error) {
+	i, err := c.ctn.UnscopedSafeGet("api_idempotency")
+	if err != nil {
+		var eo *middlewares.Idempotency
+		return eo, err
+	}
+	o, ok := i.(*middlewares.Idempotency)
+	if !ok {
+		return o, errors.New("could get 'api_idempotency' because the object could not be cast to *middlewares.Idempotency")
+	}
+	return o, nil
+}
+
+// UnscopedGetApiIdempotency retrieves the "api_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_idempotency"
+//	type: *middlewares.Idempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*idempotency.BusinessIdempotency) ["business_idempotency"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetApiIdempotency() *middlewares.Idempotency {
+	o, err := c.UnscopedSafeGetApiIdempotency()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiIdempotency retrieves the "api_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_idempotency"
+//	type: *middlewares.Idempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*idempotency.BusinessIdempotency) ["business_idempotency"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetApiIdempotency method.
+// If the container can not be retrieved, it panics.
+func ApiIdempotency(i interface{}) *middlewares.Idempotency {
+	return C(i).GetApiIdempotency()
+}
+
 // SafeGetApiMiddlewares retrieves the "api_middlewares" object from the main scope.
 //
 // ---------------------------------------------
@@ -351,69 +624,72 @@ func ApiMiddlewares(i interface{}) *middlewares.AuthRoutes {
 	return C(i).GetApiMiddlewares()
 }
 
-// SafeGetApiToken retrieves the "api_token" object from the main scope.
+// SafeGetApiStream retrieves the "api_stream" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "api_token"
-//	type: *token1.APIToken
+//	name: "api_stream"
+//	type: *stream1.APIStream
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*token.BusinessToken) ["business_token"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*stream.Broker) ["business_stream"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it returns an error.
-func (c *Container) SafeGetApiToken() (*token1.APIToken, error) {
-	i, err := c.ctn.SafeGet("api_token")
+func (c *Container) SafeGetApiStream() (*stream1.APIStream, error) {
+	i, err := c.ctn.SafeGet("api_stream")
 	if err != nil {
-		var eo *token1.APIToken
+		var eo *stream1.APIStream
 		return eo, err
 	}
-	o, ok := i.(*token1.APIToken)
+	o, ok := i.(*stream1.APIStream)
 	if !ok {
-		return o, errors.New("could get 'api_token' because the object could not be cast to *token1.APIToken")
+		return o, errors.New("could get 'api_stream' because the object could not be cast to *stream1.APIStream")
 	}
 	return o, nil
 }
 
-// GetApiToken retrieves the "api_token" object from the main scope.
+// GetApiStream retrieves the "api_stream" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "api_token"
-//	type: *token1.APIToken
+//	name: "api_stream"
+//	type: *stream1.APIStream
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*token.BusinessToken) ["business_token"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*stream.Broker) ["business_stream"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it panics.
-func (c *Container) GetApiToken() *token1.APIToken {
-	o, err := c.SafeGetApiToken()
+func (c *Container) GetApiStream() *stream1.APIStream {
+	o, err := c.SafeGetApiStream()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetApiToken retrieves the "api_token" object from the main scope.
+// UnscopedSafeGetApiStream retrieves the "api_stream" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "api_token"
-//	type: *token1.APIToken
+//	name: "api_stream"
+//	type: *stream1.APIStream
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*token.BusinessToken) ["business_token"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*stream.Broker) ["business_stream"]
 //	unshared: false
 //	close: false
 //
@@ -421,29 +697,30 @@ func (c *Container) GetApiToken() *token1.APIToken {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it returns an error.
-func (c *Container) UnscopedSafeGetApiToken() (*token1.APIToken, error) {
-	i, err := c.ctn.UnscopedSafeGet("api_token")
+func (c *Container) UnscopedSafeGetApiStream() (*stream1.APIStream, error) {
+	i, err := c.ctn.UnscopedSafeGet("api_stream")
 	if err != nil {
-		var eo *token1.APIToken
+		var eo *stream1.APIStream
 		return eo, err
 	}
-	o, ok := i.(*token1.APIToken)
+	o, ok := i.(*stream1.APIStream)
 	if !ok {
-		return o, errors.New("could get 'api_token' because the object could not be cast to *token1.APIToken")
+		return o, errors.New("could get 'api_stream' because the object could not be cast to *stream1.APIStream")
 	}
 	return o, nil
 }
 
-// UnscopedGetApiToken retrieves the "api_token" object from the main scope.
+// UnscopedGetApiStream retrieves the "api_stream" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "api_token"
-//	type: *token1.APIToken
+//	name: "api_stream"
+//	type: *stream1.APIStream
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*token.BusinessToken) ["business_token"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*stream.Broker) ["business_stream"]
 //	unshared: false
 //	close: false
 //
@@ -451,102 +728,1720 @@ func (c *Container) UnscopedSafeGetApiToken() (*token1.APIToken, error) {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it panics.
-func (c *Container) UnscopedGetApiToken() *token1.APIToken {
-	o, err := c.UnscopedSafeGetApiToken()
+func (c *Container) UnscopedGetApiStream() *stream1.APIStream {
+	o, err := c.UnscopedSafeGetApiStream()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// ApiToken retrieves the "api_token" object from the main scope.
+// ApiStream retrieves the "api_stream" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "api_token"
-//	type: *token1.APIToken
+//	name: "api_stream"
+//	type: *stream1.APIStream
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*token.BusinessToken) ["business_token"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*stream.Broker) ["business_stream"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it calls the GetApiToken method.
+// If the container can be retrieved, it calls the GetApiStream method.
 // If the container can not be retrieved, it panics.
-func ApiToken(i interface{}) *token1.APIToken {
-	return C(i).GetApiToken()
+func ApiStream(i interface{}) *stream1.APIStream {
+	return C(i).GetApiStream()
 }
 
-// SafeGetBusinessToken retrieves the "business_token" object from the main scope.
+// SafeGetApiToken retrieves the "api_token" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "business_token"
-//	type: *token.BusinessToken
+//	name: "api_token"
+//	type: *token1.APIToken
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
-//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "0": Service(*token.BusinessToken) ["business_token"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it returns an error.
-func (c *Container) SafeGetBusinessToken() (*token.BusinessToken, error) {
-	i, err := c.ctn.SafeGet("business_token")
+func (c *Container) SafeGetApiToken() (*token1.APIToken, error) {
+	i, err := c.ctn.SafeGet("api_token")
+	if err != nil {
+		var eo *token1.APIToken
+		return eo, err
+	}
+	o, ok := i.(*token1.APIToken)
+	if !ok {
+		return o, errors.New("could get 'api_token' because the object could not be cast to *token1.APIToken")
+	}
+	return o, nil
+}
+
+// GetApiToken retrieves the "api_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_token"
+//	type: *token1.APIToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetApiToken() *token1.APIToken {
+	o, err := c.SafeGetApiToken()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiToken retrieves the "api_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_token"
+//	type: *token1.APIToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetApiToken() (*token1.APIToken, error) {
+	i, err := c.ctn.UnscopedSafeGet("api_token")
+	if err != nil {
+		var eo *token1.APIToken
+		return eo, err
+	}
+	o, ok := i.(*token1.APIToken)
+	if !ok {
+		return o, errors.New("could get 'api_token' because the object could not be cast to *token1.APIToken")
+	}
+	return o, nil
+}
+
+// UnscopedGetApiToken retrieves the "api_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_token"
+//	type: *token1.APIToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetApiToken() *token1.APIToken {
+	o, err := c.UnscopedSafeGetApiToken()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiToken retrieves the "api_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_token"
+//	type: *token1.APIToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetApiToken method.
+// If the container can not be retrieved, it panics.
+func ApiToken(i interface{}) *token1.APIToken {
+	return C(i).GetApiToken()
+}
+
+// SafeGetApiWebhook retrieves the "api_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_webhook"
+//	type: *webhook1.APIWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetApiWebhook() (*webhook1.APIWebhook, error) {
+	i, err := c.ctn.SafeGet("api_webhook")
+	if err != nil {
+		var eo *webhook1.APIWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook1.APIWebhook)
+	if !ok {
+		return o, errors.New("could get 'api_webhook' because the object could not be cast to *webhook1.APIWebhook")
+	}
+	return o, nil
+}
+
+// GetApiWebhook retrieves the "api_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_webhook"
+//	type: *webhook1.APIWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetApiWebhook() *webhook1.APIWebhook {
+	o, err := c.SafeGetApiWebhook()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiWebhook retrieves the "api_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_webhook"
+//	type: *webhook1.APIWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetApiWebhook() (*webhook1.APIWebhook, error) {
+	i, err := c.ctn.UnscopedSafeGet("api_webhook")
+	if err != nil {
+		var eo *webhook1.APIWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook1.APIWebhook)
+	if !ok {
+		return o, errors.New("could get 'api_webhook' because the object could not be cast to *webhook1.APIWebhook")
+	}
+	return o, nil
+}
+
+// UnscopedGetApiWebhook retrieves the "api_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_webhook"
+//	type: *webhook1.APIWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetApiWebhook() *webhook1.APIWebhook {
+	o, err := c.UnscopedSafeGetApiWebhook()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiWebhook retrieves the "api_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "api_webhook"
+//	type: *webhook1.APIWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetApiWebhook method.
+// If the container can not be retrieved, it panics.
+func ApiWebhook(i interface{}) *webhook1.APIWebhook {
+	return C(i).GetApiWebhook()
+}
+
+// SafeGetBusinessCampaign retrieves the "business_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_campaign"
+//	type: *campaign.BusinessCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*campaign2.PersistenceCampaign) ["mysql_campaign_persistence"]
+//		- "2": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessCampaign() (*campaign.BusinessCampaign, error) {
+	i, err := c.ctn.SafeGet("business_campaign")
+	if err != nil {
+		var eo *campaign.BusinessCampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign.BusinessCampaign)
+	if !ok {
+		return o, errors.New("could get 'business_campaign' because the object could not be cast to *campaign.BusinessCampaign")
+	}
+	return o, nil
+}
+
+// GetBusinessCampaign retrieves the "business_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_campaign"
+//	type: *campaign.BusinessCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*campaign2.PersistenceCampaign) ["mysql_campaign_persistence"]
+//		- "2": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessCampaign() *campaign.BusinessCampaign {
+	o, err := c.SafeGetBusinessCampaign()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessCampaign retrieves the "business_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_campaign"
+//	type: *campaign.BusinessCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*campaign2.PersistenceCampaign) ["mysql_campaign_persistence"]
+//		- "2": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessCampaign() (*campaign.BusinessCampaign, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_campaign")
+	if err != nil {
+		var eo *campaign.BusinessCampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign.BusinessCampaign)
+	if !ok {
+		return o, errors.New("could get 'business_campaign' because the object could not be cast to *campaign.BusinessCampaign")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessCampaign retrieves the "business_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_campaign"
+//	type: *campaign.BusinessCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*campaign2.PersistenceCampaign) ["mysql_campaign_persistence"]
+//		- "2": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessCampaign() *campaign.BusinessCampaign {
+	o, err := c.UnscopedSafeGetBusinessCampaign()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessCampaign retrieves the "business_campaign" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_campaign"
+//	type: *campaign.BusinessCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*campaign2.PersistenceCampaign) ["mysql_campaign_persistence"]
+//		- "2": Service(*token.BusinessToken) ["business_token"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessCampaign method.
+// If the container can not be retrieved, it panics.
+func BusinessCampaign(i interface{}) *campaign.BusinessCampaign {
+	return C(i).GetBusinessCampaign()
+}
+
+// SafeGetBusinessEventLog retrieves the "business_event_log" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_event_log"
+//	type: *eventlog.EventLog
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessEventLog() (*eventlog.EventLog, error) {
+	i, err := c.ctn.SafeGet("business_event_log")
+	if err != nil {
+		var eo *eventlog.EventLog
+		return eo, err
+	}
+	o, ok := i.(*eventlog.EventLog)
+	if !ok {
+		return o, errors.New("could get 'business_event_log' because the object could not be cast to *eventlog.EventLog")
+	}
+	return o, nil
+}
+
+// GetBusinessEventLog retrieves the "business_event_log" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_event_log"
+//	type: *eventlog.EventLog
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessEventLog() *eventlog.EventLog {
+	o, err := c.SafeGetBusinessEventLog()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessEventLog retrieves the "business_event_log" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_event_log"
+//	type: *eventlog.EventLog
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessEventLog() (*eventlog.EventLog, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_event_log")
+	if err != nil {
+		var eo *eventlog.EventLog
+		return eo, err
+	}
+	o, ok := i.(*eventlog.EventLog)
+	if !ok {
+		return o, errors.New("could get 'business_event_log' because the object could not be cast to *eventlog.EventLog")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessEventLog retrieves the "business_event_log" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_event_log"
+//	type: *eventlog.EventLog
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessEventLog() *eventlog.EventLog {
+	o, err := c.UnscopedSafeGetBusinessEventLog()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessEventLog retrieves the "business_event_log" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_event_log"
+//	type: *eventlog.EventLog
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessEventLog method.
+// If the container can not be retrieved, it panics.
+func BusinessEventLog(i interface{}) *eventlog.EventLog {
+	return C(i).GetBusinessEventLog()
+}
+
+// SafeGetBusinessIdempotency retrieves the "business_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_idempotency"
+//	type: *idempotency.BusinessIdempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*idempotency1.PersistenceIdempotency) ["mysql_idempotency_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessIdempotency() (*idempotency.BusinessIdempotency, error) {
+	i, err := c.ctn.SafeGet("business_idempotency")
+	if err != nil {
+		var eo *idempotency.BusinessIdempotency
+		return eo, err
+	}
+	o, ok := i.(*idempotency.BusinessIdempotency)
+	if !ok {
+		return o, errors.New("could get 'business_idempotency' because the object could not be cast to *idempotency.BusinessIdempotency")
+	}
+	return o, nil
+}
+
+// GetBusinessIdempotency retrieves the "business_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_idempotency"
+//	type: *idempotency.BusinessIdempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*idempotency1.PersistenceIdempotency) ["mysql_idempotency_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessIdempotency() *idempotency.BusinessIdempotency {
+	o, err := c.SafeGetBusinessIdempotency()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessIdempotency retrieves the "business_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_idempotency"
+//	type: *idempotency.BusinessIdempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*idempotency1.PersistenceIdempotency) ["mysql_idempotency_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessIdempotency() (*idempotency.BusinessIdempotency, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_idempotency")
+	if err != nil {
+		var eo *idempotency.BusinessIdempotency
+		return eo, err
+	}
+	o, ok := i.(*idempotency.BusinessIdempotency)
+	if !ok {
+		return o, errors.New("could get 'business_idempotency' because the object could not be cast to *idempotency.BusinessIdempotency")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessIdempotency retrieves the "business_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_idempotency"
+//	type: *idempotency.BusinessIdempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*idempotency1.PersistenceIdempotency) ["mysql_idempotency_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessIdempotency() *idempotency.BusinessIdempotency {
+	o, err := c.UnscopedSafeGetBusinessIdempotency()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessIdempotency retrieves the "business_idempotency" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_idempotency"
+//	type: *idempotency.BusinessIdempotency
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*idempotency1.PersistenceIdempotency) ["mysql_idempotency_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessIdempotency method.
+// If the container can not be retrieved, it panics.
+func BusinessIdempotency(i interface{}) *idempotency.BusinessIdempotency {
+	return C(i).GetBusinessIdempotency()
+}
+
+// SafeGetBusinessOutboxRelay retrieves the "business_outbox_relay" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_outbox_relay"
+//	type: *outbox1.OutboxRelay
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*outbox.PersistenceOutbox) ["mysql_outbox_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessOutboxRelay() (*outbox1.OutboxRelay, error) {
+	i, err := c.ctn.SafeGet("business_outbox_relay")
+	if err != nil {
+		var eo *outbox1.OutboxRelay
+		return eo, err
+	}
+	o, ok := i.(*outbox1.OutboxRelay)
+	if !ok {
+		return o, errors.New("could get 'business_outbox_relay' because the object could not be cast to *outbox1.OutboxRelay")
+	}
+	return o, nil
+}
+
+// GetBusinessOutboxRelay retrieves the "business_outbox_relay" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_outbox_relay"
+//	type: *outbox1.OutboxRelay
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*outbox.PersistenceOutbox) ["mysql_outbox_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessOutboxRelay() *outbox1.OutboxRelay {
+	o, err := c.SafeGetBusinessOutboxRelay()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessOutboxRelay retrieves the "business_outbox_relay" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_outbox_relay"
+//	type: *outbox1.OutboxRelay
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*outbox.PersistenceOutbox) ["mysql_outbox_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessOutboxRelay() (*outbox1.OutboxRelay, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_outbox_relay")
+	if err != nil {
+		var eo *outbox1.OutboxRelay
+		return eo, err
+	}
+	o, ok := i.(*outbox1.OutboxRelay)
+	if !ok {
+		return o, errors.New("could get 'business_outbox_relay' because the object could not be cast to *outbox1.OutboxRelay")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessOutboxRelay retrieves the "business_outbox_relay" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_outbox_relay"
+//	type: *outbox1.OutboxRelay
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*outbox.PersistenceOutbox) ["mysql_outbox_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessOutboxRelay() *outbox1.OutboxRelay {
+	o, err := c.UnscopedSafeGetBusinessOutboxRelay()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessOutboxRelay retrieves the "business_outbox_relay" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_outbox_relay"
+//	type: *outbox1.OutboxRelay
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*outbox.PersistenceOutbox) ["mysql_outbox_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessOutboxRelay method.
+// If the container can not be retrieved, it panics.
+func BusinessOutboxRelay(i interface{}) *outbox1.OutboxRelay {
+	return C(i).GetBusinessOutboxRelay()
+}
+
+// SafeGetBusinessStream retrieves the "business_stream" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_stream"
+//	type: *stream.Broker
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessStream() (*stream.Broker, error) {
+	i, err := c.ctn.SafeGet("business_stream")
+	if err != nil {
+		var eo *stream.Broker
+		return eo, err
+	}
+	o, ok := i.(*stream.Broker)
+	if !ok {
+		return o, errors.New("could get 'business_stream' because the object could not be cast to *stream.Broker")
+	}
+	return o, nil
+}
+
+// GetBusinessStream retrieves the "business_stream" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_stream"
+//	type: *stream.Broker
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessStream() *stream.Broker {
+	o, err := c.SafeGetBusinessStream()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessStream retrieves the "business_stream" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_stream"
+//	type: *stream.Broker
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessStream() (*stream.Broker, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_stream")
+	if err != nil {
+		var eo *stream.Broker
+		return eo, err
+	}
+	o, ok := i.(*stream.Broker)
+	if !ok {
+		return o, errors.New("could get 'business_stream' because the object could not be cast to *stream.Broker")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessStream retrieves the "business_stream" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_stream"
+//	type: *stream.Broker
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessStream() *stream.Broker {
+	o, err := c.UnscopedSafeGetBusinessStream()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessStream retrieves the "business_stream" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_stream"
+//	type: *stream.Broker
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessStream method.
+// If the container can not be retrieved, it panics.
+func BusinessStream(i interface{}) *stream.Broker {
+	return C(i).GetBusinessStream()
+}
+
+// SafeGetBusinessToken retrieves the "business_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token"
+//	type: *token.BusinessToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessToken() (*token.BusinessToken, error) {
+	i, err := c.ctn.SafeGet("business_token")
+	if err != nil {
+		var eo *token.BusinessToken
+		return eo, err
+	}
+	o, ok := i.(*token.BusinessToken)
+	if !ok {
+		return o, errors.New("could get 'business_token' because the object could not be cast to *token.BusinessToken")
+	}
+	return o, nil
+}
+
+// GetBusinessToken retrieves the "business_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token"
+//	type: *token.BusinessToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessToken() *token.BusinessToken {
+	o, err := c.SafeGetBusinessToken()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessToken retrieves the "business_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token"
+//	type: *token.BusinessToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessToken() (*token.BusinessToken, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_token")
+	if err != nil {
+		var eo *token.BusinessToken
+		return eo, err
+	}
+	o, ok := i.(*token.BusinessToken)
+	if !ok {
+		return o, errors.New("could get 'business_token' because the object could not be cast to *token.BusinessToken")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessToken retrieves the "business_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token"
+//	type: *token.BusinessToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessToken() *token.BusinessToken {
+	o, err := c.UnscopedSafeGetBusinessToken()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessToken retrieves the "business_token" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token"
+//	type: *token.BusinessToken
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//		- "2": Service(*webhook.BusinessWebhook) ["business_webhook"]
+//		- "3": Service(*stream.Broker) ["business_stream"]
+//		- "4": Service(*eventlog.EventLog) ["business_event_log"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessToken method.
+// If the container can not be retrieved, it panics.
+func BusinessToken(i interface{}) *token.BusinessToken {
+	return C(i).GetBusinessToken()
+}
+
+// SafeGetBusinessTokenPurger retrieves the "business_token_purger" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token_purger"
+//	type: *token.TokenPurger
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessTokenPurger() (*token.TokenPurger, error) {
+	i, err := c.ctn.SafeGet("business_token_purger")
+	if err != nil {
+		var eo *token.TokenPurger
+		return eo, err
+	}
+	o, ok := i.(*token.TokenPurger)
+	if !ok {
+		return o, errors.New("could get 'business_token_purger' because the object could not be cast to *token.TokenPurger")
+	}
+	return o, nil
+}
+
+// GetBusinessTokenPurger retrieves the "business_token_purger" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token_purger"
+//	type: *token.TokenPurger
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessTokenPurger() *token.TokenPurger {
+	o, err := c.SafeGetBusinessTokenPurger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessTokenPurger retrieves the "business_token_purger" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token_purger"
+//	type: *token.TokenPurger
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessTokenPurger() (*token.TokenPurger, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_token_purger")
+	if err != nil {
+		var eo *token.TokenPurger
+		return eo, err
+	}
+	o, ok := i.(*token.TokenPurger)
+	if !ok {
+		return o, errors.New("could get 'business_token_purger' because the object could not be cast to *token.TokenPurger")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessTokenPurger retrieves the "business_token_purger" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token_purger"
+//	type: *token.TokenPurger
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessTokenPurger() *token.TokenPurger {
+	o, err := c.UnscopedSafeGetBusinessTokenPurger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessTokenPurger retrieves the "business_token_purger" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_token_purger"
+//	type: *token.TokenPurger
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessTokenPurger method.
+// If the container can not be retrieved, it panics.
+func BusinessTokenPurger(i interface{}) *token.TokenPurger {
+	return C(i).GetBusinessTokenPurger()
+}
+
+// SafeGetBusinessWebhook retrieves the "business_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_webhook"
+//	type: *webhook.BusinessWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*webhook2.PersistenceWebhook) ["mysql_webhook_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetBusinessWebhook() (*webhook.BusinessWebhook, error) {
+	i, err := c.ctn.SafeGet("business_webhook")
+	if err != nil {
+		var eo *webhook.BusinessWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook.BusinessWebhook)
+	if !ok {
+		return o, errors.New("could get 'business_webhook' because the object could not be cast to *webhook.BusinessWebhook")
+	}
+	return o, nil
+}
+
+// GetBusinessWebhook retrieves the "business_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_webhook"
+//	type: *webhook.BusinessWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*webhook2.PersistenceWebhook) ["mysql_webhook_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetBusinessWebhook() *webhook.BusinessWebhook {
+	o, err := c.SafeGetBusinessWebhook()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBusinessWebhook retrieves the "business_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_webhook"
+//	type: *webhook.BusinessWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*webhook2.PersistenceWebhook) ["mysql_webhook_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetBusinessWebhook() (*webhook.BusinessWebhook, error) {
+	i, err := c.ctn.UnscopedSafeGet("business_webhook")
+	if err != nil {
+		var eo *webhook.BusinessWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook.BusinessWebhook)
+	if !ok {
+		return o, errors.New("could get 'business_webhook' because the object could not be cast to *webhook.BusinessWebhook")
+	}
+	return o, nil
+}
+
+// UnscopedGetBusinessWebhook retrieves the "business_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_webhook"
+//	type: *webhook.BusinessWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*webhook2.PersistenceWebhook) ["mysql_webhook_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetBusinessWebhook() *webhook.BusinessWebhook {
+	o, err := c.UnscopedSafeGetBusinessWebhook()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BusinessWebhook retrieves the "business_webhook" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "business_webhook"
+//	type: *webhook.BusinessWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*webhook2.PersistenceWebhook) ["mysql_webhook_persistence"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetBusinessWebhook method.
+// If the container can not be retrieved, it panics.
+func BusinessWebhook(i interface{}) *webhook.BusinessWebhook {
+	return C(i).GetBusinessWebhook()
+}
+
+// SafeGetConfig retrieves the "config" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "config"
+//	type: *config.Config
+//	scope: "main"
+//	build: func
+//	params: nil
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetConfig() (*config.Config, error) {
+	i, err := c.ctn.SafeGet("config")
+	if err != nil {
+		var eo *config.Config
+		return eo, err
+	}
+	o, ok := i.(*config.Config)
+	if !ok {
+		return o, errors.New("could get 'config' because the object could not be cast to *config.Config")
+	}
+	return o, nil
+}
+
+// GetConfig retrieves the "config" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "config"
+//	type: *config.Config
+//	scope: "main"
+//	build: func
+//	params: nil
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetConfig() *config.Config {
+	o, err := c.SafeGetConfig()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConfig retrieves the "config" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "config"
+//	type: *config.Config
+//	scope: "main"
+//	build: func
+//	params: nil
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetConfig() (*config.Config, error) {
+	i, err := c.ctn.UnscopedSafeGet("config")
+	if err != nil {
+		var eo *config.Config
+		return eo, err
+	}
+	o, ok := i.(*config.Config)
+	if !ok {
+		return o, errors.New("could get 'config' because the object could not be cast to *config.Config")
+	}
+	return o, nil
+}
+
+// UnscopedGetConfig retrieves the "config" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "config"
+//	type: *config.Config
+//	scope: "main"
+//	build: func
+//	params: nil
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetConfig() *config.Config {
+	o, err := c.UnscopedSafeGetConfig()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Config retrieves the "config" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "config"
+//	type: *config.Config
+//	scope: "main"
+//	build: func
+//	params: nil
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetConfig method.
+// If the container can not be retrieved, it panics.
+func Config(i interface{}) *config.Config {
+	return C(i).GetConfig()
+}
+
+// SafeGetMysqlCampaignPersistence retrieves the "mysql_campaign_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_campaign_persistence"
+//	type: *campaign2.PersistenceCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetMysqlCampaignPersistence() (*campaign2.PersistenceCampaign, error) {
+	i, err := c.ctn.SafeGet("mysql_campaign_persistence")
+	if err != nil {
+		var eo *campaign2.PersistenceCampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign2.PersistenceCampaign)
+	if !ok {
+		return o, errors.New("could get 'mysql_campaign_persistence' because the object could not be cast to *campaign2.PersistenceCampaign")
+	}
+	return o, nil
+}
+
+// GetMysqlCampaignPersistence retrieves the "mysql_campaign_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_campaign_persistence"
+//	type: *campaign2.PersistenceCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetMysqlCampaignPersistence() *campaign2.PersistenceCampaign {
+	o, err := c.SafeGetMysqlCampaignPersistence()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMysqlCampaignPersistence retrieves the "mysql_campaign_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_campaign_persistence"
+//	type: *campaign2.PersistenceCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetMysqlCampaignPersistence() (*campaign2.PersistenceCampaign, error) {
+	i, err := c.ctn.UnscopedSafeGet("mysql_campaign_persistence")
+	if err != nil {
+		var eo *campaign2.PersistenceCampaign
+		return eo, err
+	}
+	o, ok := i.(*campaign2.PersistenceCampaign)
+	if !ok {
+		return o, errors.New("could get 'mysql_campaign_persistence' because the object could not be cast to *campaign2.PersistenceCampaign")
+	}
+	return o, nil
+}
+
+// UnscopedGetMysqlCampaignPersistence retrieves the "mysql_campaign_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_campaign_persistence"
+//	type: *campaign2.PersistenceCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetMysqlCampaignPersistence() *campaign2.PersistenceCampaign {
+	o, err := c.UnscopedSafeGetMysqlCampaignPersistence()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MysqlCampaignPersistence retrieves the "mysql_campaign_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_campaign_persistence"
+//	type: *campaign2.PersistenceCampaign
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetMysqlCampaignPersistence method.
+// If the container can not be retrieved, it panics.
+func MysqlCampaignPersistence(i interface{}) *campaign2.PersistenceCampaign {
+	return C(i).GetMysqlCampaignPersistence()
+}
+
+// SafeGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_connection"
+//	type: *mysql.MYSQLConnection
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*config.Config) ["config"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetMysqlConnection() (*mysql.MYSQLConnection, error) {
+	i, err := c.ctn.SafeGet("mysql_connection")
 	if err != nil {
-		var eo *token.BusinessToken
+		var eo *mysql.MYSQLConnection
 		return eo, err
 	}
-	o, ok := i.(*token.BusinessToken)
+	o, ok := i.(*mysql.MYSQLConnection)
 	if !ok {
-		return o, errors.New("could get 'business_token' because the object could not be cast to *token.BusinessToken")
+		return o, errors.New("could get 'mysql_connection' because the object could not be cast to *mysql.MYSQLConnection")
 	}
 	return o, nil
 }
 
-// GetBusinessToken retrieves the "business_token" object from the main scope.
+// GetMysqlConnection retrieves the "mysql_connection" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "business_token"
-//	type: *token.BusinessToken
+//	name: "mysql_connection"
+//	type: *mysql.MYSQLConnection
 //	scope: "main"
 //	build: func
 //	params:
 //		- "0": Service(*config.Config) ["config"]
-//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it panics.
-func (c *Container) GetBusinessToken() *token.BusinessToken {
-	o, err := c.SafeGetBusinessToken()
+func (c *Container) GetMysqlConnection() *mysql.MYSQLConnection {
+	o, err := c.SafeGetMysqlConnection()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetBusinessToken retrieves the "business_token" object from the main scope.
+// UnscopedSafeGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "business_token"
-//	type: *token.BusinessToken
+//	name: "mysql_connection"
+//	type: *mysql.MYSQLConnection
 //	scope: "main"
 //	build: func
 //	params:
 //		- "0": Service(*config.Config) ["config"]
-//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
 //	unshared: false
 //	close: false
 //
@@ -554,30 +2449,29 @@ func (c *Container) GetBusinessToken() *token.BusinessToken {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it returns an error.
-func (c *Container) UnscopedSafeGetBusinessToken() (*token.BusinessToken, error) {
-	i, err := c.ctn.UnscopedSafeGet("business_token")
+func (c *Container) UnscopedSafeGetMysqlConnection() (*mysql.MYSQLConnection, error) {
+	i, err := c.ctn.UnscopedSafeGet("mysql_connection")
 	if err != nil {
-		var eo *token.BusinessToken
+		var eo *mysql.MYSQLConnection
 		return eo, err
 	}
-	o, ok := i.(*token.BusinessToken)
+	o, ok := i.(*mysql.MYSQLConnection)
 	if !ok {
-		return o, errors.New("could get 'business_token' because the object could not be cast to *token.BusinessToken")
+		return o, errors.New("could get 'mysql_connection' because the object could not be cast to *mysql.MYSQLConnection")
 	}
 	return o, nil
 }
 
-// UnscopedGetBusinessToken retrieves the "business_token" object from the main scope.
+// UnscopedGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "business_token"
-//	type: *token.BusinessToken
+//	name: "mysql_connection"
+//	type: *mysql.MYSQLConnection
 //	scope: "main"
 //	build: func
 //	params:
 //		- "0": Service(*config.Config) ["config"]
-//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
 //	unshared: false
 //	close: false
 //
@@ -585,97 +2479,99 @@ func (c *Container) UnscopedSafeGetBusinessToken() (*token.BusinessToken, error)
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it panics.
-func (c *Container) UnscopedGetBusinessToken() *token.BusinessToken {
-	o, err := c.UnscopedSafeGetBusinessToken()
+func (c *Container) UnscopedGetMysqlConnection() *mysql.MYSQLConnection {
+	o, err := c.UnscopedSafeGetMysqlConnection()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// BusinessToken retrieves the "business_token" object from the main scope.
+// MysqlConnection retrieves the "mysql_connection" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "business_token"
-//	type: *token.BusinessToken
+//	name: "mysql_connection"
+//	type: *mysql.MYSQLConnection
 //	scope: "main"
 //	build: func
 //	params:
 //		- "0": Service(*config.Config) ["config"]
-//		- "1": Service(*token2.PersistenceToken) ["mysql_token_persistence"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it calls the GetBusinessToken method.
+// If the container can be retrieved, it calls the GetMysqlConnection method.
 // If the container can not be retrieved, it panics.
-func BusinessToken(i interface{}) *token.BusinessToken {
-	return C(i).GetBusinessToken()
+func MysqlConnection(i interface{}) *mysql.MYSQLConnection {
+	return C(i).GetMysqlConnection()
 }
 
-// SafeGetConfig retrieves the "config" object from the main scope.
+// SafeGetMysqlIdempotencyPersistence retrieves the "mysql_idempotency_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "config"
-//	type: *config.Config
+//	name: "mysql_idempotency_persistence"
+//	type: *idempotency1.PersistenceIdempotency
 //	scope: "main"
 //	build: func
-//	params: nil
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it returns an error.
-func (c *Container) SafeGetConfig() (*config.Config, error) {
-	i, err := c.ctn.SafeGet("config")
+func (c *Container) SafeGetMysqlIdempotencyPersistence() (*idempotency1.PersistenceIdempotency, error) {
+	i, err := c.ctn.SafeGet("mysql_idempotency_persistence")
 	if err != nil {
-		var eo *config.Config
+		var eo *idempotency1.PersistenceIdempotency
 		return eo, err
 	}
-	o, ok := i.(*config.Config)
+	o, ok := i.(*idempotency1.PersistenceIdempotency)
 	if !ok {
-		return o, errors.New("could get 'config' because the object could not be cast to *config.Config")
+		return o, errors.New("could get 'mysql_idempotency_persistence' because the object could not be cast to *idempotency1.PersistenceIdempotency")
 	}
 	return o, nil
 }
 
-// GetConfig retrieves the "config" object from the main scope.
+// GetMysqlIdempotencyPersistence retrieves the "mysql_idempotency_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "config"
-//	type: *config.Config
+//	name: "mysql_idempotency_persistence"
+//	type: *idempotency1.PersistenceIdempotency
 //	scope: "main"
 //	build: func
-//	params: nil
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it panics.
-func (c *Container) GetConfig() *config.Config {
-	o, err := c.SafeGetConfig()
+func (c *Container) GetMysqlIdempotencyPersistence() *idempotency1.PersistenceIdempotency {
+	o, err := c.SafeGetMysqlIdempotencyPersistence()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetConfig retrieves the "config" object from the main scope.
+// UnscopedSafeGetMysqlIdempotencyPersistence retrieves the "mysql_idempotency_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "config"
-//	type: *config.Config
+//	name: "mysql_idempotency_persistence"
+//	type: *idempotency1.PersistenceIdempotency
 //	scope: "main"
 //	build: func
-//	params: nil
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -683,28 +2579,29 @@ func (c *Container) GetConfig() *config.Config {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it returns an error.
-func (c *Container) UnscopedSafeGetConfig() (*config.Config, error) {
-	i, err := c.ctn.UnscopedSafeGet("config")
+func (c *Container) UnscopedSafeGetMysqlIdempotencyPersistence() (*idempotency1.PersistenceIdempotency, error) {
+	i, err := c.ctn.UnscopedSafeGet("mysql_idempotency_persistence")
 	if err != nil {
-		var eo *config.Config
+		var eo *idempotency1.PersistenceIdempotency
 		return eo, err
 	}
-	o, ok := i.(*config.Config)
+	o, ok := i.(*idempotency1.PersistenceIdempotency)
 	if !ok {
-		return o, errors.New("could get 'config' because the object could not be cast to *config.Config")
+		return o, errors.New("could get 'mysql_idempotency_persistence' because the object could not be cast to *idempotency1.PersistenceIdempotency")
 	}
 	return o, nil
 }
 
-// UnscopedGetConfig retrieves the "config" object from the main scope.
+// UnscopedGetMysqlIdempotencyPersistence retrieves the "mysql_idempotency_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "config"
-//	type: *config.Config
+//	name: "mysql_idempotency_persistence"
+//	type: *idempotency1.PersistenceIdempotency
 //	scope: "main"
 //	build: func
-//	params: nil
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -712,98 +2609,99 @@ func (c *Container) UnscopedSafeGetConfig() (*config.Config, error) {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it panics.
-func (c *Container) UnscopedGetConfig() *config.Config {
-	o, err := c.UnscopedSafeGetConfig()
+func (c *Container) UnscopedGetMysqlIdempotencyPersistence() *idempotency1.PersistenceIdempotency {
+	o, err := c.UnscopedSafeGetMysqlIdempotencyPersistence()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// Config retrieves the "config" object from the main scope.
+// MysqlIdempotencyPersistence retrieves the "mysql_idempotency_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "config"
-//	type: *config.Config
+//	name: "mysql_idempotency_persistence"
+//	type: *idempotency1.PersistenceIdempotency
 //	scope: "main"
 //	build: func
-//	params: nil
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it calls the GetConfig method.
+// If the container can be retrieved, it calls the GetMysqlIdempotencyPersistence method.
 // If the container can not be retrieved, it panics.
-func Config(i interface{}) *config.Config {
-	return C(i).GetConfig()
+func MysqlIdempotencyPersistence(i interface{}) *idempotency1.PersistenceIdempotency {
+	return C(i).GetMysqlIdempotencyPersistence()
 }
 
-// SafeGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
+// SafeGetMysqlOutboxPersistence retrieves the "mysql_outbox_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "mysql_connection"
-//	type: *mysql.MYSQLConnection
+//	name: "mysql_outbox_persistence"
+//	type: *outbox.PersistenceOutbox
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it returns an error.
-func (c *Container) SafeGetMysqlConnection() (*mysql.MYSQLConnection, error) {
-	i, err := c.ctn.SafeGet("mysql_connection")
+func (c *Container) SafeGetMysqlOutboxPersistence() (*outbox.PersistenceOutbox, error) {
+	i, err := c.ctn.SafeGet("mysql_outbox_persistence")
 	if err != nil {
-		var eo *mysql.MYSQLConnection
+		var eo *outbox.PersistenceOutbox
 		return eo, err
 	}
-	o, ok := i.(*mysql.MYSQLConnection)
+	o, ok := i.(*outbox.PersistenceOutbox)
 	if !ok {
-		return o, errors.New("could get 'mysql_connection' because the object could not be cast to *mysql.MYSQLConnection")
+		return o, errors.New("could get 'mysql_outbox_persistence' because the object could not be cast to *outbox.PersistenceOutbox")
 	}
 	return o, nil
 }
 
-// GetMysqlConnection retrieves the "mysql_connection" object from the main scope.
+// GetMysqlOutboxPersistence retrieves the "mysql_outbox_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "mysql_connection"
-//	type: *mysql.MYSQLConnection
+//	name: "mysql_outbox_persistence"
+//	type: *outbox.PersistenceOutbox
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // If the object can not be retrieved, it panics.
-func (c *Container) GetMysqlConnection() *mysql.MYSQLConnection {
-	o, err := c.SafeGetMysqlConnection()
+func (c *Container) GetMysqlOutboxPersistence() *outbox.PersistenceOutbox {
+	o, err := c.SafeGetMysqlOutboxPersistence()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
+// UnscopedSafeGetMysqlOutboxPersistence retrieves the "mysql_outbox_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "mysql_connection"
-//	type: *mysql.MYSQLConnection
+//	name: "mysql_outbox_persistence"
+//	type: *outbox.PersistenceOutbox
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -811,29 +2709,29 @@ func (c *Container) GetMysqlConnection() *mysql.MYSQLConnection {
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it returns an error.
-func (c *Container) UnscopedSafeGetMysqlConnection() (*mysql.MYSQLConnection, error) {
-	i, err := c.ctn.UnscopedSafeGet("mysql_connection")
+func (c *Container) UnscopedSafeGetMysqlOutboxPersistence() (*outbox.PersistenceOutbox, error) {
+	i, err := c.ctn.UnscopedSafeGet("mysql_outbox_persistence")
 	if err != nil {
-		var eo *mysql.MYSQLConnection
+		var eo *outbox.PersistenceOutbox
 		return eo, err
 	}
-	o, ok := i.(*mysql.MYSQLConnection)
+	o, ok := i.(*outbox.PersistenceOutbox)
 	if !ok {
-		return o, errors.New("could get 'mysql_connection' because the object could not be cast to *mysql.MYSQLConnection")
+		return o, errors.New("could get 'mysql_outbox_persistence' because the object could not be cast to *outbox.PersistenceOutbox")
 	}
 	return o, nil
 }
 
-// UnscopedGetMysqlConnection retrieves the "mysql_connection" object from the main scope.
+// UnscopedGetMysqlOutboxPersistence retrieves the "mysql_outbox_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "mysql_connection"
-//	type: *mysql.MYSQLConnection
+//	name: "mysql_outbox_persistence"
+//	type: *outbox.PersistenceOutbox
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -841,34 +2739,34 @@ func (c *Container) UnscopedSafeGetMysqlConnection() (*mysql.MYSQLConnection, er
 //
 // This method can be called even if main is a sub-scope of the container.
 // If the object can not be retrieved, it panics.
-func (c *Container) UnscopedGetMysqlConnection() *mysql.MYSQLConnection {
-	o, err := c.UnscopedSafeGetMysqlConnection()
+func (c *Container) UnscopedGetMysqlOutboxPersistence() *outbox.PersistenceOutbox {
+	o, err := c.UnscopedSafeGetMysqlOutboxPersistence()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// MysqlConnection retrieves the "mysql_connection" object from the main scope.
+// MysqlOutboxPersistence retrieves the "mysql_outbox_persistence" object from the main scope.
 //
 // ---------------------------------------------
 //
-//	name: "mysql_connection"
-//	type: *mysql.MYSQLConnection
+//	name: "mysql_outbox_persistence"
+//	type: *outbox.PersistenceOutbox
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*config.Config) ["config"]
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
 // ---------------------------------------------
 //
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it calls the GetMysqlConnection method.
+// If the container can be retrieved, it calls the GetMysqlOutboxPersistence method.
 // If the container can not be retrieved, it panics.
-func MysqlConnection(i interface{}) *mysql.MYSQLConnection {
-	return C(i).GetMysqlConnection()
+func MysqlOutboxPersistence(i interface{}) *outbox.PersistenceOutbox {
+	return C(i).GetMysqlOutboxPersistence()
 }
 
 // SafeGetMysqlTokenPersistence retrieves the "mysql_token_persistence" object from the main scope.
@@ -880,7 +2778,8 @@ func MysqlConnection(i interface{}) *mysql.MYSQLConnection {
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -909,7 +2808,8 @@ func (c *Container) SafeGetMysqlTokenPersistence() (*token2.PersistenceToken, er
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -933,7 +2833,8 @@ func (c *Container) GetMysqlTokenPersistence() *token2.PersistenceToken {
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -963,7 +2864,8 @@ func (c *Container) UnscopedSafeGetMysqlTokenPersistence() (*token2.PersistenceT
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -988,7 +2890,8 @@ func (c *Container) UnscopedGetMysqlTokenPersistence() *token2.PersistenceToken
 //	scope: "main"
 //	build: func
 //	params:
-//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//		- "0": Service(*config.Config) ["config"]
+//		- "1": Service(*mysql.MYSQLConnection) ["mysql_connection"]
 //	unshared: false
 //	close: false
 //
@@ -1130,3 +3033,133 @@ func (c *Container) UnscopedGetMysqlUserPersistence() *user.PersistenceUser {
 func MysqlUserPersistence(i interface{}) *user.PersistenceUser {
 	return C(i).GetMysqlUserPersistence()
 }
+
+// SafeGetMysqlWebhookPersistence retrieves the "mysql_webhook_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_webhook_persistence"
+//	type: *webhook2.PersistenceWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it returns an error.
+func (c *Container) SafeGetMysqlWebhookPersistence() (*webhook2.PersistenceWebhook, error) {
+	i, err := c.ctn.SafeGet("mysql_webhook_persistence")
+	if err != nil {
+		var eo *webhook2.PersistenceWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook2.PersistenceWebhook)
+	if !ok {
+		return o, errors.New("could get 'mysql_webhook_persistence' because the object could not be cast to *webhook2.PersistenceWebhook")
+	}
+	return o, nil
+}
+
+// GetMysqlWebhookPersistence retrieves the "mysql_webhook_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_webhook_persistence"
+//	type: *webhook2.PersistenceWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// If the object can not be retrieved, it panics.
+func (c *Container) GetMysqlWebhookPersistence() *webhook2.PersistenceWebhook {
+	o, err := c.SafeGetMysqlWebhookPersistence()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMysqlWebhookPersistence retrieves the "mysql_webhook_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_webhook_persistence"
+//	type: *webhook2.PersistenceWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it returns an error.
+func (c *Container) UnscopedSafeGetMysqlWebhookPersistence() (*webhook2.PersistenceWebhook, error) {
+	i, err := c.ctn.UnscopedSafeGet("mysql_webhook_persistence")
+	if err != nil {
+		var eo *webhook2.PersistenceWebhook
+		return eo, err
+	}
+	o, ok := i.(*webhook2.PersistenceWebhook)
+	if !ok {
+		return o, errors.New("could get 'mysql_webhook_persistence' because the object could not be cast to *webhook2.PersistenceWebhook")
+	}
+	return o, nil
+}
+
+// UnscopedGetMysqlWebhookPersistence retrieves the "mysql_webhook_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_webhook_persistence"
+//	type: *webhook2.PersistenceWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// This method can be called even if main is a sub-scope of the container.
+// If the object can not be retrieved, it panics.
+func (c *Container) UnscopedGetMysqlWebhookPersistence() *webhook2.PersistenceWebhook {
+	o, err := c.UnscopedSafeGetMysqlWebhookPersistence()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MysqlWebhookPersistence retrieves the "mysql_webhook_persistence" object from the main scope.
+//
+// ---------------------------------------------
+//
+//	name: "mysql_webhook_persistence"
+//	type: *webhook2.PersistenceWebhook
+//	scope: "main"
+//	build: func
+//	params:
+//		- "0": Service(*mysql.MYSQLConnection) ["mysql_connection"]
+//	unshared: false
+//	close: false
+//
+// ---------------------------------------------
+//
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it calls the GetMysqlWebhookPersistence method.
+// If the container can not be retrieved, it panics.
+func MysqlWebhookPersistence(i interface{}) *webhook2.PersistenceWebhook {
+	return C(i).GetMysqlWebhookPersistence()
+}
@@ -6,17 +6,86 @@ import (
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
 
+	campaign1 "platform_engineer_clone/api/v0/campaign"
 	middlewares "platform_engineer_clone/api/v0/middlewares"
+	stream1 "platform_engineer_clone/api/v0/stream"
 	token1 "platform_engineer_clone/api/v0/token"
+	webhook1 "platform_engineer_clone/api/v0/webhook"
+	campaign "platform_engineer_clone/business/v0/campaign"
+	eventlog "platform_engineer_clone/business/v0/eventlog"
+	idempotency "platform_engineer_clone/business/v0/idempotency"
+	outbox1 "platform_engineer_clone/business/v0/outbox"
+	stream "platform_engineer_clone/business/v0/stream"
 	token "platform_engineer_clone/business/v0/token"
+	webhook "platform_engineer_clone/business/v0/webhook"
 	config "platform_engineer_clone/src/config"
 	mysql "platform_engineer_clone/src/persistence/mysql"
+	campaign2 "platform_engineer_clone/src/persistence/mysql/v0/campaign"
+	idempotency1 "platform_engineer_clone/src/persistence/mysql/v0/idempotency"
+	outbox "platform_engineer_clone/src/persistence/mysql/v0/outbox"
 	token2 "platform_engineer_clone/src/persistence/mysql/v0/token"
 	user "platform_engineer_clone/src/persistence/mysql/v0/user"
+	webhook2 "platform_engineer_clone/src/persistence/mysql/v0/webhook"
 )
 
 func getDiDefs(provider dingo.Provider) []di.Def {
 	return []di.Def{
+		{
+			Name:  "api_campaign",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("api_campaign")
+				if err != nil {
+					var eo *campaign1.APICampaign
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("business_campaign")
+				if err != nil {
+					var eo *campaign1.APICampaign
+					return eo, err
+				}
+				p0, ok := pi0.(*campaign.BusinessCampaign)
+				if !ok {
+					var eo *campaign1.APICampaign
+					return eo, errors.New("could not cast parameter 0 to *campaign.BusinessCampaign")
+				}
+				b, ok := d.Build.(func(*campaign.BusinessCampaign) (*campaign1.APICampaign, error))
+				if !ok {
+					var eo *campaign1.APICampaign
+					return eo, errors.New("could not cast build function to func(*campaign.BusinessCampaign) (*campaign1.APICampaign, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "api_idempotency",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("api_idempotency")
+				if err != nil {
+					var eo *middlewares.Idempotency
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("business_idempotency")
+				if err != nil {
+					var eo *middlewares.Idempotency
+					return eo, err
+				}
+				p0, ok := pi0.(*idempotency.BusinessIdempotency)
+				if !ok {
+					var eo *middlewares.Idempotency
+					return eo, errors.New("could not cast parameter 0 to *idempotency.BusinessIdempotency")
+				}
+				b, ok := d.Build.(func(*idempotency.BusinessIdempotency) (*middlewares.Idempotency, error))
+				if !ok {
+					var eo *middlewares.Idempotency
+					return eo, errors.New("could not cast build function to func(*idempotency.BusinessIdempotency) (*middlewares.Idempotency, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
 		{
 			Name:  "api_middlewares",
 			Scope: "",
@@ -45,6 +114,44 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 			Unshared: false,
 		},
+		{
+			Name:  "api_stream",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("api_stream")
+				if err != nil {
+					var eo *stream1.APIStream
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *stream1.APIStream
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *stream1.APIStream
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("business_stream")
+				if err != nil {
+					var eo *stream1.APIStream
+					return eo, err
+				}
+				p1, ok := pi1.(*stream.Broker)
+				if !ok {
+					var eo *stream1.APIStream
+					return eo, errors.New("could not cast parameter 1 to *stream.Broker")
+				}
+				b, ok := d.Build.(func(*config.Config, *stream.Broker) (*stream1.APIStream, error))
+				if !ok {
+					var eo *stream1.APIStream
+					return eo, errors.New("could not cast build function to func(*config.Config, *stream.Broker) (*stream1.APIStream, error)")
+				}
+				return b(p0, p1)
+			},
+			Unshared: false,
+		},
 		{
 			Name:  "api_token",
 			Scope: "",
@@ -73,6 +180,244 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 			Unshared: false,
 		},
+		{
+			Name:  "api_webhook",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("api_webhook")
+				if err != nil {
+					var eo *webhook1.APIWebhook
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("business_webhook")
+				if err != nil {
+					var eo *webhook1.APIWebhook
+					return eo, err
+				}
+				p0, ok := pi0.(*webhook.BusinessWebhook)
+				if !ok {
+					var eo *webhook1.APIWebhook
+					return eo, errors.New("could not cast parameter 0 to *webhook.BusinessWebhook")
+				}
+				b, ok := d.Build.(func(*webhook.BusinessWebhook) (*webhook1.APIWebhook, error))
+				if !ok {
+					var eo *webhook1.APIWebhook
+					return eo, errors.New("could not cast build function to func(*webhook.BusinessWebhook) (*webhook1.APIWebhook, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_campaign",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_campaign")
+				if err != nil {
+					var eo *campaign.BusinessCampaign
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *campaign.BusinessCampaign
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *campaign.BusinessCampaign
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("mysql_campaign_persistence")
+				if err != nil {
+					var eo *campaign.BusinessCampaign
+					return eo, err
+				}
+				p1, ok := pi1.(*campaign2.PersistenceCampaign)
+				if !ok {
+					var eo *campaign.BusinessCampaign
+					return eo, errors.New("could not cast parameter 1 to *campaign2.PersistenceCampaign")
+				}
+				pi2, err := ctn.SafeGet("business_token")
+				if err != nil {
+					var eo *campaign.BusinessCampaign
+					return eo, err
+				}
+				p2, ok := pi2.(*token.BusinessToken)
+				if !ok {
+					var eo *campaign.BusinessCampaign
+					return eo, errors.New("could not cast parameter 2 to *token.BusinessToken")
+				}
+				b, ok := d.Build.(func(*config.Config, *campaign2.PersistenceCampaign, *token.BusinessToken) (*campaign.BusinessCampaign, error))
+				if !ok {
+					var eo *campaign.BusinessCampaign
+					return eo, errors.New("could not cast build function to func(*config.Config, *campaign2.PersistenceCampaign, *token.BusinessToken) (*campaign.BusinessCampaign, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_event_log",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_event_log")
+				if err != nil {
+					var eo *eventlog.EventLog
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *eventlog.EventLog
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *eventlog.EventLog
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				b, ok := d.Build.(func(*config.Config) (*eventlog.EventLog, error))
+				if !ok {
+					var eo *eventlog.EventLog
+					return eo, errors.New("could not cast build function to func(*config.Config) (*eventlog.EventLog, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_idempotency",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_idempotency")
+				if err != nil {
+					var eo *idempotency.BusinessIdempotency
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *idempotency.BusinessIdempotency
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *idempotency.BusinessIdempotency
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("mysql_idempotency_persistence")
+				if err != nil {
+					var eo *idempotency.BusinessIdempotency
+					return eo, err
+				}
+				p1, ok := pi1.(*idempotency1.PersistenceIdempotency)
+				if !ok {
+					var eo *idempotency.BusinessIdempotency
+					return eo, errors.New("could not cast parameter 1 to *idempotency1.PersistenceIdempotency")
+				}
+				b, ok := d.Build.(func(*config.Config, *idempotency1.PersistenceIdempotency) (*idempotency.BusinessIdempotency, error))
+				if !ok {
+					var eo *idempotency.BusinessIdempotency
+					return eo, errors.New("could not cast build function to func(*config.Config, *idempotency1.PersistenceIdempotency) (*idempotency.BusinessIdempotency, error)")
+				}
+				return b(p0, p1)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_outbox_relay",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_outbox_relay")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("mysql_outbox_persistence")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				p1, ok := pi1.(*outbox.PersistenceOutbox)
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast parameter 1 to *outbox.PersistenceOutbox")
+				}
+				pi2, err := ctn.SafeGet("business_webhook")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				p2, ok := pi2.(*webhook.BusinessWebhook)
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast parameter 2 to *webhook.BusinessWebhook")
+				}
+				pi3, err := ctn.SafeGet("business_stream")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				p3, ok := pi3.(*stream.Broker)
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast parameter 3 to *stream.Broker")
+				}
+				pi4, err := ctn.SafeGet("business_event_log")
+				if err != nil {
+					var eo *outbox1.OutboxRelay
+					return eo, err
+				}
+				p4, ok := pi4.(*eventlog.EventLog)
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast parameter 4 to *eventlog.EventLog")
+				}
+				b, ok := d.Build.(func(*config.Config, *outbox.PersistenceOutbox, *webhook.BusinessWebhook, *stream.Broker, *eventlog.EventLog) (*outbox1.OutboxRelay, error))
+				if !ok {
+					var eo *outbox1.OutboxRelay
+					return eo, errors.New("could not cast build function to func(*config.Config, *outbox.PersistenceOutbox, *webhook.BusinessWebhook, *stream.Broker, *eventlog.EventLog) (*outbox1.OutboxRelay, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_stream",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_stream")
+				if err != nil {
+					var eo *stream.Broker
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *stream.Broker
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *stream.Broker
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				b, ok := d.Build.(func(*config.Config) (*stream.Broker, error))
+				if !ok {
+					var eo *stream.Broker
+					return eo, errors.New("could not cast build function to func(*config.Config) (*stream.Broker, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
 		{
 			Name:  "business_token",
 			Scope: "",
@@ -102,10 +447,116 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 					var eo *token.BusinessToken
 					return eo, errors.New("could not cast parameter 1 to *token2.PersistenceToken")
 				}
-				b, ok := d.Build.(func(*config.Config, *token2.PersistenceToken) (*token.BusinessToken, error))
+				pi2, err := ctn.SafeGet("business_webhook")
+				if err != nil {
+					var eo *token.BusinessToken
+					return eo, err
+				}
+				p2, ok := pi2.(*webhook.BusinessWebhook)
 				if !ok {
 					var eo *token.BusinessToken
-					return eo, errors.New("could not cast build function to func(*config.Config, *token2.PersistenceToken) (*token.BusinessToken, error)")
+					return eo, errors.New("could not cast parameter 2 to *webhook.BusinessWebhook")
+				}
+				pi3, err := ctn.SafeGet("business_stream")
+				if err != nil {
+					var eo *token.BusinessToken
+					return eo, err
+				}
+				p3, ok := pi3.(*stream.Broker)
+				if !ok {
+					var eo *token.BusinessToken
+					return eo, errors.New("could not cast parameter 3 to *stream.Broker")
+				}
+				pi4, err := ctn.SafeGet("business_event_log")
+				if err != nil {
+					var eo *token.BusinessToken
+					return eo, err
+				}
+				p4, ok := pi4.(*eventlog.EventLog)
+				if !ok {
+					var eo *token.BusinessToken
+					return eo, errors.New("could not cast parameter 4 to *eventlog.EventLog")
+				}
+				b, ok := d.Build.(func(*config.Config, *token2.PersistenceToken, *webhook.BusinessWebhook, *stream.Broker, *eventlog.EventLog) (*token.BusinessToken, error))
+				if !ok {
+					var eo *token.BusinessToken
+					return eo, errors.New("could not cast build function to func(*config.Config, *token2.PersistenceToken, *webhook.BusinessWebhook, *stream.Broker, *eventlog.EventLog) (*token.BusinessToken, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_token_purger",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_token_purger")
+				if err != nil {
+					var eo *token.TokenPurger
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *token.TokenPurger
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *token.TokenPurger
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("mysql_token_persistence")
+				if err != nil {
+					var eo *token.TokenPurger
+					return eo, err
+				}
+				p1, ok := pi1.(*token2.PersistenceToken)
+				if !ok {
+					var eo *token.TokenPurger
+					return eo, errors.New("could not cast parameter 1 to *token2.PersistenceToken")
+				}
+				b, ok := d.Build.(func(*config.Config, *token2.PersistenceToken) (*token.TokenPurger, error))
+				if !ok {
+					var eo *token.TokenPurger
+					return eo, errors.New("could not cast build function to func(*config.Config, *token2.PersistenceToken) (*token.TokenPurger, error)")
+				}
+				return b(p0, p1)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "business_webhook",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("business_webhook")
+				if err != nil {
+					var eo *webhook.BusinessWebhook
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config")
+				if err != nil {
+					var eo *webhook.BusinessWebhook
+					return eo, err
+				}
+				p0, ok := pi0.(*config.Config)
+				if !ok {
+					var eo *webhook.BusinessWebhook
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
+				}
+				pi1, err := ctn.SafeGet("mysql_webhook_persistence")
+				if err != nil {
+					var eo *webhook.BusinessWebhook
+					return eo, err
+				}
+				p1, ok := pi1.(*webhook2.PersistenceWebhook)
+				if !ok {
+					var eo *webhook.BusinessWebhook
+					return eo, errors.New("could not cast parameter 1 to *webhook2.PersistenceWebhook")
+				}
+				b, ok := d.Build.(func(*config.Config, *webhook2.PersistenceWebhook) (*webhook.BusinessWebhook, error))
+				if !ok {
+					var eo *webhook.BusinessWebhook
+					return eo, errors.New("could not cast build function to func(*config.Config, *webhook2.PersistenceWebhook) (*webhook.BusinessWebhook, error)")
 				}
 				return b(p0, p1)
 			},
@@ -129,6 +580,34 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 			Unshared: false,
 		},
+		{
+			Name:  "mysql_campaign_persistence",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("mysql_campaign_persistence")
+				if err != nil {
+					var eo *campaign2.PersistenceCampaign
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("mysql_connection")
+				if err != nil {
+					var eo *campaign2.PersistenceCampaign
+					return eo, err
+				}
+				p0, ok := pi0.(*mysql.MYSQLConnection)
+				if !ok {
+					var eo *campaign2.PersistenceCampaign
+					return eo, errors.New("could not cast parameter 0 to *mysql.MYSQLConnection")
+				}
+				b, ok := d.Build.(func(*mysql.MYSQLConnection) (*campaign2.PersistenceCampaign, error))
+				if !ok {
+					var eo *campaign2.PersistenceCampaign
+					return eo, errors.New("could not cast build function to func(*mysql.MYSQLConnection) (*campaign2.PersistenceCampaign, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
 		{
 			Name:  "mysql_connection",
 			Scope: "",
@@ -157,6 +636,62 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 			Unshared: false,
 		},
+		{
+			Name:  "mysql_idempotency_persistence",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("mysql_idempotency_persistence")
+				if err != nil {
+					var eo *idempotency1.PersistenceIdempotency
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("mysql_connection")
+				if err != nil {
+					var eo *idempotency1.PersistenceIdempotency
+					return eo, err
+				}
+				p0, ok := pi0.(*mysql.MYSQLConnection)
+				if !ok {
+					var eo *idempotency1.PersistenceIdempotency
+					return eo, errors.New("could not cast parameter 0 to *mysql.MYSQLConnection")
+				}
+				b, ok := d.Build.(func(*mysql.MYSQLConnection) (*idempotency1.PersistenceIdempotency, error))
+				if !ok {
+					var eo *idempotency1.PersistenceIdempotency
+					return eo, errors.New("could not cast build function to func(*mysql.MYSQLConnection) (*idempotency1.PersistenceIdempotency, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
+		{
+			Name:  "mysql_outbox_persistence",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("mysql_outbox_persistence")
+				if err != nil {
+					var eo *outbox.PersistenceOutbox
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("mysql_connection")
+				if err != nil {
+					var eo *outbox.PersistenceOutbox
+					return eo, err
+				}
+				p0, ok := pi0.(*mysql.MYSQLConnection)
+				if !ok {
+					var eo *outbox.PersistenceOutbox
+					return eo, errors.New("could not cast parameter 0 to *mysql.MYSQLConnection")
+				}
+				b, ok := d.Build.(func(*mysql.MYSQLConnection) (*outbox.PersistenceOutbox, error))
+				if !ok {
+					var eo *outbox.PersistenceOutbox
+					return eo, errors.New("could not cast build function to func(*mysql.MYSQLConnection) (*outbox.PersistenceOutbox, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
 		{
 			Name:  "mysql_token_persistence",
 			Scope: "",
@@ -166,22 +701,32 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 					var eo *token2.PersistenceToken
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("mysql_connection")
+				pi0, err := ctn.SafeGet("config")
 				if err != nil {
 					var eo *token2.PersistenceToken
 					return eo, err
 				}
-				p0, ok := pi0.(*mysql.MYSQLConnection)
+				p0, ok := pi0.(*config.Config)
 				if !ok {
 					var eo *token2.PersistenceToken
-					return eo, errors.New("could not cast parameter 0 to *mysql.MYSQLConnection")
+					return eo, errors.New("could not cast parameter 0 to *config.Config")
 				}
-				b, ok := d.Build.(func(*mysql.MYSQLConnection) (*token2.PersistenceToken, error))
+				pi1, err := ctn.SafeGet("mysql_connection")
+				if err != nil {
+					var eo *token2.PersistenceToken
+					return eo, err
+				}
+				p1, ok := pi1.(*mysql.MYSQLConnection)
 				if !ok {
 					var eo *token2.PersistenceToken
-					return eo, errors.New("could not cast build function to func(*mysql.MYSQLConnection) (*token2.PersistenceToken, error)")
+					return eo, errors.New("could not cast parameter 1 to *mysql.MYSQLConnection")
 				}
-				return b(p0)
+				b, ok := d.Build.(func(*config.Config, *mysql.MYSQLConnection) (*token2.PersistenceToken, error))
+				if !ok {
+					var eo *token2.PersistenceToken
+					return eo, errors.New("could not cast build function to func(*config.Config, *mysql.MYSQLConnection) (*token2.PersistenceToken, error)")
+				}
+				return b(p0, p1)
 			},
 			Unshared: false,
 		},
@@ -213,5 +758,33 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 			Unshared: false,
 		},
+		{
+			Name:  "mysql_webhook_persistence",
+			Scope: "",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("mysql_webhook_persistence")
+				if err != nil {
+					var eo *webhook2.PersistenceWebhook
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("mysql_connection")
+				if err != nil {
+					var eo *webhook2.PersistenceWebhook
+					return eo, err
+				}
+				p0, ok := pi0.(*mysql.MYSQLConnection)
+				if !ok {
+					var eo *webhook2.PersistenceWebhook
+					return eo, errors.New("could not cast parameter 0 to *mysql.MYSQLConnection")
+				}
+				b, ok := d.Build.(func(*mysql.MYSQLConnection) (*webhook2.PersistenceWebhook, error))
+				if !ok {
+					var eo *webhook2.PersistenceWebhook
+					return eo, errors.New("could not cast build function to func(*mysql.MYSQLConnection) (*webhook2.PersistenceWebhook, error)")
+				}
+				return b(p0)
+			},
+			Unshared: false,
+		},
 	}
 }
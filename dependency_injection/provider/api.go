@@ -2,15 +2,27 @@ package provider
 
 import (
 	"github.com/sarulabs/dingo/v4"
+	"platform_engineer_clone/api/v0/campaign"
 	"platform_engineer_clone/api/v0/middlewares"
+	"platform_engineer_clone/api/v0/stream"
 	"platform_engineer_clone/api/v0/token"
+	"platform_engineer_clone/api/v0/webhook"
+	BusinessCampaign "platform_engineer_clone/business/v0/campaign"
+	BusinessIdempotency "platform_engineer_clone/business/v0/idempotency"
+	BusinessStream "platform_engineer_clone/business/v0/stream"
 	BusinessToken "platform_engineer_clone/business/v0/token"
+	BusinessWebhook "platform_engineer_clone/business/v0/webhook"
+	"platform_engineer_clone/src/config"
 	"platform_engineer_clone/src/persistence/mysql/v0/user"
 )
 
 const (
 	apiToken       = "api_token"
 	apiMiddlewares = "api_middlewares"
+	apiWebhook     = "api_webhook"
+	apiStream      = "api_stream"
+	apiIdempotency = "api_idempotency"
+	apiCampaign    = "api_campaign"
 )
 
 func getAPILayers() *[]dingo.Def {
@@ -27,5 +39,29 @@ func getAPILayers() *[]dingo.Def {
 				return middlewares.NewAuthRoutes(user), nil
 			},
 		},
+		{
+			Name: apiWebhook,
+			Build: func(businessWebhook *BusinessWebhook.BusinessWebhook) (*webhook.APIWebhook, error) {
+				return webhook.NewAPIWebhook(businessWebhook), nil
+			},
+		},
+		{
+			Name: apiStream,
+			Build: func(config *config.Config, broker *BusinessStream.Broker) (*stream.APIStream, error) {
+				return stream.NewAPIStream(broker, config.App.StreamHeartbeatInterval), nil
+			},
+		},
+		{
+			Name: apiIdempotency,
+			Build: func(businessIdempotency *BusinessIdempotency.BusinessIdempotency) (*middlewares.Idempotency, error) {
+				return middlewares.NewIdempotency(businessIdempotency), nil
+			},
+		},
+		{
+			Name: apiCampaign,
+			Build: func(businessCampaign *BusinessCampaign.BusinessCampaign) (*campaign.APICampaign, error) {
+				return campaign.NewAPICampaign(businessCampaign), nil
+			},
+		},
 	}
 }
@@ -2,25 +2,143 @@ package provider
 
 import (
 	"github.com/sarulabs/dingo/v4"
+	BusinessCampaign "platform_engineer_clone/business/v0/campaign"
+	BusinessEventLog "platform_engineer_clone/business/v0/eventlog"
+	BusinessIdempotency "platform_engineer_clone/business/v0/idempotency"
+	BusinessOutbox "platform_engineer_clone/business/v0/outbox"
+	BusinessStream "platform_engineer_clone/business/v0/stream"
 	BusinessToken "platform_engineer_clone/business/v0/token"
+	BusinessWebhook "platform_engineer_clone/business/v0/webhook"
 	"platform_engineer_clone/src/config"
+	PersistenceCampaign "platform_engineer_clone/src/persistence/mysql/v0/campaign"
+	PersistenceIdempotency "platform_engineer_clone/src/persistence/mysql/v0/idempotency"
+	PersistenceOutbox "platform_engineer_clone/src/persistence/mysql/v0/outbox"
 	PersistenceToken "platform_engineer_clone/src/persistence/mysql/v0/token"
+	PersistenceWebhook "platform_engineer_clone/src/persistence/mysql/v0/webhook"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/sealing"
 )
 
 const (
-	businessToken = "business_token"
+	businessToken       = "business_token"
+	businessTokenPurger = "business_token_purger"
+	businessWebhook     = "business_webhook"
+	businessOutboxRelay = "business_outbox_relay"
+	businessStream      = "business_stream"
+	businessEventLog    = "business_event_log"
+	businessIdempotency = "business_idempotency"
+	businessCampaign    = "business_campaign"
 )
 
 func getBusinessLayers() *[]dingo.Def {
 	return &[]dingo.Def{
 		{
 			Name: businessToken,
-			Build: func(config *config.Config, persistenceToken *PersistenceToken.PersistenceToken) (*BusinessToken.BusinessToken, error) {
+			Build: func(config *config.Config, persistenceToken *PersistenceToken.PersistenceToken,
+				businessWebhook *BusinessWebhook.BusinessWebhook, broker *BusinessStream.Broker,
+				eventLog *BusinessEventLog.EventLog) (*BusinessToken.BusinessToken, error) {
+				signer, err := newTokenSigner(config)
+				if err != nil {
+					return nil, err
+				}
 				return BusinessToken.NewBusinessToken(
 					persistenceToken,
 					config.App.TokenDaysValid,
+					config.App.TokenMinTTL,
+					config.App.TokenMaxTTL,
 					config.App.RandomCharMinLength,
 					config.App.RandomCharMaxLength,
+					config.App.TokenBatchMaxCount,
+					config.App.TokenExpirySweepBatchSize,
+					config.App.TokenActiveQuota,
+					keygen.Format{Prefix: config.App.TokenKeyPrefix},
+					config.App.TokenAcceptLegacyKeys,
+					signer,
+					businessWebhook,
+					broker,
+					eventLog,
+				), nil
+			},
+		},
+		{
+			Name: businessTokenPurger,
+			Build: func(config *config.Config, persistenceToken *PersistenceToken.PersistenceToken) (*BusinessToken.TokenPurger, error) {
+				return BusinessToken.NewTokenPurger(
+					persistenceToken,
+					config.App.TokenRetentionDays,
+					config.App.TokenPurgeBatchSize,
+					config.App.TokenPurgeArchiveFile,
+				), nil
+			},
+		},
+		{
+			Name: businessWebhook,
+			Build: func(config *config.Config, persistenceWebhook *PersistenceWebhook.PersistenceWebhook) (*BusinessWebhook.BusinessWebhook, error) {
+				return BusinessWebhook.NewBusinessWebhook(
+					persistenceWebhook,
+					config.App.WebhookBatchSize,
+					config.App.WebhookMaxAttempts,
+					config.App.WebhookBackoffBase,
+					config.App.WebhookBackoffMax,
+					config.App.WebhookTimeout,
+				), nil
+			},
+		},
+		{
+			Name: businessOutboxRelay,
+			Build: func(config *config.Config, persistenceOutbox *PersistenceOutbox.PersistenceOutbox,
+				businessWebhook *BusinessWebhook.BusinessWebhook, broker *BusinessStream.Broker,
+				eventLog *BusinessEventLog.EventLog) (*BusinessOutbox.OutboxRelay, error) {
+				return BusinessOutbox.NewOutboxRelay(
+					persistenceOutbox,
+					config.App.OutboxBatchSize,
+					config.App.OutboxMaxAttempts,
+					config.App.OutboxLease,
+					config.App.OutboxRetention,
+					businessWebhook,
+					broker,
+					eventLog,
+				), nil
+			},
+		},
+		{
+			Name: businessStream,
+			Build: func(config *config.Config) (*BusinessStream.Broker, error) {
+				return BusinessStream.NewBroker(config.App.StreamBufferSize), nil
+			},
+		},
+		{
+			Name: businessEventLog,
+			Build: func(config *config.Config) (*BusinessEventLog.EventLog, error) {
+				return BusinessEventLog.NewEventLog(
+					config.App.EventLogFile,
+					int64(config.App.EventLogMaxSizeMB)*1024*1024,
+					config.App.EventLogMaxAge,
+					config.App.EventLogCompress,
+					config.App.EventLogFsync,
+				), nil
+			},
+		},
+		{
+			Name: businessIdempotency,
+			Build: func(config *config.Config, persistenceIdempotency *PersistenceIdempotency.PersistenceIdempotency) (*BusinessIdempotency.BusinessIdempotency, error) {
+				return BusinessIdempotency.NewBusinessIdempotency(
+					persistenceIdempotency,
+					sealing.NewSealer(config.App.TokenKeySecret, "idempotency"),
+					config.App.IdempotencyWindow,
+					config.App.IdempotencyPurgeBatchSize,
+				), nil
+			},
+		},
+		{
+			Name: businessCampaign,
+			Build: func(config *config.Config, persistenceCampaign *PersistenceCampaign.PersistenceCampaign,
+				businessToken *BusinessToken.BusinessToken) (*BusinessCampaign.BusinessCampaign, error) {
+				return BusinessCampaign.NewBusinessCampaign(
+					persistenceCampaign,
+					businessToken,
+					config.App.TokenMinTTL,
+					config.App.TokenMaxTTL,
 				), nil
 			},
 		},
@@ -5,14 +5,24 @@ import (
 	"log"
 	"platform_engineer_clone/src/config"
 	PersistenceMYSQL "platform_engineer_clone/src/persistence/mysql"
+	PersistenceCampaign "platform_engineer_clone/src/persistence/mysql/v0/campaign"
+	PersistenceIdempotency "platform_engineer_clone/src/persistence/mysql/v0/idempotency"
+	PersistenceOutbox "platform_engineer_clone/src/persistence/mysql/v0/outbox"
 	PersistenceToken "platform_engineer_clone/src/persistence/mysql/v0/token"
 	"platform_engineer_clone/src/persistence/mysql/v0/user"
+	PersistenceWebhook "platform_engineer_clone/src/persistence/mysql/v0/webhook"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
 )
 
 const (
-	mysqlConnection            = "mysql_connection"
-	mysqlTokenPersistenceLayer = "mysql_token_persistence"
-	mysqlUserPersistenceLayer  = "mysql_user_persistence"
+	mysqlConnection             = "mysql_connection"
+	mysqlTokenPersistenceLayer  = "mysql_token_persistence"
+	mysqlUserPersistenceLayer   = "mysql_user_persistence"
+	mysqlWebhookPersistence     = "mysql_webhook_persistence"
+	mysqlOutboxPersistence      = "mysql_outbox_persistence"
+	mysqlIdempotencyPersistence = "mysql_idempotency_persistence"
+	mysqlCampaignPersistence    = "mysql_campaign_persistence"
 )
 
 func getPersistenceLayers() *[]dingo.Def {
@@ -29,8 +39,21 @@ func getPersistenceLayers() *[]dingo.Def {
 		},
 		{
 			Name: mysqlTokenPersistenceLayer,
-			Build: func(connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceToken.PersistenceToken, error) {
-				return PersistenceToken.NewPersistenceToken(connection.DB), nil
+			Build: func(config *config.Config, connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceToken.PersistenceToken, error) {
+				keyGenerator, err := keygen.NewGenerator(
+					config.App.TokenAlphabet,
+					config.App.TokenBlocklist,
+					keygen.Format{Prefix: config.App.TokenKeyPrefix},
+				)
+				if err != nil {
+					return nil, err
+				}
+				keySigner, err := newTokenSigner(config)
+				if err != nil {
+					return nil, err
+				}
+				return PersistenceToken.NewPersistenceToken(connection.DB, config.App.TokenKeySecret, keyGenerator,
+					keySigner), nil
 			},
 		},
 		{
@@ -39,5 +62,37 @@ func getPersistenceLayers() *[]dingo.Def {
 				return user.NewPersistenceUser(connection.DB), nil
 			},
 		},
+		{
+			Name: mysqlWebhookPersistence,
+			Build: func(connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceWebhook.PersistenceWebhook, error) {
+				return PersistenceWebhook.NewPersistenceWebhook(connection.DB), nil
+			},
+		},
+		{
+			Name: mysqlOutboxPersistence,
+			Build: func(connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceOutbox.PersistenceOutbox, error) {
+				return PersistenceOutbox.NewPersistenceOutbox(connection.DB), nil
+			},
+		},
+		{
+			Name: mysqlIdempotencyPersistence,
+			Build: func(connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceIdempotency.PersistenceIdempotency, error) {
+				return PersistenceIdempotency.NewPersistenceIdempotency(connection.DB), nil
+			},
+		},
+		{
+			Name: mysqlCampaignPersistence,
+			Build: func(connection *PersistenceMYSQL.MYSQLConnection) (*PersistenceCampaign.PersistenceCampaign, error) {
+				return PersistenceCampaign.NewPersistenceCampaign(connection.DB), nil
+			},
+		},
+	}
+}
+
+// newTokenSigner returns the signer for the signed token mode, and nil for stored tokens
+func newTokenSigner(cfg *config.Config) (*signing.Signer, error) {
+	if cfg.App.TokenMode != config.TokenModeSigned {
+		return nil, nil
 	}
+	return signing.NewSigner(cfg.App.TokenKeyPrefix, cfg.App.TokenSigningAlgorithm, cfg.App.TokenSigningKeys)
 }
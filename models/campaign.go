@@ -0,0 +1,79 @@
+package models
+
+import (
+	"github.com/volatiletech/null/v8"
+	"time"
+)
+
+// Campaign is a named group of tokens, minted with the campaign's defaults.
+// Its tokens aren't usable before it starts, and don't outlive it.
+type Campaign struct {
+	Id             int         `json:"id" example:"1"`
+	Name           string      `json:"name" example:"Spring launch"`
+	Description    null.String `json:"description" swaggertype:"string" example:"Invites for the spring launch waitlist"`
+	DefaultTTL     null.String `json:"default_ttl" swaggertype:"string" example:"72h0m0s"`
+	DefaultMaxUses null.Int    `json:"default_max_uses" swaggertype:"integer" example:"1"`
+	StartsAt       null.Time   `json:"starts_at" swaggertype:"string" example:"2024-05-01T09:00:00Z"`
+	EndsAt         null.Time   `json:"ends_at" swaggertype:"string" example:"2024-06-01T00:00:00Z"`
+	RevokedAt      null.Time   `json:"revoked_at" swaggertype:"string"`
+	CreatedBy      int         `json:"created_by" example:"1"`
+	CreatedAt      time.Time   `json:"created_at" example:"2024-04-20T09:30:00Z"`
+}
+
+// CreateCampaign is the body accepted when creating a campaign.
+// "default_ttl" and "default_max_uses" apply to tokens minted without their own expiry or max uses.
+type CreateCampaign struct {
+	Name           string     `json:"name" validate:"required,max=255" example:"Spring launch"`
+	Description    string     `json:"description" validate:"omitempty,max=1024" example:"Invites for the spring launch waitlist"`
+	DefaultTTL     string     `json:"default_ttl" example:"72h"`
+	DefaultMaxUses *int       `json:"default_max_uses" example:"1"`
+	StartsAt       *time.Time `json:"starts_at" example:"2024-05-01T09:00:00Z"`
+	EndsAt         *time.Time `json:"ends_at" example:"2024-06-01T00:00:00Z"`
+}
+
+// UpdateCampaign is the body accepted when changing a campaign. Omitted fields are left as they are.
+// Changing the defaults or dates only applies to tokens minted afterwards.
+type UpdateCampaign struct {
+	Name           *string    `json:"name" validate:"omitempty,min=1,max=255" example:"Spring launch"`
+	Description    *string    `json:"description" validate:"omitempty,max=1024" example:"Invites for the spring launch waitlist"`
+	DefaultTTL     *string    `json:"default_ttl" example:"48h"`
+	DefaultMaxUses *int       `json:"default_max_uses" example:"3"`
+	StartsAt       *time.Time `json:"starts_at" example:"2024-05-01T09:00:00Z"`
+	EndsAt         *time.Time `json:"ends_at" example:"2024-06-15T00:00:00Z"`
+}
+
+// NewCampaign holds the values persisted when creating a campaign
+type NewCampaign struct {
+	Name           string
+	Description    string
+	DefaultTTL     *time.Duration
+	DefaultMaxUses *int
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	CreatedBy      int
+}
+
+// CampaignChanges holds the values persisted when changing a campaign. Nil fields are left as they are.
+type CampaignChanges struct {
+	Name           *string
+	Description    *string
+	DefaultTTL     *time.Duration
+	DefaultMaxUses *int
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+}
+
+// CampaignStats counts a campaign's tokens. Active tokens are neither revoked nor expired,
+// and validated tokens were validated successfully at least once.
+type CampaignStats struct {
+	Issued    int `json:"issued" boil:"issued" example:"200"`
+	Active    int `json:"active" boil:"active" example:"150"`
+	Validated int `json:"validated" boil:"validated" example:"80"`
+	Expired   int `json:"expired" boil:"expired" example:"30"`
+	Revoked   int `json:"revoked" boil:"revoked" example:"20"`
+}
+
+// CampaignRevocation is the result of revoking a campaign, with how many of its tokens were revoked with it
+type CampaignRevocation struct {
+	Revoked int `json:"revoked" example:"150"`
+}
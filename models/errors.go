@@ -0,0 +1,17 @@
+package models
+
+import (
+	"net/http"
+	"platform_engineer_clone/src/utils/error_handling"
+)
+
+// ErrNotFound wraps the persistence layer's error when a lookup yields no results,
+// so other layers can tell a missing record apart from a failed query
+var ErrNotFound = error_handling.NotFound(TokenInvalidReasonNotFound, "error, record not found")
+
+// ErrTokenQuotaExceeded is returned when generating tokens would take their creator past their cap on active tokens
+var ErrTokenQuotaExceeded = error_handling.New("token_quota_exceeded", http.StatusTooManyRequests,
+	"error, generating the tokens would exceed your quota of active tokens")
+
+// ErrCampaignRevoked is returned when minting tokens into a campaign that was revoked
+var ErrCampaignRevoked = error_handling.Gone("campaign_revoked", "error, campaign is revoked")
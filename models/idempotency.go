@@ -0,0 +1,24 @@
+package models
+
+// IdempotencyRequest identifies a request sent with an Idempotency-Key header.
+// Keys are scoped to the user and the route, and the hash tells a retry from a different request.
+type IdempotencyRequest struct {
+	UserId      int
+	Scope       string
+	Key         string
+	RequestHash string
+}
+
+// IdempotentResponse is the response stored for an idempotency key, replayed to retries
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyRecord is the idempotency key as stored. Its response is nil while the request is being handled.
+type IdempotencyRecord struct {
+	Id          int
+	RequestHash string
+	Response    *IdempotentResponse
+}
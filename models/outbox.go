@@ -0,0 +1,13 @@
+package models
+
+// OutboxEvent is a token lifecycle event waiting in the outbox to be relayed to its sinks.
+// The payload is the TokenLifecycleEvent, JSON encoded.
+type OutboxEvent struct {
+	Id        int    `db:"id"`
+	TokenId   int    `db:"token_id"`
+	EventType string `db:"event_type"`
+	Payload   string `db:"payload"`
+	Attempts  int    `db:"attempts"`
+	// Delivered lists the sinks that accepted the event on an earlier attempt
+	Delivered []string
+}
@@ -7,3 +7,10 @@ type AuthFailBadRequest struct {
 type AuthFailInternalServerError struct {
 	Errors []string `json:"errors" example:"internal server error"`
 }
+
+// ErrorResponse is rendered for every failed request, with the request id to quote when reporting a failure
+type ErrorResponse struct {
+	Code      string   `json:"code" example:"invalid_token_params"`
+	Errors    []string `json:"errors" example:"error, invalid token params"`
+	RequestId string   `json:"request_id,omitempty" example:"3f1c9c0e-6a2e-4c43-8f86-0e0c2f6b9a41"`
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"github.com/volatiletech/null/v8"
+	"platform_engineer_clone/src/utils/error_handling"
+	"time"
+)
 
 var (
 	SevenDaysLapse     = (7 * time.Hour * 24).Hours() / 7
@@ -8,11 +12,179 @@ var (
 )
 
 type Token struct {
-	Id        int       `json:"id" db:"id"`
-	Key       string    `json:"key" db:"key"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
-	Revoked   bool      `json:"revoked" db:"revoked"`
-	Expired   bool      `json:"expired" db:"expired"`
-	CreatedBy string    `json:"created_by" db:"created_by"`
+	Id             int         `json:"id" db:"id"`
+	KeyPrefix      string      `json:"key_prefix" db:"key_prefix"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	ExpiresAt      time.Time   `json:"expires_at" db:"expires_at"`
+	Revoked        bool        `json:"revoked" db:"revoked"`
+	Expired        bool        `json:"expired" db:"expired"`
+	CreatedBy      string      `json:"created_by" db:"created_by"`
+	MaxUses        null.Int    `json:"max_uses" db:"max_uses" swaggertype:"integer"`
+	UseCount       int         `json:"use_count" db:"use_count"`
+	Label          null.String `json:"label" db:"label" swaggertype:"string"`
+	Note           null.String `json:"note" db:"note" swaggertype:"string"`
+	RecipientEmail null.String `json:"recipient_email" db:"recipient_email" swaggertype:"string"`
+	NotBefore      null.Time   `json:"not_before" db:"not_before" swaggertype:"string"`
+	CampaignId     null.Int    `json:"campaign_id" db:"campaign_id" swaggertype:"integer"`
+}
+
+// ArchivedToken is a purged token as appended to the archive, along with its events
+type ArchivedToken struct {
+	Token
+	Events []TokenEvent `json:"events"`
+}
+
+// TokenIterator calls each for every token in turn, stopping at the first error
+type TokenIterator func(each func(token *Token) error) error
+
+// CreateToken is the optional body accepted when creating a token.
+// Only one of "expires_in" or "expires_at" may be provided.
+// Omitting "max_uses" allows unlimited redemptions.
+// "not_before" schedules the token, which is rejected as not yet active until then.
+// "scopes" are checked when validating with ?scope=, e.g. "beta:analytics" or "org:42".
+type CreateToken struct {
+	ExpiresIn      string     `json:"expires_in" example:"72h"`
+	ExpiresAt      *time.Time `json:"expires_at" example:"2024-06-01T00:00:00Z"`
+	MaxUses        *int       `json:"max_uses" example:"1"`
+	Label          string     `json:"label" validate:"omitempty,max=255" example:"ACME onboarding"`
+	Note           string     `json:"note" validate:"omitempty,max=1024" example:"Sent after the kickoff call"`
+	RecipientEmail string     `json:"recipient_email" validate:"omitempty,email,max=320" example:"jane@acme.com"`
+	Scopes         []string   `json:"scopes" validate:"omitempty,max=20,unique,dive,token_scope" example:"beta:analytics,org:42"`
+	NotBefore      *time.Time `json:"not_before" example:"2024-05-01T09:00:00Z"`
+}
+
+// CreateTokenBatch is the body accepted when creating tokens in bulk.
+// Every token shares the same options.
+type CreateTokenBatch struct {
+	Count int `json:"count" example:"200"`
+	CreateToken
+}
+
+// UpdateToken is the body accepted when changing a token.
+// Only one of "extend_by" or "expires_at" may be provided. "extend_by" is relative to the
+// current expiry, and is negative to shorten it. "revoked" false reinstates a revoked token.
+type UpdateToken struct {
+	ExtendBy  string     `json:"extend_by" example:"48h"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2024-06-01T00:00:00Z"`
+	Revoked   *bool      `json:"revoked" example:"false"`
+}
+
+// TokenChanges holds the values persisted when changing a token. Nil fields are left as they are.
+type TokenChanges struct {
+	ExpiresAt *time.Time
+	Revoked   *bool
+}
+
+// NewToken holds the values persisted when generating a token.
+// ActiveQuota caps the creator's active tokens, new ones included, and nil leaves them uncapped.
+type NewToken struct {
+	CreatedBy      int
+	ActiveQuota    *int
+	CampaignId     *int
+	ExpiresAt      time.Time
+	MaxUses        *int
+	Label          string
+	Note           string
+	RecipientEmail string
+	Scopes         []string
+	NotBefore      *time.Time
+}
+
+// TokenValidation is the result of validating a usable token
+type TokenValidation struct {
+	Valid     bool      `json:"valid" example:"true"`
+	ExpiresAt time.Time `json:"expires_at" example:"2024-06-01T00:00:00Z"`
+	Scopes    []string  `json:"scopes" example:"beta:analytics,org:42"`
+}
+
+// Reasons a token fails validation, which are the codes of the errors it fails with.
+// They are stable, so clients can act on them.
+const (
+	TokenInvalidReasonMalformed     = "malformed"
+	TokenInvalidReasonNotFound      = "not_found"
+	TokenInvalidReasonRevoked       = "revoked"
+	TokenInvalidReasonExpired       = "expired"
+	TokenInvalidReasonExhausted     = "exhausted"
+	TokenInvalidReasonMissingScope  = "missing_scope"
+	TokenInvalidReasonNotYetActive  = "not_yet_active"
+	TokenInvalidReasonInternalError = error_handling.CodeInternalError
+)
+
+// TokenValidationFailure is the result of validating a token that can't be used, with the reason why
+type TokenValidationFailure struct {
+	Valid  bool     `json:"valid" example:"false"`
+	Reason string   `json:"reason" example:"revoked"`
+	Errors []string `json:"errors" example:"error, token is revoked"`
+}
+
+// Token statuses accepted by TokenFilter
+const (
+	TokenStatusActive  = "active"
+	TokenStatusRevoked = "revoked"
+	TokenStatusExpired = "expired"
+)
+
+// Token sort orders accepted by TokenFilter, prefixed with "-" to sort descending
+const (
+	TokenSortCreatedAt = "created_at"
+	TokenSortExpiresAt = "expires_at"
+)
+
+// TokenFilter narrows down the tokens returned by GetAll.
+// "search" matches part of the label, note or recipient email.
+// Date ranges accept either YYYY-MM-DD or RFC 3339 timestamps.
+type TokenFilter struct {
+	Label          string `query:"label"`
+	RecipientEmail string `query:"recipient_email"`
+	Search         string `query:"search"`
+	Status         string `query:"status"`
+	CreatedBy      int    `query:"created_by"`
+	CampaignId     int    `query:"campaign_id"`
+	CreatedAfter   string `query:"created_after"`
+	CreatedBefore  string `query:"created_before"`
+	ExpiresAfter   string `query:"expires_after"`
+	ExpiresBefore  string `query:"expires_before"`
+	Sort           string `query:"sort"`
+	Cursor         string `query:"cursor"`
+	Limit          int    `query:"limit"`
+}
+
+// TokenQuery is the validated TokenFilter handed to the persistence layer.
+// A zero Limit returns every matching token.
+type TokenQuery struct {
+	Label          string
+	RecipientEmail string
+	Search         string
+	Status         string
+	CreatedBy      int
+	CampaignId     int
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	ExpiresAfter   *time.Time
+	ExpiresBefore  *time.Time
+	SortBy         string
+	SortDesc       bool
+	After          *TokenCursor
+	Limit          int
+}
+
+// TokenCursor marks the last token of a page, by its sort value and id
+type TokenCursor struct {
+	Value time.Time `json:"v"`
+	Id    int       `json:"id"`
+}
+
+// TokenPage is a single page of tokens.
+// NextCursor is empty on the last page.
+type TokenPage struct {
+	Tokens     []Token
+	NextCursor string
+}
+
+// TokenQuota is the user's cap on active tokens, which are neither revoked nor expired.
+// Limit and Remaining are null when the user's tokens aren't capped.
+type TokenQuota struct {
+	Limit     *int `json:"limit" example:"100"`
+	Used      int  `json:"used" example:"42"`
+	Remaining *int `json:"remaining" example:"58"`
 }
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// Token event actions
+const (
+	TokenEventActionValidate = "validate"
+	TokenEventActionRedeem   = "redeem"
+)
+
+// Token event outcomes
+const (
+	TokenEventOutcomeValid        = "valid"
+	TokenEventOutcomeRevoked      = "revoked"
+	TokenEventOutcomeExpired      = "expired"
+	TokenEventOutcomeExhausted    = "exhausted"
+	TokenEventOutcomeMissingScope = "missing_scope"
+	TokenEventOutcomeNotYetActive = "not_yet_active"
+	TokenEventOutcomeNotFound     = "not_found"
+	TokenEventOutcomeError        = "error"
+)
+
+type TokenEvent struct {
+	Id        int       `json:"id" db:"id"`
+	Action    string    `json:"action" db:"action" example:"validate"`
+	Outcome   string    `json:"outcome" db:"outcome" example:"valid"`
+	Ip        string    `json:"ip" db:"ip" example:"127.0.0.1"`
+	UserAgent string    `json:"user_agent" db:"user_agent"`
+	RequestId string    `json:"request_id" db:"request_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// NewTokenEvent holds the values persisted when recording a token event.
+// TokenId is nil when the key did not match any token.
+type NewTokenEvent struct {
+	TokenId   *int
+	Action    string
+	Outcome   string
+	Ip        string
+	UserAgent string
+	RequestId string
+}
+
+// RequestMeta identifies the client behind a request
+type RequestMeta struct {
+	Ip        string
+	UserAgent string
+	RequestId string
+}
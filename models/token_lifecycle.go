@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// Token lifecycle event types, published to subscribers such as webhooks
+const (
+	TokenLifecycleCreated   = "token.created"
+	TokenLifecycleValidated = "token.validated"
+	TokenLifecycleRedeemed  = "token.redeemed"
+	TokenLifecycleRevoked   = "token.revoked"
+	TokenLifecycleExpired   = "token.expired"
+)
+
+// TokenLifecycleEventTypes lists every event type, in the order tokens go through them
+var TokenLifecycleEventTypes = []string{
+	TokenLifecycleCreated,
+	TokenLifecycleValidated,
+	TokenLifecycleRedeemed,
+	TokenLifecycleRevoked,
+	TokenLifecycleExpired,
+}
+
+// TokenRef identifies a token without its key
+type TokenRef struct {
+	Id        int    `json:"id" db:"id" example:"42"`
+	KeyPrefix string `json:"key_prefix" db:"key_prefix" example:"inv_3k"`
+}
+
+// TokenLifecycleEvent tells subscribers something happened to a token.
+// It only identifies the token, which subscribers can fetch for its details.
+type TokenLifecycleEvent struct {
+	Type       string    `json:"type" example:"token.created"`
+	Token      TokenRef  `json:"token"`
+	OccurredAt time.Time `json:"occurred_at" example:"2024-06-01T09:30:00Z"`
+}
+
+// TokenStreamEvent is a token lifecycle event pushed to stream subscribers.
+// Ids are the broker's epoch followed by a sequence increasing with every event,
+// so a subscriber can resume after the last event it received.
+type TokenStreamEvent struct {
+	Id    string
+	Event TokenLifecycleEvent
+}
+
+// TokenStreamSubscription receives the events published after it subscribed, on Events,
+// which is closed once the subscription ends. Backlog holds the buffered events it resumes from,
+// and Reset is set when some of the events after the one it resumes from are no longer buffered,
+// so the subscriber may have missed events.
+type TokenStreamSubscription struct {
+	Backlog []TokenStreamEvent
+	Reset   bool
+	Events  <-chan TokenStreamEvent
+}
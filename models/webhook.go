@@ -0,0 +1,95 @@
+package models
+
+import (
+	"github.com/volatiletech/null/v8"
+	"time"
+)
+
+// Webhook delivery statuses. Pending deliveries are retried until they succeed, or run out of attempts.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook is a subscription to token lifecycle events. An empty "events" subscribes to every event.
+type Webhook struct {
+	Id        int       `json:"id" example:"1"`
+	Url       string    `json:"url" example:"https://hooks.acme.com/invites"`
+	Events    []string  `json:"events" example:"token.created,token.redeemed"`
+	Active    bool      `json:"active" example:"true"`
+	CreatedBy int       `json:"created_by" example:"1"`
+	CreatedAt time.Time `json:"created_at" example:"2024-06-01T09:30:00Z"`
+}
+
+// CreatedWebhook is returned once when creating a webhook, with the secret its deliveries are signed with
+type CreatedWebhook struct {
+	Webhook
+	Secret string `json:"secret" example:"whsec_6c1f0e..."`
+}
+
+// CreateWebhook is the body accepted when subscribing a URL to token lifecycle events.
+// Omitting "events" subscribes to every event.
+type CreateWebhook struct {
+	Url    string   `json:"url" validate:"required,max=2048,http_url" example:"https://hooks.acme.com/invites"`
+	Events []string `json:"events" validate:"omitempty,unique,dive,oneof=token.created token.validated token.redeemed token.revoked token.expired" example:"token.created,token.redeemed"`
+}
+
+// UpdateWebhook is the body accepted when changing a webhook. Omitted fields are left as they are.
+type UpdateWebhook struct {
+	Url    *string   `json:"url" validate:"omitempty,max=2048,http_url" example:"https://hooks.acme.com/invites"`
+	Events *[]string `json:"events" validate:"omitempty,unique,dive,oneof=token.created token.validated token.redeemed token.revoked token.expired" example:"token.revoked"`
+	Active *bool     `json:"active" example:"false"`
+}
+
+// NewWebhook holds the values persisted when creating a webhook
+type NewWebhook struct {
+	Url       string
+	Secret    string
+	Events    []string
+	CreatedBy int
+}
+
+// WebhookChanges holds the values persisted when changing a webhook. Nil fields are left as they are.
+type WebhookChanges struct {
+	Url    *string
+	Events *[]string
+	Active *bool
+}
+
+// WebhookDelivery is one event sent, or to be sent, to a webhook.
+// Pending deliveries are attempted again from "next_attempt_at".
+type WebhookDelivery struct {
+	Id             int         `json:"id" db:"id" example:"7"`
+	WebhookId      int         `json:"webhook_id" db:"webhook_id" example:"1"`
+	EventType      string      `json:"event_type" db:"event_type" example:"token.created"`
+	Payload        string      `json:"payload" db:"payload"`
+	Status         string      `json:"status" db:"status" example:"pending"`
+	Attempts       int         `json:"attempts" db:"attempts" example:"2"`
+	NextAttemptAt  time.Time   `json:"next_attempt_at" db:"next_attempt_at"`
+	LastAttemptAt  null.Time   `json:"last_attempt_at" db:"last_attempt_at" swaggertype:"string"`
+	ResponseStatus null.Int    `json:"response_status" db:"response_status" swaggertype:"integer"`
+	LastError      null.String `json:"last_error" db:"last_error" swaggertype:"string"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	DeliveredAt    null.Time   `json:"delivered_at" db:"delivered_at" swaggertype:"string"`
+}
+
+// DueWebhookDelivery is a delivery claimed for sending, with where to send it and what to sign it with
+type DueWebhookDelivery struct {
+	Id        int    `db:"id"`
+	EventType string `db:"event_type"`
+	Payload   string `db:"payload"`
+	Attempts  int    `db:"attempts"`
+	Url       string `db:"url"`
+	Secret    string `db:"secret"`
+}
+
+// WebhookDeliveryAttempt holds the outcome persisted after attempting a delivery
+type WebhookDeliveryAttempt struct {
+	Status         string
+	Attempts       int
+	AttemptedAt    time.Time
+	NextAttemptAt  time.Time
+	ResponseStatus *int
+	Error          string
+}
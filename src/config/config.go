@@ -3,16 +3,52 @@ package config
 import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
 	"platform_engineer_clone/src/utils/validation"
+	"regexp"
 	"strings"
+	"time"
 )
 
 var (
 	errValidatingStructParams      = errors.New("error validating struct params")
 	errReturnedFromParamValidation = errors.New("errors returned from param validation")
 	errTokenDaysValidLessThanOne   = errors.New("error, token days valid is less than one")
+	errTokenMinTTLGreaterThanMax   = errors.New("error, token min ttl is greater than the max ttl")
+	errTokenDaysValidOutsideTTL    = errors.New("error, token days valid is outside the min and max ttl")
+	errTokenSweepIntervalNegative  = errors.New("error, token expiry sweep interval is negative")
+	errRandomCharLengthRange       = errors.New("error, random char lengths must be between 1 and 64, with the min no greater than the max")
+	errUnknownTokenAlphabet        = errors.New("error, token alphabet must be one of hex, crockford, numeric or friendly")
+	errInvalidTokenKeyPrefix       = errors.New("error, token key prefix must be up to 8 lowercase letters and digits ending in _, e.g. inv_")
+	errUnknownTokenMode            = errors.New("error, token mode must be one of stored or signed")
+	errInvalidTokenSigningKeys     = errors.New("error, invalid token signing keys")
+	errTokenRevocationRefreshRange = errors.New("error, token revocation refresh interval must be positive")
+	errTokenRetentionDaysNegative  = errors.New("error, token retention days is negative")
+	errTokenPurgeIntervalNegative  = errors.New("error, token purge interval is negative")
+	errTokenPurgeWithoutRetention  = errors.New("error, token purge interval is set without token retention days")
+	errWebhookIntervalNegative     = errors.New("error, webhook delivery interval is negative")
+	errWebhookBackoffRange         = errors.New("error, webhook backoff base must be positive, and no greater than the backoff max")
+	errOutboxIntervalNegative      = errors.New("error, outbox relay interval is negative")
+	errOutboxRetentionNegative     = errors.New("error, outbox retention is negative")
+	errEventLogRotationNegative    = errors.New("error, event log max size and max age can't be negative")
+	errIdempotencyWindowRange      = errors.New("error, idempotency window must be positive")
+	errIdempotencyPurgeNegative    = errors.New("error, idempotency purge interval is negative")
 )
 
+// The token modes. Stored tokens are looked up on every validation, while signed tokens
+// embed their claims and are validated offline, against an in memory revocation set.
+const (
+	TokenModeStored = "stored"
+	TokenModeSigned = "signed"
+)
+
+// maxRandomCharLength caps generated keys, well past the length needed for them to be unguessable
+const maxRandomCharLength = 64
+
+// tokenKeyPrefixPattern keeps key prefixes recognisable, and short enough for the stored visible prefix
+var tokenKeyPrefixPattern = regexp.MustCompile(`^[a-z][a-z0-9]{0,6}_$`)
+
 // DatabaseCredentials holds our database env settings
 type DatabaseCredentials struct {
 	Host     string `mapstructure:"DB_HOST" validate:"required"`
@@ -23,9 +59,50 @@ type DatabaseCredentials struct {
 }
 
 type App struct {
-	TokenDaysValid      int `mapstructure:"APP_TOKEN_DAYS_VALID" validate:"required"`
-	RandomCharMinLength int `mapstructure:"APP_RANDOM_CHAR_MIN_LENGTH" validate:"required"`
-	RandomCharMaxLength int `mapstructure:"APP_RANDOM_CHAR_MAX_LENGTH" validate:"required"`
+	TokenDaysValid                 int           `mapstructure:"APP_TOKEN_DAYS_VALID" validate:"required"`
+	TokenMinTTL                    time.Duration `mapstructure:"APP_TOKEN_MIN_TTL" validate:"required"`
+	TokenMaxTTL                    time.Duration `mapstructure:"APP_TOKEN_MAX_TTL" validate:"required"`
+	RandomCharMinLength            int           `mapstructure:"APP_RANDOM_CHAR_MIN_LENGTH" validate:"required"`
+	RandomCharMaxLength            int           `mapstructure:"APP_RANDOM_CHAR_MAX_LENGTH" validate:"required"`
+	TokenBatchMaxCount             int           `mapstructure:"APP_TOKEN_BATCH_MAX_COUNT" validate:"required,min=1"`
+	TokenActiveQuota               int           `mapstructure:"APP_TOKEN_ACTIVE_QUOTA" validate:"min=0"`
+	TokenExpirySweepInterval       time.Duration `mapstructure:"APP_TOKEN_EXPIRY_SWEEP_INTERVAL" validate:"required"`
+	TokenExpirySweepBatchSize      int           `mapstructure:"APP_TOKEN_EXPIRY_SWEEP_BATCH_SIZE" validate:"required,min=1"`
+	TokenKeySecret                 string        `mapstructure:"APP_TOKEN_KEY_SECRET" validate:"required,min=32"`
+	TokenAlphabet                  string        `mapstructure:"APP_TOKEN_ALPHABET" validate:"required"`
+	TokenBlocklist                 []string      `mapstructure:"APP_TOKEN_BLOCKLIST"`
+	TokenKeyPrefix                 string        `mapstructure:"APP_TOKEN_KEY_PREFIX" validate:"required"`
+	TokenAcceptLegacyKeys          bool          `mapstructure:"APP_TOKEN_ACCEPT_LEGACY_KEYS"`
+	TokenMode                      string        `mapstructure:"APP_TOKEN_MODE" validate:"required"`
+	TokenSigningAlgorithm          string        `mapstructure:"APP_TOKEN_SIGNING_ALGORITHM"`
+	TokenSigningKeys               []string      `mapstructure:"APP_TOKEN_SIGNING_KEYS"`
+	TokenRevocationRefreshInterval time.Duration `mapstructure:"APP_TOKEN_REVOCATION_REFRESH_INTERVAL"`
+	TokenRetentionDays             int           `mapstructure:"APP_TOKEN_RETENTION_DAYS"`
+	TokenPurgeInterval             time.Duration `mapstructure:"APP_TOKEN_PURGE_INTERVAL"`
+	TokenPurgeBatchSize            int           `mapstructure:"APP_TOKEN_PURGE_BATCH_SIZE" validate:"required,min=1"`
+	TokenPurgeArchiveFile          string        `mapstructure:"APP_TOKEN_PURGE_ARCHIVE_FILE"`
+	WebhookDeliveryInterval        time.Duration `mapstructure:"APP_WEBHOOK_DELIVERY_INTERVAL"`
+	WebhookBatchSize               int           `mapstructure:"APP_WEBHOOK_BATCH_SIZE" validate:"required,min=1"`
+	WebhookMaxAttempts             int           `mapstructure:"APP_WEBHOOK_MAX_ATTEMPTS" validate:"required,min=1"`
+	WebhookBackoffBase             time.Duration `mapstructure:"APP_WEBHOOK_BACKOFF_BASE"`
+	WebhookBackoffMax              time.Duration `mapstructure:"APP_WEBHOOK_BACKOFF_MAX"`
+	WebhookTimeout                 time.Duration `mapstructure:"APP_WEBHOOK_TIMEOUT" validate:"required"`
+	OutboxRelayInterval            time.Duration `mapstructure:"APP_OUTBOX_RELAY_INTERVAL"`
+	OutboxBatchSize                int           `mapstructure:"APP_OUTBOX_BATCH_SIZE" validate:"required,min=1"`
+	OutboxMaxAttempts              int           `mapstructure:"APP_OUTBOX_MAX_ATTEMPTS" validate:"required,min=1"`
+	OutboxLease                    time.Duration `mapstructure:"APP_OUTBOX_LEASE" validate:"required"`
+	OutboxRetention                time.Duration `mapstructure:"APP_OUTBOX_RETENTION"`
+	StreamBufferSize               int           `mapstructure:"APP_STREAM_BUFFER_SIZE" validate:"required,min=1"`
+	StreamHeartbeatInterval        time.Duration `mapstructure:"APP_STREAM_HEARTBEAT_INTERVAL" validate:"required"`
+	EventLogFile                   string        `mapstructure:"APP_EVENT_LOG_FILE"`
+	EventLogMaxSizeMB              int           `mapstructure:"APP_EVENT_LOG_MAX_SIZE_MB"`
+	EventLogMaxAge                 time.Duration `mapstructure:"APP_EVENT_LOG_MAX_AGE"`
+	EventLogCompress               bool          `mapstructure:"APP_EVENT_LOG_COMPRESS"`
+	EventLogFsync                  string        `mapstructure:"APP_EVENT_LOG_FSYNC" validate:"oneof=always interval never"`
+	EventLogFsyncInterval          time.Duration `mapstructure:"APP_EVENT_LOG_FSYNC_INTERVAL" validate:"required"`
+	IdempotencyWindow              time.Duration `mapstructure:"APP_IDEMPOTENCY_WINDOW"`
+	IdempotencyPurgeInterval       time.Duration `mapstructure:"APP_IDEMPOTENCY_PURGE_INTERVAL"`
+	IdempotencyPurgeBatchSize      int           `mapstructure:"APP_IDEMPOTENCY_PURGE_BATCH_SIZE" validate:"required,min=1"`
 }
 
 type API struct {
@@ -38,6 +115,45 @@ type Config struct {
 	App                 App
 }
 
+// setDefaults registers the fallback values for the optional settings,
+// which also makes them overridable through the environment
+func setDefaults() {
+	viper.SetDefault("APP_TOKEN_MIN_TTL", time.Hour)
+	viper.SetDefault("APP_TOKEN_MAX_TTL", 30*24*time.Hour)
+	viper.SetDefault("APP_TOKEN_BATCH_MAX_COUNT", 500)
+	viper.SetDefault("APP_TOKEN_ACTIVE_QUOTA", 0)
+	viper.SetDefault("APP_TOKEN_EXPIRY_SWEEP_INTERVAL", time.Minute)
+	viper.SetDefault("APP_TOKEN_EXPIRY_SWEEP_BATCH_SIZE", 500)
+	viper.SetDefault("APP_TOKEN_ALPHABET", keygen.AlphabetHex)
+	viper.SetDefault("APP_TOKEN_KEY_PREFIX", "inv_")
+	viper.SetDefault("APP_TOKEN_ACCEPT_LEGACY_KEYS", true)
+	viper.SetDefault("APP_TOKEN_MODE", TokenModeStored)
+	viper.SetDefault("APP_TOKEN_SIGNING_ALGORITHM", signing.AlgorithmHMAC)
+	viper.SetDefault("APP_TOKEN_REVOCATION_REFRESH_INTERVAL", 30*time.Second)
+	viper.SetDefault("APP_TOKEN_PURGE_BATCH_SIZE", 500)
+	viper.SetDefault("APP_WEBHOOK_DELIVERY_INTERVAL", 5*time.Second)
+	viper.SetDefault("APP_WEBHOOK_BATCH_SIZE", 50)
+	viper.SetDefault("APP_WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("APP_WEBHOOK_BACKOFF_BASE", 30*time.Second)
+	viper.SetDefault("APP_WEBHOOK_BACKOFF_MAX", time.Hour)
+	viper.SetDefault("APP_WEBHOOK_TIMEOUT", 10*time.Second)
+	viper.SetDefault("APP_OUTBOX_RELAY_INTERVAL", time.Second)
+	viper.SetDefault("APP_OUTBOX_BATCH_SIZE", 100)
+	viper.SetDefault("APP_OUTBOX_MAX_ATTEMPTS", 10)
+	viper.SetDefault("APP_OUTBOX_LEASE", time.Minute)
+	viper.SetDefault("APP_OUTBOX_RETENTION", 7*24*time.Hour)
+	viper.SetDefault("APP_STREAM_BUFFER_SIZE", 1000)
+	viper.SetDefault("APP_STREAM_HEARTBEAT_INTERVAL", 15*time.Second)
+	viper.SetDefault("APP_EVENT_LOG_MAX_SIZE_MB", 100)
+	viper.SetDefault("APP_EVENT_LOG_MAX_AGE", 24*time.Hour)
+	viper.SetDefault("APP_EVENT_LOG_COMPRESS", true)
+	viper.SetDefault("APP_EVENT_LOG_FSYNC", "interval")
+	viper.SetDefault("APP_EVENT_LOG_FSYNC_INTERVAL", time.Second)
+	viper.SetDefault("APP_IDEMPOTENCY_WINDOW", 24*time.Hour)
+	viper.SetDefault("APP_IDEMPOTENCY_PURGE_INTERVAL", time.Hour)
+	viper.SetDefault("APP_IDEMPOTENCY_PURGE_BATCH_SIZE", 500)
+}
+
 // NewConfig reads values from the .env file, and writes them to the Config struct
 func NewConfig(configFile string) (*Config, error) {
 	viper.SetConfigFile(configFile)
@@ -46,6 +162,7 @@ func NewConfig(configFile string) (*Config, error) {
 		return nil, errors.Wrap(err, "error reading config file")
 	}
 	viper.AutomaticEnv()
+	setDefaults()
 
 	config := &Config{}
 
@@ -66,6 +183,74 @@ func NewConfig(configFile string) (*Config, error) {
 	if config.App.TokenDaysValid < 1 {
 		return config, errTokenDaysValidLessThanOne
 	}
+	if config.App.TokenMinTTL > config.App.TokenMaxTTL {
+		return config, errTokenMinTTLGreaterThanMax
+	}
+	tokenDaysValid := time.Duration(config.App.TokenDaysValid) * 24 * time.Hour
+	if tokenDaysValid < config.App.TokenMinTTL || tokenDaysValid > config.App.TokenMaxTTL {
+		return config, errTokenDaysValidOutsideTTL
+	}
+	if config.App.TokenExpirySweepInterval < 0 {
+		return config, errTokenSweepIntervalNegative
+	}
+	if config.App.RandomCharMinLength < 1 || config.App.RandomCharMaxLength > maxRandomCharLength ||
+		config.App.RandomCharMinLength > config.App.RandomCharMaxLength {
+		return config, errRandomCharLengthRange
+	}
+	if !keygen.IsAlphabet(config.App.TokenAlphabet) {
+		return config, errUnknownTokenAlphabet
+	}
+	if !tokenKeyPrefixPattern.MatchString(config.App.TokenKeyPrefix) {
+		return config, errInvalidTokenKeyPrefix
+	}
+	// Retention is off until days are set, and the periodic purge is off until an interval is set too
+	if config.App.TokenRetentionDays < 0 {
+		return config, errTokenRetentionDaysNegative
+	}
+	if config.App.TokenPurgeInterval < 0 {
+		return config, errTokenPurgeIntervalNegative
+	}
+	if config.App.TokenPurgeInterval > 0 && config.App.TokenRetentionDays == 0 {
+		return config, errTokenPurgeWithoutRetention
+	}
+	// Webhook deliveries are queued regardless, and only sent while the interval is set
+	if config.App.WebhookDeliveryInterval < 0 {
+		return config, errWebhookIntervalNegative
+	}
+	if config.App.WebhookBackoffBase <= 0 || config.App.WebhookBackoffBase > config.App.WebhookBackoffMax {
+		return config, errWebhookBackoffRange
+	}
+	// Outbox events are written regardless, and only relayed while the interval is set
+	if config.App.OutboxRelayInterval < 0 {
+		return config, errOutboxIntervalNegative
+	}
+	if config.App.OutboxRetention < 0 {
+		return config, errOutboxRetentionNegative
+	}
+	// The event log is off until a file is set
+	if config.App.EventLogMaxSizeMB < 0 || config.App.EventLogMaxAge < 0 {
+		return config, errEventLogRotationNegative
+	}
+	// Expired keys are ignored regardless, and only deleted while the purge interval is set
+	if config.App.IdempotencyWindow <= 0 {
+		return config, errIdempotencyWindowRange
+	}
+	if config.App.IdempotencyPurgeInterval < 0 {
+		return config, errIdempotencyPurgeNegative
+	}
+	switch config.App.TokenMode {
+	case TokenModeStored:
+	case TokenModeSigned:
+		_, err = signing.NewSigner(config.App.TokenKeyPrefix, config.App.TokenSigningAlgorithm, config.App.TokenSigningKeys)
+		if err != nil {
+			return config, errors.Wrap(err, errInvalidTokenSigningKeys.Error())
+		}
+		if config.App.TokenRevocationRefreshInterval <= 0 {
+			return config, errTokenRevocationRefreshRange
+		}
+	default:
+		return config, errUnknownTokenMode
+	}
 
 	configStructs := []interface{}{
 		config.DatabaseCredentials,
@@ -4,9 +4,25 @@
 package models_schema
 
 var TableNames = struct {
-	Token string
-	User  string
+	Campaign        string
+	IdempotencyKey  string
+	Outbox          string
+	OutboxDelivery  string
+	Token           string
+	TokenEvent      string
+	TokenScope      string
+	User            string
+	Webhook         string
+	WebhookDelivery string
 }{
-	Token: "token",
-	User:  "user",
+	Campaign:        "campaign",
+	IdempotencyKey:  "idempotency_key",
+	Outbox:          "outbox",
+	OutboxDelivery:  "outbox_delivery",
+	Token:           "token",
+	TokenEvent:      "token_event",
+	TokenScope:      "token_scope",
+	User:            "user",
+	Webhook:         "webhook",
+	WebhookDelivery: "webhook_delivery",
 }
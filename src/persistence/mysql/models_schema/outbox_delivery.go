@@ -0,0 +1,1107 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OutboxDelivery is an object representing the database table.
+type OutboxDelivery struct {
+	OutboxID    int       `boil:"outbox_id" json:"outbox_id" toml:"outbox_id" yaml:"outbox_id"`
+	Sink        string    `boil:"sink" json:"sink" toml:"sink" yaml:"sink"`
+	DeliveredAt time.Time `boil:"delivered_at" json:"delivered_at" toml:"delivered_at" yaml:"delivered_at"`
+
+	R *outboxDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxDeliveryColumns = struct {
+	OutboxID    string
+	Sink        string
+	DeliveredAt string
+}{
+	OutboxID:    "outbox_id",
+	Sink:        "sink",
+	DeliveredAt: "delivered_at",
+}
+
+var OutboxDeliveryTableColumns = struct {
+	OutboxID    string
+	Sink        string
+	DeliveredAt string
+}{
+	OutboxID:    "outbox_delivery.outbox_id",
+	Sink:        "outbox_delivery.sink",
+	DeliveredAt: "outbox_delivery.delivered_at",
+}
+
+// Generated where
+
+var OutboxDeliveryWhere = struct {
+	OutboxID    whereHelperint
+	Sink        whereHelperstring
+	DeliveredAt whereHelpertime_Time
+}{
+	OutboxID:    whereHelperint{field: "`outbox_delivery`.`outbox_id`"},
+	Sink:        whereHelperstring{field: "`outbox_delivery`.`sink`"},
+	DeliveredAt: whereHelpertime_Time{field: "`outbox_delivery`.`delivered_at`"},
+}
+
+// OutboxDeliveryRels is where relationship names are stored.
+var OutboxDeliveryRels = struct {
+	Outbox string
+}{
+	Outbox: "Outbox",
+}
+
+// outboxDeliveryR is where relationships are stored.
+type outboxDeliveryR struct {
+	Outbox *Outbox `boil:"Outbox" json:"Outbox" toml:"Outbox" yaml:"Outbox"`
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxDeliveryR) NewStruct() *outboxDeliveryR {
+	return &outboxDeliveryR{}
+}
+
+func (r *outboxDeliveryR) GetOutbox() *Outbox {
+	if r == nil {
+		return nil
+	}
+	return r.Outbox
+}
+
+// outboxDeliveryL is where Load methods for each relationship are stored.
+type outboxDeliveryL struct{}
+
+var (
+	outboxDeliveryAllColumns            = []string{"outbox_id", "sink", "delivered_at"}
+	outboxDeliveryColumnsWithoutDefault = []string{"outbox_id", "sink"}
+	outboxDeliveryColumnsWithDefault    = []string{"delivered_at"}
+	outboxDeliveryPrimaryKeyColumns     = []string{"outbox_id", "sink"}
+	outboxDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxDeliverySlice is an alias for a slice of pointers to OutboxDelivery.
+	// This should almost always be used instead of []OutboxDelivery.
+	OutboxDeliverySlice []*OutboxDelivery
+	// OutboxDeliveryHook is the signature for custom OutboxDelivery hook methods
+	OutboxDeliveryHook func(context.Context, boil.ContextExecutor, *OutboxDelivery) error
+
+	outboxDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxDeliveryType                 = reflect.TypeOf(&OutboxDelivery{})
+	outboxDeliveryMapping              = queries.MakeStructMapping(outboxDeliveryType)
+	outboxDeliveryPrimaryKeyMapping, _ = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, outboxDeliveryPrimaryKeyColumns)
+	outboxDeliveryInsertCacheMut       sync.RWMutex
+	outboxDeliveryInsertCache          = make(map[string]insertCache)
+	outboxDeliveryUpdateCacheMut       sync.RWMutex
+	outboxDeliveryUpdateCache          = make(map[string]updateCache)
+	outboxDeliveryUpsertCacheMut       sync.RWMutex
+	outboxDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var outboxDeliveryAfterSelectHooks []OutboxDeliveryHook
+
+var outboxDeliveryBeforeInsertHooks []OutboxDeliveryHook
+var outboxDeliveryAfterInsertHooks []OutboxDeliveryHook
+
+var outboxDeliveryBeforeUpdateHooks []OutboxDeliveryHook
+var outboxDeliveryAfterUpdateHooks []OutboxDeliveryHook
+
+var outboxDeliveryBeforeDeleteHooks []OutboxDeliveryHook
+var outboxDeliveryAfterDeleteHooks []OutboxDeliveryHook
+
+var outboxDeliveryBeforeUpsertHooks []OutboxDeliveryHook
+var outboxDeliveryAfterUpsertHooks []OutboxDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OutboxDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OutboxDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OutboxDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OutboxDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OutboxDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OutboxDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OutboxDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OutboxDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OutboxDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOutboxDeliveryHook registers your hook function for all future operations.
+func AddOutboxDeliveryHook(hookPoint boil.HookPoint, outboxDeliveryHook OutboxDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		outboxDeliveryAfterSelectHooks = append(outboxDeliveryAfterSelectHooks, outboxDeliveryHook)
+	case boil.BeforeInsertHook:
+		outboxDeliveryBeforeInsertHooks = append(outboxDeliveryBeforeInsertHooks, outboxDeliveryHook)
+	case boil.AfterInsertHook:
+		outboxDeliveryAfterInsertHooks = append(outboxDeliveryAfterInsertHooks, outboxDeliveryHook)
+	case boil.BeforeUpdateHook:
+		outboxDeliveryBeforeUpdateHooks = append(outboxDeliveryBeforeUpdateHooks, outboxDeliveryHook)
+	case boil.AfterUpdateHook:
+		outboxDeliveryAfterUpdateHooks = append(outboxDeliveryAfterUpdateHooks, outboxDeliveryHook)
+	case boil.BeforeDeleteHook:
+		outboxDeliveryBeforeDeleteHooks = append(outboxDeliveryBeforeDeleteHooks, outboxDeliveryHook)
+	case boil.AfterDeleteHook:
+		outboxDeliveryAfterDeleteHooks = append(outboxDeliveryAfterDeleteHooks, outboxDeliveryHook)
+	case boil.BeforeUpsertHook:
+		outboxDeliveryBeforeUpsertHooks = append(outboxDeliveryBeforeUpsertHooks, outboxDeliveryHook)
+	case boil.AfterUpsertHook:
+		outboxDeliveryAfterUpsertHooks = append(outboxDeliveryAfterUpsertHooks, outboxDeliveryHook)
+	}
+}
+
+// One returns a single outboxDelivery record from the query.
+func (q outboxDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OutboxDelivery, error) {
+	o := &OutboxDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: failed to execute a one query for outbox_delivery")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OutboxDelivery records from the query.
+func (q outboxDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (OutboxDeliverySlice, error) {
+	var o []*OutboxDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_schema: failed to assign all query results to OutboxDelivery slice")
+	}
+
+	if len(outboxDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OutboxDelivery records in the query.
+func (q outboxDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to count outbox_delivery rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: failed to check if outbox_delivery exists")
+	}
+
+	return count > 0, nil
+}
+
+// Outbox pointed to by the foreign key.
+func (o *OutboxDelivery) Outbox(mods ...qm.QueryMod) outboxQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.OutboxID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Outboxes(queryMods...)
+}
+
+// LoadOutbox allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (outboxDeliveryL) LoadOutbox(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOutboxDelivery interface{}, mods queries.Applicator) error {
+	var slice []*OutboxDelivery
+	var object *OutboxDelivery
+
+	if singular {
+		object = maybeOutboxDelivery.(*OutboxDelivery)
+	} else {
+		slice = *maybeOutboxDelivery.(*[]*OutboxDelivery)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &outboxDeliveryR{}
+		}
+		args = append(args, object.OutboxID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &outboxDeliveryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OutboxID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OutboxID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`outbox`),
+		qm.WhereIn(`outbox.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Outbox")
+	}
+
+	var resultSlice []*Outbox
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Outbox")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for outbox")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for outbox")
+	}
+
+	if len(outboxDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Outbox = foreign
+		if foreign.R == nil {
+			foreign.R = &outboxR{}
+		}
+		foreign.R.OutboxDeliveries = append(foreign.R.OutboxDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OutboxID == foreign.ID {
+				local.R.Outbox = foreign
+				if foreign.R == nil {
+					foreign.R = &outboxR{}
+				}
+				foreign.R.OutboxDeliveries = append(foreign.R.OutboxDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOutbox of the outboxDelivery to the related item.
+// Sets o.R.Outbox to related.
+// Adds o to related.R.OutboxDeliveries.
+func (o *OutboxDelivery) SetOutbox(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Outbox) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `outbox_delivery` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"outbox_id"}),
+		strmangle.WhereClause("`", "`", 0, outboxDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OutboxID, o.Sink}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OutboxID = related.ID
+	if o.R == nil {
+		o.R = &outboxDeliveryR{
+			Outbox: related,
+		}
+	} else {
+		o.R.Outbox = related
+	}
+
+	if related.R == nil {
+		related.R = &outboxR{
+			OutboxDeliveries: OutboxDeliverySlice{o},
+		}
+	} else {
+		related.R.OutboxDeliveries = append(related.R.OutboxDeliveries, o)
+	}
+
+	return nil
+}
+
+// OutboxDeliveries retrieves all the records using an executor.
+func OutboxDeliveries(mods ...qm.QueryMod) outboxDeliveryQuery {
+	mods = append(mods, qm.From("`outbox_delivery`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`outbox_delivery`.*"})
+	}
+
+	return outboxDeliveryQuery{q}
+}
+
+// FindOutboxDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxDelivery(ctx context.Context, exec boil.ContextExecutor, outboxID int, sink string, selectCols ...string) (*OutboxDelivery, error) {
+	outboxDeliveryObj := &OutboxDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `outbox_delivery` where `outbox_id`=? AND `sink`=?", sel,
+	)
+
+	q := queries.Raw(query, outboxID, sink)
+
+	err := q.Bind(ctx, exec, outboxDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: unable to select from outbox_delivery")
+	}
+
+	if err = outboxDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return outboxDeliveryObj, err
+	}
+
+	return outboxDeliveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no outbox_delivery provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxDeliveryInsertCacheMut.RLock()
+	cache, cached := outboxDeliveryInsertCache[key]
+	outboxDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxDeliveryAllColumns,
+			outboxDeliveryColumnsWithDefault,
+			outboxDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `outbox_delivery` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `outbox_delivery` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `outbox_delivery` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, outboxDeliveryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to insert into outbox_delivery")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.OutboxID,
+		o.Sink,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for outbox_delivery")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxDeliveryInsertCacheMut.Lock()
+		outboxDeliveryInsertCache[key] = cache
+		outboxDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OutboxDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	outboxDeliveryUpdateCacheMut.RLock()
+	cache, cached := outboxDeliveryUpdateCache[key]
+	outboxDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxDeliveryAllColumns,
+			outboxDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_schema: unable to update outbox_delivery, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `outbox_delivery` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, outboxDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, append(wl, outboxDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update outbox_delivery row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by update for outbox_delivery")
+	}
+
+	if !cached {
+		outboxDeliveryUpdateCacheMut.Lock()
+		outboxDeliveryUpdateCache[key] = cache
+		outboxDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all for outbox_delivery")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected for outbox_delivery")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `outbox_delivery` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all in outboxDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected all in update all outboxDelivery")
+	}
+	return rowsAff, nil
+}
+
+var mySQLOutboxDeliveryUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no outbox_delivery provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxDeliveryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLOutboxDeliveryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxDeliveryUpsertCacheMut.RLock()
+	cache, cached := outboxDeliveryUpsertCache[key]
+	outboxDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			outboxDeliveryAllColumns,
+			outboxDeliveryColumnsWithDefault,
+			outboxDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxDeliveryAllColumns,
+			outboxDeliveryPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models_schema: unable to upsert outbox_delivery, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`outbox_delivery`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `outbox_delivery` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to upsert for outbox_delivery")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(outboxDeliveryType, outboxDeliveryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to retrieve unique values for outbox_delivery")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for outbox_delivery")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxDeliveryUpsertCacheMut.Lock()
+		outboxDeliveryUpsertCache[key] = cache
+		outboxDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OutboxDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_schema: no OutboxDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM `outbox_delivery` WHERE `outbox_id`=? AND `sink`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete from outbox_delivery")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by delete for outbox_delivery")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_schema: no outboxDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from outbox_delivery")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for outbox_delivery")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(outboxDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `outbox_delivery` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from outboxDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for outbox_delivery")
+	}
+
+	if len(outboxDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOutboxDelivery(ctx, exec, o.OutboxID, o.Sink)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `outbox_delivery`.* FROM `outbox_delivery` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to reload all in OutboxDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxDeliveryExists checks if the OutboxDelivery row exists.
+func OutboxDeliveryExists(ctx context.Context, exec boil.ContextExecutor, outboxID int, sink string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `outbox_delivery` where `outbox_id`=? AND `sink`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, outboxID, sink)
+	}
+	row := exec.QueryRowContext(ctx, sql, outboxID, sink)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: unable to check if outbox_delivery exists")
+	}
+
+	return exists, nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,123 +24,96 @@ import (
 
 // Token is an object representing the database table.
 type Token struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Key       string    `boil:"key" json:"key" toml:"key" yaml:"key"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	Revoked   bool      `boil:"revoked" json:"revoked" toml:"revoked" yaml:"revoked"`
-	Expired   bool      `boil:"expired" json:"expired" toml:"expired" yaml:"expired"`
-	CreatedBy int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	KeyHash        string      `boil:"key_hash" json:"key_hash" toml:"key_hash" yaml:"key_hash"`
+	KeyPrefix      string      `boil:"key_prefix" json:"key_prefix" toml:"key_prefix" yaml:"key_prefix"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Revoked        bool        `boil:"revoked" json:"revoked" toml:"revoked" yaml:"revoked"`
+	Expired        bool        `boil:"expired" json:"expired" toml:"expired" yaml:"expired"`
+	CreatedBy      int         `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	ExpiresAt      time.Time   `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	MaxUses        null.Int    `boil:"max_uses" json:"max_uses,omitempty" toml:"max_uses" yaml:"max_uses,omitempty"`
+	UseCount       int         `boil:"use_count" json:"use_count" toml:"use_count" yaml:"use_count"`
+	Label          null.String `boil:"label" json:"label,omitempty" toml:"label" yaml:"label,omitempty"`
+	Note           null.String `boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
+	RecipientEmail null.String `boil:"recipient_email" json:"recipient_email,omitempty" toml:"recipient_email" yaml:"recipient_email,omitempty"`
+	NotBefore      null.Time   `boil:"not_before" json:"not_before,omitempty" toml:"not_before" yaml:"not_before,omitempty"`
+	CampaignID     null.Int    `boil:"campaign_id" json:"campaign_id,omitempty" toml:"campaign_id" yaml:"campaign_id,omitempty"`
 
 	R *tokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TokenColumns = struct {
-	ID        string
-	Key       string
-	CreatedAt string
-	Revoked   string
-	Expired   string
-	CreatedBy string
-	ExpiresAt string
+	ID             string
+	KeyHash        string
+	KeyPrefix      string
+	CreatedAt      string
+	Revoked        string
+	Expired        string
+	CreatedBy      string
+	ExpiresAt      string
+	MaxUses        string
+	UseCount       string
+	Label          string
+	Note           string
+	RecipientEmail string
+	NotBefore      string
+	CampaignID     string
 }{
-	ID:        "id",
-	Key:       "key",
-	CreatedAt: "created_at",
-	Revoked:   "revoked",
-	Expired:   "expired",
-	CreatedBy: "created_by",
-	ExpiresAt: "expires_at",
+	ID:             "id",
+	KeyHash:        "key_hash",
+	KeyPrefix:      "key_prefix",
+	CreatedAt:      "created_at",
+	Revoked:        "revoked",
+	Expired:        "expired",
+	CreatedBy:      "created_by",
+	ExpiresAt:      "expires_at",
+	MaxUses:        "max_uses",
+	UseCount:       "use_count",
+	Label:          "label",
+	Note:           "note",
+	RecipientEmail: "recipient_email",
+	NotBefore:      "not_before",
+	CampaignID:     "campaign_id",
 }
 
 var TokenTableColumns = struct {
-	ID        string
-	Key       string
-	CreatedAt string
-	Revoked   string
-	Expired   string
-	CreatedBy string
-	ExpiresAt string
+	ID             string
+	KeyHash        string
+	KeyPrefix      string
+	CreatedAt      string
+	Revoked        string
+	Expired        string
+	CreatedBy      string
+	ExpiresAt      string
+	MaxUses        string
+	UseCount       string
+	Label          string
+	Note           string
+	RecipientEmail string
+	NotBefore      string
+	CampaignID     string
 }{
-	ID:        "token.id",
-	Key:       "token.key",
-	CreatedAt: "token.created_at",
-	Revoked:   "token.revoked",
-	Expired:   "token.expired",
-	CreatedBy: "token.created_by",
-	ExpiresAt: "token.expires_at",
+	ID:             "token.id",
+	KeyHash:        "token.key_hash",
+	KeyPrefix:      "token.key_prefix",
+	CreatedAt:      "token.created_at",
+	Revoked:        "token.revoked",
+	Expired:        "token.expired",
+	CreatedBy:      "token.created_by",
+	ExpiresAt:      "token.expires_at",
+	MaxUses:        "token.max_uses",
+	UseCount:       "token.use_count",
+	Label:          "token.label",
+	Note:           "token.note",
+	RecipientEmail: "token.recipient_email",
+	NotBefore:      "token.not_before",
+	CampaignID:     "token.campaign_id",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelperbool struct{ field string }
 
 func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -150,33 +124,58 @@ func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var TokenWhere = struct {
-	ID        whereHelperint
-	Key       whereHelperstring
-	CreatedAt whereHelpertime_Time
-	Revoked   whereHelperbool
-	Expired   whereHelperbool
-	CreatedBy whereHelperint
-	ExpiresAt whereHelpertime_Time
+	ID             whereHelperint
+	KeyHash        whereHelperstring
+	KeyPrefix      whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	Revoked        whereHelperbool
+	Expired        whereHelperbool
+	CreatedBy      whereHelperint
+	ExpiresAt      whereHelpertime_Time
+	MaxUses        whereHelpernull_Int
+	UseCount       whereHelperint
+	Label          whereHelpernull_String
+	Note           whereHelpernull_String
+	RecipientEmail whereHelpernull_String
+	NotBefore      whereHelpernull_Time
+	CampaignID     whereHelpernull_Int
 }{
-	ID:        whereHelperint{field: "`token`.`id`"},
-	Key:       whereHelperstring{field: "`token`.`key`"},
-	CreatedAt: whereHelpertime_Time{field: "`token`.`created_at`"},
-	Revoked:   whereHelperbool{field: "`token`.`revoked`"},
-	Expired:   whereHelperbool{field: "`token`.`expired`"},
-	CreatedBy: whereHelperint{field: "`token`.`created_by`"},
-	ExpiresAt: whereHelpertime_Time{field: "`token`.`expires_at`"},
+	ID:             whereHelperint{field: "`token`.`id`"},
+	KeyHash:        whereHelperstring{field: "`token`.`key_hash`"},
+	KeyPrefix:      whereHelperstring{field: "`token`.`key_prefix`"},
+	CreatedAt:      whereHelpertime_Time{field: "`token`.`created_at`"},
+	Revoked:        whereHelperbool{field: "`token`.`revoked`"},
+	Expired:        whereHelperbool{field: "`token`.`expired`"},
+	CreatedBy:      whereHelperint{field: "`token`.`created_by`"},
+	ExpiresAt:      whereHelpertime_Time{field: "`token`.`expires_at`"},
+	MaxUses:        whereHelpernull_Int{field: "`token`.`max_uses`"},
+	UseCount:       whereHelperint{field: "`token`.`use_count`"},
+	Label:          whereHelpernull_String{field: "`token`.`label`"},
+	Note:           whereHelpernull_String{field: "`token`.`note`"},
+	RecipientEmail: whereHelpernull_String{field: "`token`.`recipient_email`"},
+	NotBefore:      whereHelpernull_Time{field: "`token`.`not_before`"},
+	CampaignID:     whereHelpernull_Int{field: "`token`.`campaign_id`"},
 }
 
 // TokenRels is where relationship names are stored.
 var TokenRels = struct {
+	Campaign      string
 	CreatedByUser string
+	TokenEvents   string
+	TokenScopes   string
 }{
+	Campaign:      "Campaign",
 	CreatedByUser: "CreatedByUser",
+	TokenEvents:   "TokenEvents",
+	TokenScopes:   "TokenScopes",
 }
 
 // tokenR is where relationships are stored.
 type tokenR struct {
-	CreatedByUser *User `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	Campaign      *Campaign       `boil:"Campaign" json:"Campaign" toml:"Campaign" yaml:"Campaign"`
+	CreatedByUser *User           `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	TokenEvents   TokenEventSlice `boil:"TokenEvents" json:"TokenEvents" toml:"TokenEvents" yaml:"TokenEvents"`
+	TokenScopes   TokenScopeSlice `boil:"TokenScopes" json:"TokenScopes" toml:"TokenScopes" yaml:"TokenScopes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -184,6 +183,13 @@ func (*tokenR) NewStruct() *tokenR {
 	return &tokenR{}
 }
 
+func (r *tokenR) GetCampaign() *Campaign {
+	if r == nil {
+		return nil
+	}
+	return r.Campaign
+}
+
 func (r *tokenR) GetCreatedByUser() *User {
 	if r == nil {
 		return nil
@@ -191,13 +197,27 @@ func (r *tokenR) GetCreatedByUser() *User {
 	return r.CreatedByUser
 }
 
+func (r *tokenR) GetTokenEvents() TokenEventSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TokenEvents
+}
+
+func (r *tokenR) GetTokenScopes() TokenScopeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TokenScopes
+}
+
 // tokenL is where Load methods for each relationship are stored.
 type tokenL struct{}
 
 var (
-	tokenAllColumns            = []string{"id", "key", "created_at", "revoked", "expired", "created_by", "expires_at"}
-	tokenColumnsWithoutDefault = []string{"key", "created_by", "expires_at"}
-	tokenColumnsWithDefault    = []string{"id", "created_at", "revoked", "expired"}
+	tokenAllColumns            = []string{"id", "key_hash", "key_prefix", "created_at", "revoked", "expired", "created_by", "expires_at", "max_uses", "use_count", "label", "note", "recipient_email", "not_before", "campaign_id"}
+	tokenColumnsWithoutDefault = []string{"key_hash", "key_prefix", "created_by", "expires_at", "max_uses", "label", "note", "recipient_email", "not_before", "campaign_id"}
+	tokenColumnsWithDefault    = []string{"id", "created_at", "revoked", "expired", "use_count"}
 	tokenPrimaryKeyColumns     = []string{"id"}
 	tokenGeneratedColumns      = []string{}
 )
@@ -480,6 +500,17 @@ func (q tokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// Campaign pointed to by the foreign key.
+func (o *Token) Campaign(mods ...qm.QueryMod) campaignQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.CampaignID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Campaigns(queryMods...)
+}
+
 // CreatedByUser pointed to by the foreign key.
 func (o *Token) CreatedByUser(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -491,6 +522,142 @@ func (o *Token) CreatedByUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// TokenEvents retrieves all the token_event's TokenEvents with an executor.
+func (o *Token) TokenEvents(mods ...qm.QueryMod) tokenEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`token_event`.`token_id`=?", o.ID),
+	)
+
+	return TokenEvents(queryMods...)
+}
+
+// TokenScopes retrieves all the token_scope's TokenScopes with an executor.
+func (o *Token) TokenScopes(mods ...qm.QueryMod) tokenScopeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`token_scope`.`token_id`=?", o.ID),
+	)
+
+	return TokenScopes(queryMods...)
+}
+
+// LoadCampaign allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tokenL) LoadCampaign(ctx context.Context, e boil.ContextExecutor, singular bool, maybeToken interface{}, mods queries.Applicator) error {
+	var slice []*Token
+	var object *Token
+
+	if singular {
+		object = maybeToken.(*Token)
+	} else {
+		slice = *maybeToken.(*[]*Token)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &tokenR{}
+		}
+		if !queries.IsNil(object.CampaignID) {
+			args = append(args, object.CampaignID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CampaignID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CampaignID) {
+				args = append(args, obj.CampaignID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`campaign`),
+		qm.WhereIn(`campaign.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Campaign")
+	}
+
+	var resultSlice []*Campaign
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Campaign")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for campaign")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for campaign")
+	}
+
+	if len(tokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Campaign = foreign
+		if foreign.R == nil {
+			foreign.R = &campaignR{}
+		}
+		foreign.R.Tokens = append(foreign.R.Tokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CampaignID, foreign.ID) {
+				local.R.Campaign = foreign
+				if foreign.R == nil {
+					foreign.R = &campaignR{}
+				}
+				foreign.R.Tokens = append(foreign.R.Tokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCreatedByUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (tokenL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeToken interface{}, mods queries.Applicator) error {
@@ -595,6 +762,282 @@ func (tokenL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// LoadTokenEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tokenL) LoadTokenEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeToken interface{}, mods queries.Applicator) error {
+	var slice []*Token
+	var object *Token
+
+	if singular {
+		object = maybeToken.(*Token)
+	} else {
+		slice = *maybeToken.(*[]*Token)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &tokenR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`token_event`),
+		qm.WhereIn(`token_event.token_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load token_event")
+	}
+
+	var resultSlice []*TokenEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice token_event")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on token_event")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for token_event")
+	}
+
+	if len(tokenEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TokenEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tokenEventR{}
+			}
+			foreign.R.Token = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TokenID) {
+				local.R.TokenEvents = append(local.R.TokenEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &tokenEventR{}
+				}
+				foreign.R.Token = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTokenScopes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tokenL) LoadTokenScopes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeToken interface{}, mods queries.Applicator) error {
+	var slice []*Token
+	var object *Token
+
+	if singular {
+		object = maybeToken.(*Token)
+	} else {
+		slice = *maybeToken.(*[]*Token)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &tokenR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`token_scope`),
+		qm.WhereIn(`token_scope.token_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load token_scope")
+	}
+
+	var resultSlice []*TokenScope
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice token_scope")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on token_scope")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for token_scope")
+	}
+
+	if len(tokenScopeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TokenScopes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tokenScopeR{}
+			}
+			foreign.R.Token = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TokenID {
+				local.R.TokenScopes = append(local.R.TokenScopes, foreign)
+				if foreign.R == nil {
+					foreign.R = &tokenScopeR{}
+				}
+				foreign.R.Token = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampaign of the token to the related item.
+// Sets o.R.Campaign to related.
+// Adds o to related.R.Tokens.
+func (o *Token) SetCampaign(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Campaign) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `token` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campaign_id"}),
+		strmangle.WhereClause("`", "`", 0, tokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CampaignID, related.ID)
+	if o.R == nil {
+		o.R = &tokenR{
+			Campaign: related,
+		}
+	} else {
+		o.R.Campaign = related
+	}
+
+	if related.R == nil {
+		related.R = &campaignR{
+			Tokens: TokenSlice{o},
+		}
+	} else {
+		related.R.Tokens = append(related.R.Tokens, o)
+	}
+
+	return nil
+}
+
+// RemoveCampaign relationship.
+// Sets o.R.Campaign to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Token) RemoveCampaign(ctx context.Context, exec boil.ContextExecutor, related *Campaign) error {
+	var err error
+
+	queries.SetScanner(&o.CampaignID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("campaign_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Campaign = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Tokens {
+		if queries.Equal(o.CampaignID, ri.CampaignID) {
+			continue
+		}
+
+		ln := len(related.R.Tokens)
+		if ln > 1 && i < ln-1 {
+			related.R.Tokens[i] = related.R.Tokens[ln-1]
+		}
+		related.R.Tokens = related.R.Tokens[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetCreatedByUser of the token to the related item.
 // Sets o.R.CreatedByUser to related.
 // Adds o to related.R.CreatedByTokens.
@@ -642,6 +1085,186 @@ func (o *Token) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddTokenEvents adds the given related objects to the existing relationships
+// of the token, optionally inserting them as new records.
+// Appends related to o.R.TokenEvents.
+// Sets related.R.Token appropriately.
+func (o *Token) AddTokenEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TokenEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TokenID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `token_event` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"token_id"}),
+				strmangle.WhereClause("`", "`", 0, tokenEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TokenID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tokenR{
+			TokenEvents: related,
+		}
+	} else {
+		o.R.TokenEvents = append(o.R.TokenEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tokenEventR{
+				Token: o,
+			}
+		} else {
+			rel.R.Token = o
+		}
+	}
+	return nil
+}
+
+// SetTokenEvents removes all previously related items of the
+// token replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Token's TokenEvents accordingly.
+// Replaces o.R.TokenEvents with related.
+// Sets related.R.Token's TokenEvents accordingly.
+func (o *Token) SetTokenEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TokenEvent) error {
+	query := "update `token_event` set `token_id` = null where `token_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TokenEvents {
+			queries.SetScanner(&rel.TokenID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Token = nil
+		}
+		o.R.TokenEvents = nil
+	}
+
+	return o.AddTokenEvents(ctx, exec, insert, related...)
+}
+
+// RemoveTokenEvents relationships from objects passed in.
+// Removes related items from R.TokenEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Token.
+func (o *Token) RemoveTokenEvents(ctx context.Context, exec boil.ContextExecutor, related ...*TokenEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TokenID, nil)
+		if rel.R != nil {
+			rel.R.Token = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("token_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TokenEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TokenEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.TokenEvents[i] = o.R.TokenEvents[ln-1]
+			}
+			o.R.TokenEvents = o.R.TokenEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTokenScopes adds the given related objects to the existing relationships
+// of the token, optionally inserting them as new records.
+// Appends related to o.R.TokenScopes.
+// Sets related.R.Token appropriately.
+func (o *Token) AddTokenScopes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TokenScope) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TokenID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `token_scope` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"token_id"}),
+				strmangle.WhereClause("`", "`", 0, tokenScopePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TokenID, rel.Scope}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TokenID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tokenR{
+			TokenScopes: related,
+		}
+	} else {
+		o.R.TokenScopes = append(o.R.TokenScopes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tokenScopeR{
+				Token: o,
+			}
+		} else {
+			rel.R.Token = o
+		}
+	}
+	return nil
+}
+
 // Tokens retrieves all the records using an executor.
 func Tokens(mods ...qm.QueryMod) tokenQuery {
 	mods = append(mods, qm.From("`token`"))
@@ -926,7 +1549,7 @@ func (o TokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, co
 
 var mySQLTokenUniqueColumns = []string{
 	"id",
-	"key",
+	"key_hash",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
@@ -0,0 +1,1217 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TokenEvent is an object representing the database table.
+type TokenEvent struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TokenID   null.Int  `boil:"token_id" json:"token_id,omitempty" toml:"token_id" yaml:"token_id,omitempty"`
+	Action    string    `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Outcome   string    `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
+	IP        string    `boil:"ip" json:"ip" toml:"ip" yaml:"ip"`
+	UserAgent string    `boil:"user_agent" json:"user_agent" toml:"user_agent" yaml:"user_agent"`
+	RequestID string    `boil:"request_id" json:"request_id" toml:"request_id" yaml:"request_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *tokenEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tokenEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TokenEventColumns = struct {
+	ID        string
+	TokenID   string
+	Action    string
+	Outcome   string
+	IP        string
+	UserAgent string
+	RequestID string
+	CreatedAt string
+}{
+	ID:        "id",
+	TokenID:   "token_id",
+	Action:    "action",
+	Outcome:   "outcome",
+	IP:        "ip",
+	UserAgent: "user_agent",
+	RequestID: "request_id",
+	CreatedAt: "created_at",
+}
+
+var TokenEventTableColumns = struct {
+	ID        string
+	TokenID   string
+	Action    string
+	Outcome   string
+	IP        string
+	UserAgent string
+	RequestID string
+	CreatedAt string
+}{
+	ID:        "token_event.id",
+	TokenID:   "token_event.token_id",
+	Action:    "token_event.action",
+	Outcome:   "token_event.outcome",
+	IP:        "token_event.ip",
+	UserAgent: "token_event.user_agent",
+	RequestID: "token_event.request_id",
+	CreatedAt: "token_event.created_at",
+}
+
+// Generated where
+
+var TokenEventWhere = struct {
+	ID        whereHelperint
+	TokenID   whereHelpernull_Int
+	Action    whereHelperstring
+	Outcome   whereHelperstring
+	IP        whereHelperstring
+	UserAgent whereHelperstring
+	RequestID whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`token_event`.`id`"},
+	TokenID:   whereHelpernull_Int{field: "`token_event`.`token_id`"},
+	Action:    whereHelperstring{field: "`token_event`.`action`"},
+	Outcome:   whereHelperstring{field: "`token_event`.`outcome`"},
+	IP:        whereHelperstring{field: "`token_event`.`ip`"},
+	UserAgent: whereHelperstring{field: "`token_event`.`user_agent`"},
+	RequestID: whereHelperstring{field: "`token_event`.`request_id`"},
+	CreatedAt: whereHelpertime_Time{field: "`token_event`.`created_at`"},
+}
+
+// TokenEventRels is where relationship names are stored.
+var TokenEventRels = struct {
+	Token string
+}{
+	Token: "Token",
+}
+
+// tokenEventR is where relationships are stored.
+type tokenEventR struct {
+	Token *Token `boil:"Token" json:"Token" toml:"Token" yaml:"Token"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tokenEventR) NewStruct() *tokenEventR {
+	return &tokenEventR{}
+}
+
+func (r *tokenEventR) GetToken() *Token {
+	if r == nil {
+		return nil
+	}
+	return r.Token
+}
+
+// tokenEventL is where Load methods for each relationship are stored.
+type tokenEventL struct{}
+
+var (
+	tokenEventAllColumns            = []string{"id", "token_id", "action", "outcome", "ip", "user_agent", "request_id", "created_at"}
+	tokenEventColumnsWithoutDefault = []string{"token_id", "action", "outcome", "ip", "user_agent", "request_id"}
+	tokenEventColumnsWithDefault    = []string{"id", "created_at"}
+	tokenEventPrimaryKeyColumns     = []string{"id"}
+	tokenEventGeneratedColumns      = []string{}
+)
+
+type (
+	// TokenEventSlice is an alias for a slice of pointers to TokenEvent.
+	// This should almost always be used instead of []TokenEvent.
+	TokenEventSlice []*TokenEvent
+	// TokenEventHook is the signature for custom TokenEvent hook methods
+	TokenEventHook func(context.Context, boil.ContextExecutor, *TokenEvent) error
+
+	tokenEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tokenEventType                 = reflect.TypeOf(&TokenEvent{})
+	tokenEventMapping              = queries.MakeStructMapping(tokenEventType)
+	tokenEventPrimaryKeyMapping, _ = queries.BindMapping(tokenEventType, tokenEventMapping, tokenEventPrimaryKeyColumns)
+	tokenEventInsertCacheMut       sync.RWMutex
+	tokenEventInsertCache          = make(map[string]insertCache)
+	tokenEventUpdateCacheMut       sync.RWMutex
+	tokenEventUpdateCache          = make(map[string]updateCache)
+	tokenEventUpsertCacheMut       sync.RWMutex
+	tokenEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tokenEventAfterSelectHooks []TokenEventHook
+
+var tokenEventBeforeInsertHooks []TokenEventHook
+var tokenEventAfterInsertHooks []TokenEventHook
+
+var tokenEventBeforeUpdateHooks []TokenEventHook
+var tokenEventAfterUpdateHooks []TokenEventHook
+
+var tokenEventBeforeDeleteHooks []TokenEventHook
+var tokenEventAfterDeleteHooks []TokenEventHook
+
+var tokenEventBeforeUpsertHooks []TokenEventHook
+var tokenEventAfterUpsertHooks []TokenEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TokenEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TokenEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TokenEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TokenEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TokenEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TokenEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TokenEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TokenEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TokenEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTokenEventHook registers your hook function for all future operations.
+func AddTokenEventHook(hookPoint boil.HookPoint, tokenEventHook TokenEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tokenEventAfterSelectHooks = append(tokenEventAfterSelectHooks, tokenEventHook)
+	case boil.BeforeInsertHook:
+		tokenEventBeforeInsertHooks = append(tokenEventBeforeInsertHooks, tokenEventHook)
+	case boil.AfterInsertHook:
+		tokenEventAfterInsertHooks = append(tokenEventAfterInsertHooks, tokenEventHook)
+	case boil.BeforeUpdateHook:
+		tokenEventBeforeUpdateHooks = append(tokenEventBeforeUpdateHooks, tokenEventHook)
+	case boil.AfterUpdateHook:
+		tokenEventAfterUpdateHooks = append(tokenEventAfterUpdateHooks, tokenEventHook)
+	case boil.BeforeDeleteHook:
+		tokenEventBeforeDeleteHooks = append(tokenEventBeforeDeleteHooks, tokenEventHook)
+	case boil.AfterDeleteHook:
+		tokenEventAfterDeleteHooks = append(tokenEventAfterDeleteHooks, tokenEventHook)
+	case boil.BeforeUpsertHook:
+		tokenEventBeforeUpsertHooks = append(tokenEventBeforeUpsertHooks, tokenEventHook)
+	case boil.AfterUpsertHook:
+		tokenEventAfterUpsertHooks = append(tokenEventAfterUpsertHooks, tokenEventHook)
+	}
+}
+
+// One returns a single tokenEvent record from the query.
+func (q tokenEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TokenEvent, error) {
+	o := &TokenEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: failed to execute a one query for token_event")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TokenEvent records from the query.
+func (q tokenEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (TokenEventSlice, error) {
+	var o []*TokenEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_schema: failed to assign all query results to TokenEvent slice")
+	}
+
+	if len(tokenEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TokenEvent records in the query.
+func (q tokenEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to count token_event rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tokenEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: failed to check if token_event exists")
+	}
+
+	return count > 0, nil
+}
+
+// Token pointed to by the foreign key.
+func (o *TokenEvent) Token(mods ...qm.QueryMod) tokenQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TokenID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tokens(queryMods...)
+}
+
+// LoadToken allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tokenEventL) LoadToken(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTokenEvent interface{}, mods queries.Applicator) error {
+	var slice []*TokenEvent
+	var object *TokenEvent
+
+	if singular {
+		object = maybeTokenEvent.(*TokenEvent)
+	} else {
+		slice = *maybeTokenEvent.(*[]*TokenEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &tokenEventR{}
+		}
+		if !queries.IsNil(object.TokenID) {
+			args = append(args, object.TokenID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenEventR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.TokenID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.TokenID) {
+				args = append(args, obj.TokenID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`token`),
+		qm.WhereIn(`token.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Token")
+	}
+
+	var resultSlice []*Token
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Token")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for token")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for token")
+	}
+
+	if len(tokenEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Token = foreign
+		if foreign.R == nil {
+			foreign.R = &tokenR{}
+		}
+		foreign.R.TokenEvents = append(foreign.R.TokenEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TokenID, foreign.ID) {
+				local.R.Token = foreign
+				if foreign.R == nil {
+					foreign.R = &tokenR{}
+				}
+				foreign.R.TokenEvents = append(foreign.R.TokenEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetToken of the tokenEvent to the related item.
+// Sets o.R.Token to related.
+// Adds o to related.R.TokenEvents.
+func (o *TokenEvent) SetToken(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Token) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `token_event` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"token_id"}),
+		strmangle.WhereClause("`", "`", 0, tokenEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TokenID, related.ID)
+	if o.R == nil {
+		o.R = &tokenEventR{
+			Token: related,
+		}
+	} else {
+		o.R.Token = related
+	}
+
+	if related.R == nil {
+		related.R = &tokenR{
+			TokenEvents: TokenEventSlice{o},
+		}
+	} else {
+		related.R.TokenEvents = append(related.R.TokenEvents, o)
+	}
+
+	return nil
+}
+
+// RemoveToken relationship.
+// Sets o.R.Token to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TokenEvent) RemoveToken(ctx context.Context, exec boil.ContextExecutor, related *Token) error {
+	var err error
+
+	queries.SetScanner(&o.TokenID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("token_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Token = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TokenEvents {
+		if queries.Equal(o.TokenID, ri.TokenID) {
+			continue
+		}
+
+		ln := len(related.R.TokenEvents)
+		if ln > 1 && i < ln-1 {
+			related.R.TokenEvents[i] = related.R.TokenEvents[ln-1]
+		}
+		related.R.TokenEvents = related.R.TokenEvents[:ln-1]
+		break
+	}
+	return nil
+}
+
+// TokenEvents retrieves all the records using an executor.
+func TokenEvents(mods ...qm.QueryMod) tokenEventQuery {
+	mods = append(mods, qm.From("`token_event`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`token_event`.*"})
+	}
+
+	return tokenEventQuery{q}
+}
+
+// FindTokenEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTokenEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TokenEvent, error) {
+	tokenEventObj := &TokenEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `token_event` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tokenEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: unable to select from token_event")
+	}
+
+	if err = tokenEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tokenEventObj, err
+	}
+
+	return tokenEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TokenEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no token_event provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tokenEventInsertCacheMut.RLock()
+	cache, cached := tokenEventInsertCache[key]
+	tokenEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tokenEventAllColumns,
+			tokenEventColumnsWithDefault,
+			tokenEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenEventType, tokenEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tokenEventType, tokenEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `token_event` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `token_event` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `token_event` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tokenEventPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to insert into token_event")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tokenEventMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for token_event")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tokenEventInsertCacheMut.Lock()
+		tokenEventInsertCache[key] = cache
+		tokenEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TokenEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TokenEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tokenEventUpdateCacheMut.RLock()
+	cache, cached := tokenEventUpdateCache[key]
+	tokenEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tokenEventAllColumns,
+			tokenEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_schema: unable to update token_event, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `token_event` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tokenEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tokenEventType, tokenEventMapping, append(wl, tokenEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update token_event row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by update for token_event")
+	}
+
+	if !cached {
+		tokenEventUpdateCacheMut.Lock()
+		tokenEventUpdateCache[key] = cache
+		tokenEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tokenEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all for token_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected for token_event")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TokenEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `token_event` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all in tokenEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected all in update all tokenEvent")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTokenEventUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TokenEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no token_event provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenEventColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTokenEventUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tokenEventUpsertCacheMut.RLock()
+	cache, cached := tokenEventUpsertCache[key]
+	tokenEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			tokenEventAllColumns,
+			tokenEventColumnsWithDefault,
+			tokenEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tokenEventAllColumns,
+			tokenEventPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models_schema: unable to upsert token_event, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`token_event`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `token_event` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenEventType, tokenEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tokenEventType, tokenEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to upsert for token_event")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tokenEventMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tokenEventType, tokenEventMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to retrieve unique values for token_event")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for token_event")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tokenEventUpsertCacheMut.Lock()
+		tokenEventUpsertCache[key] = cache
+		tokenEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TokenEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TokenEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_schema: no TokenEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tokenEventPrimaryKeyMapping)
+	sql := "DELETE FROM `token_event` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete from token_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by delete for token_event")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tokenEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_schema: no tokenEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from token_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for token_event")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TokenEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tokenEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `token_event` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from tokenEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for token_event")
+	}
+
+	if len(tokenEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TokenEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTokenEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TokenEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TokenEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `token_event`.* FROM `token_event` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to reload all in TokenEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TokenEventExists checks if the TokenEvent row exists.
+func TokenEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `token_event` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: unable to check if token_event exists")
+	}
+
+	return exists, nil
+}
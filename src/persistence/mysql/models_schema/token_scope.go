@@ -0,0 +1,1100 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TokenScope is an object representing the database table.
+type TokenScope struct {
+	TokenID int    `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	Scope   string `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+
+	R *tokenScopeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tokenScopeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TokenScopeColumns = struct {
+	TokenID string
+	Scope   string
+}{
+	TokenID: "token_id",
+	Scope:   "scope",
+}
+
+var TokenScopeTableColumns = struct {
+	TokenID string
+	Scope   string
+}{
+	TokenID: "token_scope.token_id",
+	Scope:   "token_scope.scope",
+}
+
+// Generated where
+
+var TokenScopeWhere = struct {
+	TokenID whereHelperint
+	Scope   whereHelperstring
+}{
+	TokenID: whereHelperint{field: "`token_scope`.`token_id`"},
+	Scope:   whereHelperstring{field: "`token_scope`.`scope`"},
+}
+
+// TokenScopeRels is where relationship names are stored.
+var TokenScopeRels = struct {
+	Token string
+}{
+	Token: "Token",
+}
+
+// tokenScopeR is where relationships are stored.
+type tokenScopeR struct {
+	Token *Token `boil:"Token" json:"Token" toml:"Token" yaml:"Token"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tokenScopeR) NewStruct() *tokenScopeR {
+	return &tokenScopeR{}
+}
+
+func (r *tokenScopeR) GetToken() *Token {
+	if r == nil {
+		return nil
+	}
+	return r.Token
+}
+
+// tokenScopeL is where Load methods for each relationship are stored.
+type tokenScopeL struct{}
+
+var (
+	tokenScopeAllColumns            = []string{"token_id", "scope"}
+	tokenScopeColumnsWithoutDefault = []string{"token_id", "scope"}
+	tokenScopeColumnsWithDefault    = []string{}
+	tokenScopePrimaryKeyColumns     = []string{"token_id", "scope"}
+	tokenScopeGeneratedColumns      = []string{}
+)
+
+type (
+	// TokenScopeSlice is an alias for a slice of pointers to TokenScope.
+	// This should almost always be used instead of []TokenScope.
+	TokenScopeSlice []*TokenScope
+	// TokenScopeHook is the signature for custom TokenScope hook methods
+	TokenScopeHook func(context.Context, boil.ContextExecutor, *TokenScope) error
+
+	tokenScopeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tokenScopeType                 = reflect.TypeOf(&TokenScope{})
+	tokenScopeMapping              = queries.MakeStructMapping(tokenScopeType)
+	tokenScopePrimaryKeyMapping, _ = queries.BindMapping(tokenScopeType, tokenScopeMapping, tokenScopePrimaryKeyColumns)
+	tokenScopeInsertCacheMut       sync.RWMutex
+	tokenScopeInsertCache          = make(map[string]insertCache)
+	tokenScopeUpdateCacheMut       sync.RWMutex
+	tokenScopeUpdateCache          = make(map[string]updateCache)
+	tokenScopeUpsertCacheMut       sync.RWMutex
+	tokenScopeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tokenScopeAfterSelectHooks []TokenScopeHook
+
+var tokenScopeBeforeInsertHooks []TokenScopeHook
+var tokenScopeAfterInsertHooks []TokenScopeHook
+
+var tokenScopeBeforeUpdateHooks []TokenScopeHook
+var tokenScopeAfterUpdateHooks []TokenScopeHook
+
+var tokenScopeBeforeDeleteHooks []TokenScopeHook
+var tokenScopeAfterDeleteHooks []TokenScopeHook
+
+var tokenScopeBeforeUpsertHooks []TokenScopeHook
+var tokenScopeAfterUpsertHooks []TokenScopeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TokenScope) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TokenScope) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TokenScope) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TokenScope) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TokenScope) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TokenScope) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TokenScope) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TokenScope) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TokenScope) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenScopeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTokenScopeHook registers your hook function for all future operations.
+func AddTokenScopeHook(hookPoint boil.HookPoint, tokenScopeHook TokenScopeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tokenScopeAfterSelectHooks = append(tokenScopeAfterSelectHooks, tokenScopeHook)
+	case boil.BeforeInsertHook:
+		tokenScopeBeforeInsertHooks = append(tokenScopeBeforeInsertHooks, tokenScopeHook)
+	case boil.AfterInsertHook:
+		tokenScopeAfterInsertHooks = append(tokenScopeAfterInsertHooks, tokenScopeHook)
+	case boil.BeforeUpdateHook:
+		tokenScopeBeforeUpdateHooks = append(tokenScopeBeforeUpdateHooks, tokenScopeHook)
+	case boil.AfterUpdateHook:
+		tokenScopeAfterUpdateHooks = append(tokenScopeAfterUpdateHooks, tokenScopeHook)
+	case boil.BeforeDeleteHook:
+		tokenScopeBeforeDeleteHooks = append(tokenScopeBeforeDeleteHooks, tokenScopeHook)
+	case boil.AfterDeleteHook:
+		tokenScopeAfterDeleteHooks = append(tokenScopeAfterDeleteHooks, tokenScopeHook)
+	case boil.BeforeUpsertHook:
+		tokenScopeBeforeUpsertHooks = append(tokenScopeBeforeUpsertHooks, tokenScopeHook)
+	case boil.AfterUpsertHook:
+		tokenScopeAfterUpsertHooks = append(tokenScopeAfterUpsertHooks, tokenScopeHook)
+	}
+}
+
+// One returns a single tokenScope record from the query.
+func (q tokenScopeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TokenScope, error) {
+	o := &TokenScope{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: failed to execute a one query for token_scope")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TokenScope records from the query.
+func (q tokenScopeQuery) All(ctx context.Context, exec boil.ContextExecutor) (TokenScopeSlice, error) {
+	var o []*TokenScope
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_schema: failed to assign all query results to TokenScope slice")
+	}
+
+	if len(tokenScopeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TokenScope records in the query.
+func (q tokenScopeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to count token_scope rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tokenScopeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: failed to check if token_scope exists")
+	}
+
+	return count > 0, nil
+}
+
+// Token pointed to by the foreign key.
+func (o *TokenScope) Token(mods ...qm.QueryMod) tokenQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TokenID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tokens(queryMods...)
+}
+
+// LoadToken allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tokenScopeL) LoadToken(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTokenScope interface{}, mods queries.Applicator) error {
+	var slice []*TokenScope
+	var object *TokenScope
+
+	if singular {
+		object = maybeTokenScope.(*TokenScope)
+	} else {
+		slice = *maybeTokenScope.(*[]*TokenScope)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &tokenScopeR{}
+		}
+		args = append(args, object.TokenID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenScopeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TokenID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TokenID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`token`),
+		qm.WhereIn(`token.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Token")
+	}
+
+	var resultSlice []*Token
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Token")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for token")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for token")
+	}
+
+	if len(tokenScopeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Token = foreign
+		if foreign.R == nil {
+			foreign.R = &tokenR{}
+		}
+		foreign.R.TokenScopes = append(foreign.R.TokenScopes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TokenID == foreign.ID {
+				local.R.Token = foreign
+				if foreign.R == nil {
+					foreign.R = &tokenR{}
+				}
+				foreign.R.TokenScopes = append(foreign.R.TokenScopes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetToken of the tokenScope to the related item.
+// Sets o.R.Token to related.
+// Adds o to related.R.TokenScopes.
+func (o *TokenScope) SetToken(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Token) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `token_scope` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"token_id"}),
+		strmangle.WhereClause("`", "`", 0, tokenScopePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TokenID, o.Scope}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TokenID = related.ID
+	if o.R == nil {
+		o.R = &tokenScopeR{
+			Token: related,
+		}
+	} else {
+		o.R.Token = related
+	}
+
+	if related.R == nil {
+		related.R = &tokenR{
+			TokenScopes: TokenScopeSlice{o},
+		}
+	} else {
+		related.R.TokenScopes = append(related.R.TokenScopes, o)
+	}
+
+	return nil
+}
+
+// TokenScopes retrieves all the records using an executor.
+func TokenScopes(mods ...qm.QueryMod) tokenScopeQuery {
+	mods = append(mods, qm.From("`token_scope`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`token_scope`.*"})
+	}
+
+	return tokenScopeQuery{q}
+}
+
+// FindTokenScope retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTokenScope(ctx context.Context, exec boil.ContextExecutor, tokenID int, scope string, selectCols ...string) (*TokenScope, error) {
+	tokenScopeObj := &TokenScope{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `token_scope` where `token_id`=? AND `scope`=?", sel,
+	)
+
+	q := queries.Raw(query, tokenID, scope)
+
+	err := q.Bind(ctx, exec, tokenScopeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_schema: unable to select from token_scope")
+	}
+
+	if err = tokenScopeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tokenScopeObj, err
+	}
+
+	return tokenScopeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TokenScope) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no token_scope provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenScopeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tokenScopeInsertCacheMut.RLock()
+	cache, cached := tokenScopeInsertCache[key]
+	tokenScopeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tokenScopeAllColumns,
+			tokenScopeColumnsWithDefault,
+			tokenScopeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `token_scope` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `token_scope` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `token_scope` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tokenScopePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to insert into token_scope")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TokenID,
+		o.Scope,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for token_scope")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tokenScopeInsertCacheMut.Lock()
+		tokenScopeInsertCache[key] = cache
+		tokenScopeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TokenScope.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TokenScope) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tokenScopeUpdateCacheMut.RLock()
+	cache, cached := tokenScopeUpdateCache[key]
+	tokenScopeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tokenScopeAllColumns,
+			tokenScopePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_schema: unable to update token_scope, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `token_scope` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tokenScopePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, append(wl, tokenScopePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update token_scope row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by update for token_scope")
+	}
+
+	if !cached {
+		tokenScopeUpdateCacheMut.Lock()
+		tokenScopeUpdateCache[key] = cache
+		tokenScopeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tokenScopeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all for token_scope")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected for token_scope")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TokenScopeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenScopePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `token_scope` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenScopePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to update all in tokenScope slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to retrieve rows affected all in update all tokenScope")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTokenScopeUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TokenScope) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_schema: no token_scope provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenScopeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTokenScopeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tokenScopeUpsertCacheMut.RLock()
+	cache, cached := tokenScopeUpsertCache[key]
+	tokenScopeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			tokenScopeAllColumns,
+			tokenScopeColumnsWithDefault,
+			tokenScopeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tokenScopeAllColumns,
+			tokenScopePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models_schema: unable to upsert token_scope, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`token_scope`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `token_scope` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to upsert for token_scope")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tokenScopeType, tokenScopeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to retrieve unique values for token_scope")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to populate default values for token_scope")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tokenScopeUpsertCacheMut.Lock()
+		tokenScopeUpsertCache[key] = cache
+		tokenScopeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TokenScope record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TokenScope) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_schema: no TokenScope provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tokenScopePrimaryKeyMapping)
+	sql := "DELETE FROM `token_scope` WHERE `token_id`=? AND `scope`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete from token_scope")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by delete for token_scope")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tokenScopeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_schema: no tokenScopeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from token_scope")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for token_scope")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TokenScopeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tokenScopeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenScopePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `token_scope` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenScopePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: unable to delete all from tokenScope slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_schema: failed to get rows affected by deleteall for token_scope")
+	}
+
+	if len(tokenScopeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TokenScope) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTokenScope(ctx, exec, o.TokenID, o.Scope)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TokenScopeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TokenScopeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenScopePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `token_scope`.* FROM `token_scope` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tokenScopePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_schema: unable to reload all in TokenScopeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TokenScopeExists checks if the TokenScope row exists.
+func TokenScopeExists(ctx context.Context, exec boil.ContextExecutor, tokenID int, scope string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `token_scope` where `token_id`=? AND `scope`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tokenID, scope)
+	}
+	row := exec.QueryRowContext(ctx, sql, tokenID, scope)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_schema: unable to check if token_scope exists")
+	}
+
+	return exists, nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,70 +24,86 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Password  string    `boil:"password" json:"password" toml:"password" yaml:"password"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID               int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name             string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Email            string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Password         string    `boil:"password" json:"password" toml:"password" yaml:"password"`
+	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ActiveTokenQuota null.Int  `boil:"active_token_quota" json:"active_token_quota,omitempty" toml:"active_token_quota" yaml:"active_token_quota,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID        string
-	Name      string
-	Email     string
-	Password  string
-	CreatedAt string
+	ID               string
+	Name             string
+	Email            string
+	Password         string
+	CreatedAt        string
+	ActiveTokenQuota string
 }{
-	ID:        "id",
-	Name:      "name",
-	Email:     "email",
-	Password:  "password",
-	CreatedAt: "created_at",
+	ID:               "id",
+	Name:             "name",
+	Email:            "email",
+	Password:         "password",
+	CreatedAt:        "created_at",
+	ActiveTokenQuota: "active_token_quota",
 }
 
 var UserTableColumns = struct {
-	ID        string
-	Name      string
-	Email     string
-	Password  string
-	CreatedAt string
+	ID               string
+	Name             string
+	Email            string
+	Password         string
+	CreatedAt        string
+	ActiveTokenQuota string
 }{
-	ID:        "user.id",
-	Name:      "user.name",
-	Email:     "user.email",
-	Password:  "user.password",
-	CreatedAt: "user.created_at",
+	ID:               "user.id",
+	Name:             "user.name",
+	Email:            "user.email",
+	Password:         "user.password",
+	CreatedAt:        "user.created_at",
+	ActiveTokenQuota: "user.active_token_quota",
 }
 
 // Generated where
 
 var UserWhere = struct {
-	ID        whereHelperint
-	Name      whereHelperstring
-	Email     whereHelperstring
-	Password  whereHelperstring
-	CreatedAt whereHelpertime_Time
+	ID               whereHelperint
+	Name             whereHelperstring
+	Email            whereHelperstring
+	Password         whereHelperstring
+	CreatedAt        whereHelpertime_Time
+	ActiveTokenQuota whereHelpernull_Int
 }{
-	ID:        whereHelperint{field: "`user`.`id`"},
-	Name:      whereHelperstring{field: "`user`.`name`"},
-	Email:     whereHelperstring{field: "`user`.`email`"},
-	Password:  whereHelperstring{field: "`user`.`password`"},
-	CreatedAt: whereHelpertime_Time{field: "`user`.`created_at`"},
+	ID:               whereHelperint{field: "`user`.`id`"},
+	Name:             whereHelperstring{field: "`user`.`name`"},
+	Email:            whereHelperstring{field: "`user`.`email`"},
+	Password:         whereHelperstring{field: "`user`.`password`"},
+	CreatedAt:        whereHelpertime_Time{field: "`user`.`created_at`"},
+	ActiveTokenQuota: whereHelpernull_Int{field: "`user`.`active_token_quota`"},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	CreatedByTokens string
+	CreatedByCampaigns string
+	IdempotencyKeys    string
+	CreatedByTokens    string
+	CreatedByWebhooks  string
 }{
-	CreatedByTokens: "CreatedByTokens",
+	CreatedByCampaigns: "CreatedByCampaigns",
+	IdempotencyKeys:    "IdempotencyKeys",
+	CreatedByTokens:    "CreatedByTokens",
+	CreatedByWebhooks:  "CreatedByWebhooks",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	CreatedByTokens TokenSlice `boil:"CreatedByTokens" json:"CreatedByTokens" toml:"CreatedByTokens" yaml:"CreatedByTokens"`
+	CreatedByCampaigns CampaignSlice       `boil:"CreatedByCampaigns" json:"CreatedByCampaigns" toml:"CreatedByCampaigns" yaml:"CreatedByCampaigns"`
+	IdempotencyKeys    IdempotencyKeySlice `boil:"IdempotencyKeys" json:"IdempotencyKeys" toml:"IdempotencyKeys" yaml:"IdempotencyKeys"`
+	CreatedByTokens    TokenSlice          `boil:"CreatedByTokens" json:"CreatedByTokens" toml:"CreatedByTokens" yaml:"CreatedByTokens"`
+	CreatedByWebhooks  WebhookSlice        `boil:"CreatedByWebhooks" json:"CreatedByWebhooks" toml:"CreatedByWebhooks" yaml:"CreatedByWebhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -94,6 +111,20 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetCreatedByCampaigns() CampaignSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByCampaigns
+}
+
+func (r *userR) GetIdempotencyKeys() IdempotencyKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.IdempotencyKeys
+}
+
 func (r *userR) GetCreatedByTokens() TokenSlice {
 	if r == nil {
 		return nil
@@ -101,12 +132,19 @@ func (r *userR) GetCreatedByTokens() TokenSlice {
 	return r.CreatedByTokens
 }
 
+func (r *userR) GetCreatedByWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByWebhooks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "email", "password", "created_at"}
-	userColumnsWithoutDefault = []string{"name", "email", "password"}
+	userAllColumns            = []string{"id", "name", "email", "password", "created_at", "active_token_quota"}
+	userColumnsWithoutDefault = []string{"name", "email", "password", "active_token_quota"}
 	userColumnsWithDefault    = []string{"id", "created_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
@@ -390,6 +428,34 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// CreatedByCampaigns retrieves all the campaign's Campaigns with an executor via created_by column.
+func (o *User) CreatedByCampaigns(mods ...qm.QueryMod) campaignQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`campaign`.`created_by`=?", o.ID),
+	)
+
+	return Campaigns(queryMods...)
+}
+
+// IdempotencyKeys retrieves all the idempotency_key's IdempotencyKeys with an executor.
+func (o *User) IdempotencyKeys(mods ...qm.QueryMod) idempotencyKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`idempotency_key`.`user_id`=?", o.ID),
+	)
+
+	return IdempotencyKeys(queryMods...)
+}
+
 // CreatedByTokens retrieves all the token's Tokens with an executor via created_by column.
 func (o *User) CreatedByTokens(mods ...qm.QueryMod) tokenQuery {
 	var queryMods []qm.QueryMod
@@ -404,6 +470,216 @@ func (o *User) CreatedByTokens(mods ...qm.QueryMod) tokenQuery {
 	return Tokens(queryMods...)
 }
 
+// CreatedByWebhooks retrieves all the webhook's Webhooks with an executor via created_by column.
+func (o *User) CreatedByWebhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`webhook`.`created_by`=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadCreatedByCampaigns allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByCampaigns(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`campaign`),
+		qm.WhereIn(`campaign.created_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load campaign")
+	}
+
+	var resultSlice []*Campaign
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice campaign")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on campaign")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for campaign")
+	}
+
+	if len(campaignAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByCampaigns = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &campaignR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByCampaigns = append(local.R.CreatedByCampaigns, foreign)
+				if foreign.R == nil {
+					foreign.R = &campaignR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIdempotencyKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIdempotencyKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`idempotency_key`),
+		qm.WhereIn(`idempotency_key.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load idempotency_key")
+	}
+
+	var resultSlice []*IdempotencyKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice idempotency_key")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on idempotency_key")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for idempotency_key")
+	}
+
+	if len(idempotencyKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.IdempotencyKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &idempotencyKeyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.IdempotencyKeys = append(local.R.IdempotencyKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &idempotencyKeyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCreatedByTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadCreatedByTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -502,6 +778,210 @@ func (userL) LoadCreatedByTokens(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// LoadCreatedByWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`webhook`),
+		qm.WhereIn(`webhook.created_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByWebhooks = append(local.R.CreatedByWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByCampaigns adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByCampaigns.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByCampaigns(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Campaign) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `campaign` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"created_by"}),
+				strmangle.WhereClause("`", "`", 0, campaignPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByCampaigns: related,
+		}
+	} else {
+		o.R.CreatedByCampaigns = append(o.R.CreatedByCampaigns, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &campaignR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddIdempotencyKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.IdempotencyKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddIdempotencyKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*IdempotencyKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `idempotency_key` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, idempotencyKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			IdempotencyKeys: related,
+		}
+	} else {
+		o.R.IdempotencyKeys = append(o.R.IdempotencyKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &idempotencyKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddCreatedByTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.CreatedByTokens.
@@ -555,6 +1035,59 @@ func (o *User) AddCreatedByTokens(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddCreatedByWebhooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByWebhooks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `webhook` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"created_by"}),
+				strmangle.WhereClause("`", "`", 0, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByWebhooks: related,
+		}
+	} else {
+		o.R.CreatedByWebhooks = append(o.R.CreatedByWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("`user`"))
@@ -0,0 +1,176 @@
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"github.com/friendsofgo/errors"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"time"
+)
+
+type PersistenceCampaign struct {
+	db *sql.DB
+}
+
+var (
+	errCampaignNotFound = errors.New("error, campaign not found")
+	errDeleteCampaign   = errors.New("error deleting campaign")
+	errFetchCampaign    = errors.New("error fetching campaign")
+	errFetchCampaigns   = errors.New("error fetching campaigns")
+	errFetchStats       = errors.New("error fetching campaign stats")
+	errInsertCampaign   = errors.New("error inserting campaign")
+	errUpdateCampaign   = errors.New("error updating campaign")
+)
+
+// campaignStatsQuery counts the campaign's tokens by state. Expired tokens are counted once their expiry
+// passes, whether or not the sweeper flagged them yet, and revoked tokens are only counted as revoked.
+// Validated tokens were validated successfully at least once, whatever their state now.
+const campaignStatsQuery = "SELECT COUNT(*) AS `issued`, " +
+	"COALESCE(SUM(t.`revoked` = 0 AND t.`expired` = 0 AND t.`expires_at` > ?), 0) AS `active`, " +
+	"COALESCE(SUM(t.`revoked` = 0 AND (t.`expired` = 1 OR t.`expires_at` <= ?)), 0) AS `expired`, " +
+	"COALESCE(SUM(t.`revoked` = 1), 0) AS `revoked`, " +
+	"(SELECT COUNT(DISTINCT e.`token_id`) FROM `token_event` e JOIN `token` v ON v.`id` = e.`token_id` " +
+	"WHERE v.`campaign_id` = ? AND e.`action` = ? AND e.`outcome` = ?) AS `validated` " +
+	"FROM `token` t WHERE t.`campaign_id` = ?"
+
+// CreateCampaign inserts the campaign
+func (p *PersistenceCampaign) CreateCampaign(ctx context.Context, newCampaign *models.NewCampaign) (*models.Campaign, error) {
+	entry := models_schema.Campaign{
+		Name:              newCampaign.Name,
+		Description:       null.NewString(newCampaign.Description, newCampaign.Description != ""),
+		DefaultTTLSeconds: ttlSeconds(newCampaign.DefaultTTL),
+		DefaultMaxUses:    null.IntFromPtr(newCampaign.DefaultMaxUses),
+		StartsAt:          null.TimeFromPtr(newCampaign.StartsAt),
+		EndsAt:            null.TimeFromPtr(newCampaign.EndsAt),
+		CreatedBy:         newCampaign.CreatedBy,
+		CreatedAt:         time.Now(),
+	}
+	err := entry.Insert(ctx, p.db, boil.Infer())
+	if err != nil {
+		return nil, errors.Wrap(err, errInsertCampaign.Error())
+	}
+	return toCampaign(&entry), nil
+}
+
+// GetCampaigns returns every campaign, oldest first
+func (p *PersistenceCampaign) GetCampaigns(ctx context.Context) ([]models.Campaign, error) {
+	entries, err := models_schema.Campaigns(
+		qm.OrderBy(models_schema.CampaignColumns.ID),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchCampaigns.Error())
+	}
+	campaigns := make([]models.Campaign, 0, len(entries))
+	for _, entry := range entries {
+		campaigns = append(campaigns, *toCampaign(entry))
+	}
+	return campaigns, nil
+}
+
+func (p *PersistenceCampaign) GetCampaign(ctx context.Context, id int) (*models.Campaign, error) {
+	entry, err := models_schema.FindCampaign(ctx, p.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound.Wrap(errCampaignNotFound)
+		}
+		return nil, errors.Wrap(err, errFetchCampaign.Error())
+	}
+	return toCampaign(entry), nil
+}
+
+// UpdateCampaign applies the changes to the campaign. An empty description clears it.
+func (p *PersistenceCampaign) UpdateCampaign(ctx context.Context, id int, changes *models.CampaignChanges) error {
+	columns := models_schema.M{}
+	if changes.Name != nil {
+		columns[models_schema.CampaignColumns.Name] = *changes.Name
+	}
+	if changes.Description != nil {
+		columns[models_schema.CampaignColumns.Description] = null.NewString(*changes.Description, *changes.Description != "")
+	}
+	if changes.DefaultTTL != nil {
+		columns[models_schema.CampaignColumns.DefaultTTLSeconds] = ttlSeconds(changes.DefaultTTL)
+	}
+	if changes.DefaultMaxUses != nil {
+		columns[models_schema.CampaignColumns.DefaultMaxUses] = *changes.DefaultMaxUses
+	}
+	if changes.StartsAt != nil {
+		columns[models_schema.CampaignColumns.StartsAt] = *changes.StartsAt
+	}
+	if changes.EndsAt != nil {
+		columns[models_schema.CampaignColumns.EndsAt] = *changes.EndsAt
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	_, err := models_schema.Campaigns(
+		models_schema.CampaignWhere.ID.EQ(id),
+	).UpdateAll(ctx, p.db, columns)
+	if err != nil {
+		return errors.Wrap(err, errUpdateCampaign.Error())
+	}
+	return nil
+}
+
+// DeleteCampaign deletes the campaign. Its tokens are kept, and no longer belong to a campaign.
+func (p *PersistenceCampaign) DeleteCampaign(ctx context.Context, id int) error {
+	deleted, err := models_schema.Campaigns(
+		models_schema.CampaignWhere.ID.EQ(id),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return errors.Wrap(err, errDeleteCampaign.Error())
+	}
+	if deleted == 0 {
+		return models.ErrNotFound.Wrap(errCampaignNotFound)
+	}
+	return nil
+}
+
+// GetCampaignStats counts the campaign's tokens by state, as of now
+func (p *PersistenceCampaign) GetCampaignStats(ctx context.Context, id int, now time.Time) (*models.CampaignStats, error) {
+	var stats models.CampaignStats
+	err := queries.Raw(campaignStatsQuery,
+		now, now, id, models.TokenEventActionValidate, models.TokenEventOutcomeValid, id,
+	).Bind(ctx, p.db, &stats)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchStats.Error())
+	}
+	return &stats, nil
+}
+
+// ttlSeconds stores the default TTL in whole seconds
+func ttlSeconds(ttl *time.Duration) null.Int {
+	if ttl == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(ttl.Seconds()))
+}
+
+func toCampaign(entry *models_schema.Campaign) *models.Campaign {
+	campaign := models.Campaign{
+		Id:             entry.ID,
+		Name:           entry.Name,
+		Description:    entry.Description,
+		DefaultMaxUses: entry.DefaultMaxUses,
+		StartsAt:       entry.StartsAt,
+		EndsAt:         entry.EndsAt,
+		RevokedAt:      entry.RevokedAt,
+		CreatedBy:      entry.CreatedBy,
+		CreatedAt:      entry.CreatedAt,
+	}
+	if entry.DefaultTTLSeconds.Valid {
+		campaign.DefaultTTL = null.StringFrom((time.Duration(entry.DefaultTTLSeconds.Int) * time.Second).String())
+	}
+	return &campaign
+}
+
+// NewPersistenceCampaign returns a new *PersistenceCampaign instance
+func NewPersistenceCampaign(db *sql.DB) *PersistenceCampaign {
+	return &PersistenceCampaign{db: db}
+}
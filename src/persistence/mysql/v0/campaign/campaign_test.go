@@ -0,0 +1,144 @@
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var campaignColumns = []string{"id", "name", "description", "default_ttl_seconds", "default_max_uses",
+	"starts_at", "ends_at", "revoked_at", "created_by", "created_at"}
+
+func TestPersistenceCampaign_CreateCampaign_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	ttl := 72 * time.Hour
+	maxUses := 1
+	endsAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `campaign` (`name`,`description`,`default_ttl_seconds`,`default_max_uses`,`starts_at`,`ends_at`,`revoked_at`,`created_by`,`created_at`)")).
+		WithArgs("Spring launch", nil, 259200, 1, nil, endsAt, nil, 1, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	campaign, err := NewPersistenceCampaign(db).CreateCampaign(context.Background(), &models.NewCampaign{
+		Name:           "Spring launch",
+		DefaultTTL:     &ttl,
+		DefaultMaxUses: &maxUses,
+		EndsAt:         &endsAt,
+		CreatedBy:      1,
+	})
+	t.Run("Test CreateCampaign - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, campaign.Id)
+		assert.Equal(t, null.StringFrom("72h0m0s"), campaign.DefaultTTL)
+		assert.False(t, campaign.Description.Valid)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceCampaign_GetCampaigns_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `campaign`.* FROM `campaign` ORDER BY id")).
+		WillReturnRows(sqlmock.NewRows(campaignColumns).
+			AddRow(1, "Spring launch", "Waitlist invites", 3600, 1, nil, nil, nil, 1, createdAt).
+			AddRow(2, "Beta", nil, nil, nil, nil, nil, createdAt, 1, createdAt))
+
+	campaigns, err := NewPersistenceCampaign(db).GetCampaigns(context.Background())
+	t.Run("Test GetCampaigns - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, campaigns, 2)
+		assert.Equal(t, null.StringFrom("1h0m0s"), campaigns[0].DefaultTTL)
+		assert.Equal(t, null.IntFrom(1), campaigns[0].DefaultMaxUses)
+		assert.False(t, campaigns[1].DefaultTTL.Valid)
+		assert.True(t, campaigns[1].RevokedAt.Valid)
+	})
+}
+
+func TestPersistenceCampaign_GetCampaign_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery("select \\* from `campaign`").WillReturnError(sql.ErrNoRows)
+
+	_, err = NewPersistenceCampaign(db).GetCampaign(context.Background(), 9)
+	t.Run("Test GetCampaign - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestPersistenceCampaign_UpdateCampaign_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	description := ""
+	ttl := 48 * time.Hour
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `campaign` SET `default_ttl_seconds` = ?, `description` = ? WHERE (`campaign`.`id` = ?)")).
+		WithArgs(172800, nil, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewPersistenceCampaign(db).UpdateCampaign(context.Background(), 3, &models.CampaignChanges{
+		Description: &description,
+		DefaultTTL:  &ttl,
+	})
+	t.Run("Test UpdateCampaign - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceCampaign_DeleteCampaign_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `campaign` WHERE (`campaign`.`id` = ?)")).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewPersistenceCampaign(db).DeleteCampaign(context.Background(), 9)
+	t.Run("Test DeleteCampaign - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestPersistenceCampaign_GetCampaignStats_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(campaignStatsQuery)).
+		WithArgs(now, now, 3, models.TokenEventActionValidate, models.TokenEventOutcomeValid, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"issued", "active", "expired", "revoked", "validated"}).
+			AddRow(200, 150, 30, 20, 80))
+
+	stats, err := NewPersistenceCampaign(db).GetCampaignStats(context.Background(), 3, now)
+	t.Run("Test GetCampaignStats - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, &models.CampaignStats{Issued: 200, Active: 150, Validated: 80, Expired: 30, Revoked: 20}, stats)
+	})
+}
+
+func TestPersistenceCampaign_GetCampaignStats_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(campaignStatsQuery)).WillReturnError(fmt.Errorf("connection lost"))
+
+	_, err = NewPersistenceCampaign(db).GetCampaignStats(context.Background(), 3, time.Now())
+	t.Run("Test GetCampaignStats - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchStats.Error())
+	})
+}
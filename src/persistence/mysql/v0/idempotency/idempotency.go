@@ -0,0 +1,150 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"github.com/friendsofgo/errors"
+	"github.com/go-sql-driver/mysql"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"time"
+)
+
+type PersistenceIdempotency struct {
+	db *sql.DB
+}
+
+var (
+	errDeleteExpired     = errors.New("error deleting expired idempotency keys")
+	errFetchIdempotency  = errors.New("error fetching idempotency key")
+	errInsertIdempotency = errors.New("error inserting idempotency key")
+	errUpdateIdempotency = errors.New("error updating idempotency key")
+	errDeleteIdempotency = errors.New("error deleting idempotency key")
+)
+
+// errDuplicateEntry is the MySQL error number of a unique key violation
+const errDuplicateEntry = 1062
+
+// maxReserveAttempts bounds how many times a key released while being reserved is reserved again
+const maxReserveAttempts = 3
+
+// Reserve stores the key as being handled, and returns it, unless the user already sent it to the scope.
+// In that case the key as stored is returned instead, and reserved is false.
+// A key that expired is replaced, as if it had never been sent.
+// A key released between the insert failing and it being fetched is reserved again.
+func (p *PersistenceIdempotency) Reserve(ctx context.Context, request *models.IdempotencyRequest, now time.Time,
+	expiresAt time.Time) (record *models.IdempotencyRecord, reserved bool, err error) {
+	for attempt := 1; ; attempt++ {
+		record, reserved, err = p.reserve(ctx, request, now, expiresAt)
+		if !errors.Is(err, sql.ErrNoRows) || attempt == maxReserveAttempts {
+			return record, reserved, err
+		}
+	}
+}
+
+func (p *PersistenceIdempotency) reserve(ctx context.Context, request *models.IdempotencyRequest, now time.Time,
+	expiresAt time.Time) (record *models.IdempotencyRecord, reserved bool, err error) {
+	_, err = models_schema.IdempotencyKeys(
+		models_schema.IdempotencyKeyWhere.UserID.EQ(request.UserId),
+		models_schema.IdempotencyKeyWhere.Scope.EQ(request.Scope),
+		models_schema.IdempotencyKeyWhere.IdempotencyKey.EQ(request.Key),
+		models_schema.IdempotencyKeyWhere.ExpiresAt.LTE(now),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errDeleteExpired.Error())
+	}
+
+	entry := models_schema.IdempotencyKey{
+		UserID:         request.UserId,
+		Scope:          request.Scope,
+		IdempotencyKey: request.Key,
+		RequestHash:    request.RequestHash,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+	}
+	err = entry.Insert(ctx, p.db, boil.Infer())
+	if err == nil {
+		return &models.IdempotencyRecord{Id: entry.ID, RequestHash: entry.RequestHash}, true, nil
+	}
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != errDuplicateEntry {
+		return nil, false, errors.Wrap(err, errInsertIdempotency.Error())
+	}
+
+	existing, err := models_schema.IdempotencyKeys(
+		models_schema.IdempotencyKeyWhere.UserID.EQ(request.UserId),
+		models_schema.IdempotencyKeyWhere.Scope.EQ(request.Scope),
+		models_schema.IdempotencyKeyWhere.IdempotencyKey.EQ(request.Key),
+	).One(ctx, p.db)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errFetchIdempotency.Error())
+	}
+	return toIdempotencyRecord(existing), false, nil
+}
+
+// Complete stores the response for the key, to replay
+func (p *PersistenceIdempotency) Complete(ctx context.Context, id int, response *models.IdempotentResponse) error {
+	_, err := models_schema.IdempotencyKeys(
+		models_schema.IdempotencyKeyWhere.ID.EQ(id),
+	).UpdateAll(ctx, p.db, models_schema.M{
+		models_schema.IdempotencyKeyColumns.StatusCode:   response.StatusCode,
+		models_schema.IdempotencyKeyColumns.ContentType:  response.ContentType,
+		models_schema.IdempotencyKeyColumns.ResponseBody: responseBody(response.Body),
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateIdempotency.Error())
+	}
+	return nil
+}
+
+// Release deletes the key, so it can be sent again
+func (p *PersistenceIdempotency) Release(ctx context.Context, id int) error {
+	_, err := models_schema.IdempotencyKeys(
+		models_schema.IdempotencyKeyWhere.ID.EQ(id),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return errors.Wrap(err, errDeleteIdempotency.Error())
+	}
+	return nil
+}
+
+// DeleteExpired deletes up to limit keys that expired by now, and returns how many were deleted
+func (p *PersistenceIdempotency) DeleteExpired(ctx context.Context, now time.Time, limit int) (int64, error) {
+	deleted, err := models_schema.IdempotencyKeys(
+		models_schema.IdempotencyKeyWhere.ExpiresAt.LTE(now),
+		qm.OrderBy(models_schema.IdempotencyKeyColumns.ExpiresAt),
+		qm.Limit(limit),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return 0, errors.Wrap(err, errDeleteExpired.Error())
+	}
+	return deleted, nil
+}
+
+func toIdempotencyRecord(entry *models_schema.IdempotencyKey) *models.IdempotencyRecord {
+	record := models.IdempotencyRecord{Id: entry.ID, RequestHash: entry.RequestHash}
+	if entry.StatusCode.Valid {
+		record.Response = &models.IdempotentResponse{
+			StatusCode:  entry.StatusCode.Int,
+			ContentType: entry.ContentType.String,
+			Body:        entry.ResponseBody.Bytes,
+		}
+	}
+	return &record
+}
+
+// responseBody keeps empty bodies apart from a missing one
+func responseBody(body []byte) null.Bytes {
+	if body == nil {
+		body = []byte{}
+	}
+	return null.BytesFrom(body)
+}
+
+// NewPersistenceIdempotency returns a new *PersistenceIdempotency instance
+func NewPersistenceIdempotency(db *sql.DB) *PersistenceIdempotency {
+	return &PersistenceIdempotency{db: db}
+}
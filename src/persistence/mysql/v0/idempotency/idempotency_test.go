@@ -0,0 +1,179 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	testNow       = time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+	testExpiresAt = testNow.Add(24 * time.Hour)
+)
+
+func mockIdempotencyRequest() *models.IdempotencyRequest {
+	return &models.IdempotencyRequest{
+		UserId:      3,
+		Scope:       "POST /api/v0/token/",
+		Key:         "retry-1",
+		RequestHash: "5f0c",
+	}
+}
+
+func expectDeleteExpiredKey(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_key` WHERE (`idempotency_key`.`user_id` = ?) AND "+
+		"(`idempotency_key`.`scope` = ?) AND (`idempotency_key`.`idempotency_key` = ?) AND (`idempotency_key`.`expires_at` <= ?);")).
+		WithArgs(3, "POST /api/v0/token/", "retry-1", testNow).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestPersistenceIdempotency_Reserve_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectDeleteExpiredKey(mock)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_key`")).
+		WithArgs(3, "POST /api/v0/token/", "retry-1", "5f0c", nil, nil, nil, testNow, testExpiresAt).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	record, reserved, err := persistenceIdempotency.Reserve(context.Background(), mockIdempotencyRequest(), testNow, testExpiresAt)
+	t.Run("Test Reserve - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.True(t, reserved)
+		assert.Equal(t, &models.IdempotencyRecord{Id: 7, RequestHash: "5f0c"}, record)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceIdempotency_Reserve_HappyPath_AlreadySent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectDeleteExpiredKey(mock)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_key`")).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `idempotency_key`.* FROM `idempotency_key` WHERE (`idempotency_key`.`user_id` = ?) AND "+
+		"(`idempotency_key`.`scope` = ?) AND (`idempotency_key`.`idempotency_key` = ?) LIMIT 1;")).
+		WithArgs(3, "POST /api/v0/token/", "retry-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "request_hash", "status_code", "content_type", "response_body"}).
+			AddRow(5, "5f0c", 201, "application/json", []byte(`{"key":"sealed"}`)))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	record, reserved, err := persistenceIdempotency.Reserve(context.Background(), mockIdempotencyRequest(), testNow, testExpiresAt)
+	t.Run("Test Reserve - Happy Path Already Sent", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.False(t, reserved)
+		assert.Equal(t, &models.IdempotencyRecord{
+			Id:          5,
+			RequestHash: "5f0c",
+			Response: &models.IdempotentResponse{
+				StatusCode:  201,
+				ContentType: "application/json",
+				Body:        []byte(`{"key":"sealed"}`),
+			},
+		}, record)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceIdempotency_Reserve_HappyPath_ReleasedWhileReserving(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectDeleteExpiredKey(mock)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_key`")).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `idempotency_key`.* FROM `idempotency_key`")).
+		WithArgs(3, "POST /api/v0/token/", "retry-1").
+		WillReturnError(sql.ErrNoRows)
+	expectDeleteExpiredKey(mock)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_key`")).
+		WithArgs(3, "POST /api/v0/token/", "retry-1", "5f0c", nil, nil, nil, testNow, testExpiresAt).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	record, reserved, err := persistenceIdempotency.Reserve(context.Background(), mockIdempotencyRequest(), testNow, testExpiresAt)
+	t.Run("Test Reserve - Happy Path Released While Reserving", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.True(t, reserved)
+		assert.Equal(t, &models.IdempotencyRecord{Id: 8, RequestHash: "5f0c"}, record)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceIdempotency_Reserve_FailInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectDeleteExpiredKey(mock)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_key`")).
+		WillReturnError(fmt.Errorf("connection lost"))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	_, _, err = persistenceIdempotency.Reserve(context.Background(), mockIdempotencyRequest(), testNow, testExpiresAt)
+	t.Run("Test Reserve - Fail Insert", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errInsertIdempotency.Error())
+	})
+}
+
+func TestPersistenceIdempotency_Complete_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `idempotency_key` SET `content_type` = ?, `response_body` = ?, `status_code` = ? WHERE (`idempotency_key`.`id` = ?)")).
+		WithArgs("application/json", []byte("{}"), 201, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	err = persistenceIdempotency.Complete(context.Background(), 7, &models.IdempotentResponse{
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte("{}"),
+	})
+	t.Run("Test Complete - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceIdempotency_Release_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_key` WHERE (`idempotency_key`.`id` = ?);")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	err = persistenceIdempotency.Release(context.Background(), 7)
+	t.Run("Test Release - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceIdempotency_DeleteExpired_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_key` WHERE (`idempotency_key`.`expires_at` <= ?) ORDER BY expires_at LIMIT 500;")).
+		WithArgs(testNow).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	persistenceIdempotency := PersistenceIdempotency{db: db}
+	deleted, err := persistenceIdempotency.DeleteExpired(context.Background(), testNow, 500)
+	t.Run("Test DeleteExpired - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), deleted)
+	})
+}
@@ -0,0 +1,315 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"github.com/friendsofgo/errors"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"platform_engineer_clone/src/utils/common"
+	"time"
+)
+
+type PersistenceOutbox struct {
+	db *sql.DB
+}
+
+var (
+	errBeginTransaction    = errors.New("error beginning transaction")
+	errClaimPending        = errors.New("error claiming pending outbox events")
+	errCommitTransaction   = errors.New("error committing transaction")
+	errDeleteSent          = errors.New("error deleting sent outbox events")
+	errFetchDelivered      = errors.New("error fetching outbox event deliveries")
+	errFetchPending        = errors.New("error fetching pending outbox events")
+	errMarkSent            = errors.New("error marking outbox events as sent")
+	errPublishOutbox       = errors.New("error publishing outbox event")
+	errRecordAttempt       = errors.New("error recording outbox publish attempt")
+	errRecordDelivered     = errors.New("error recording outbox event deliveries")
+	errReleaseClaim        = errors.New("error releasing the claim on outbox events")
+	errRollbackTransaction = errors.New("error rolling back transaction")
+)
+
+// maxErrorLength fits the last error into its column
+const maxErrorLength = 1024
+
+// RelayPending publishes up to limit pending events, oldest first, and marks the published ones as sent.
+// The events are claimed for the lease in a short transaction, and published outside of it, so the writers
+// adding events aren't held up by the sinks. A relay finding the oldest events claimed by another leaves them,
+// rather than publish out of order, and events whose relay stopped are pending again once the lease runs out.
+// publish is handed each event with the sinks that accepted it earlier, and returns the sinks that accepted it.
+// Publishing stops at the first failure, whose attempt and accepting sinks are recorded, leaving that event
+// and the ones after it for the next relay. An event failing for the maxAttempts-th time is parked instead,
+// and publishing goes on. It returns how many events were sent and parked, and the publish error, if any.
+func (p *PersistenceOutbox) RelayPending(ctx context.Context, limit int, maxAttempts int, lease time.Duration,
+	publish func(event *models.OutboxEvent) ([]string, error)) (int, int, error) {
+	pending, err := p.inTx(ctx, func(tx *sql.Tx) ([]models.OutboxEvent, error) {
+		return claimPending(ctx, tx, time.Now(), limit, lease)
+	})
+	if err != nil || len(pending) == 0 {
+		return 0, 0, err
+	}
+
+	var failures []failedPublish
+	var publishErr error
+	sentIds := make([]int, 0, len(pending))
+	for i := range pending {
+		accepted, err := publish(&pending[i])
+		if err == nil {
+			sentIds = append(sentIds, pending[i].Id)
+			continue
+		}
+		failed := failedPublish{event: &pending[i], accepted: accepted, err: errors.Wrap(err, errPublishOutbox.Error())}
+		failures = append(failures, failed)
+		if pending[i].Attempts+1 < maxAttempts {
+			publishErr = failed.err
+			break
+		}
+	}
+
+	var result relayed
+	_, err = p.inTx(ctx, func(tx *sql.Tx) ([]models.OutboxEvent, error) {
+		result, err = recordRelay(ctx, tx, pending, sentIds, failures, maxAttempts)
+		return nil, err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return result.sent, result.parked, publishErr
+}
+
+// inTx runs fn in a transaction, committed unless fn fails
+func (p *PersistenceOutbox) inTx(ctx context.Context, fn func(tx *sql.Tx) ([]models.OutboxEvent, error)) ([]models.OutboxEvent, error) {
+	// Read committed, so the pending events read are locked without the gap after them,
+	// where the writers insert new events
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, errors.Wrap(err, errBeginTransaction.Error())
+	}
+	events, err := fn(tx)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			common.GetLogger(ctx).WithFields(logrus.Fields{
+				"err": errors.Wrap(rollbackErr, errRollbackTransaction.Error()),
+			}).Error("error_relay_outbox")
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, errCommitTransaction.Error())
+	}
+	return events, nil
+}
+
+// relayed counts the events taken out of the pending ones by a relay
+type relayed struct {
+	sent   int
+	parked int
+}
+
+// failedPublish is an event that failed to publish, with the sinks that accepted it regardless
+type failedPublish struct {
+	event    *models.OutboxEvent
+	accepted []string
+	err      error
+}
+
+// claimedEvent is a pending event, claimed by a relay until ClaimedUntil, if valid
+type claimedEvent struct {
+	models.OutboxEvent `boil:",bind"`
+	ClaimedUntil       null.Time `boil:"claimed_until"`
+}
+
+// claimPending claims up to limit pending events for the lease, oldest first, along with the sinks that accepted them.
+// None are claimed while any of them is claimed by another relay.
+func claimPending(ctx context.Context, tx *sql.Tx, now time.Time, limit int, lease time.Duration) ([]models.OutboxEvent, error) {
+	var claimed []claimedEvent
+	err := models_schema.Outboxes(
+		qm.Select(
+			models_schema.OutboxColumns.ID,
+			models_schema.OutboxColumns.TokenID,
+			models_schema.OutboxColumns.EventType,
+			models_schema.OutboxColumns.Payload,
+			models_schema.OutboxColumns.Attempts,
+			models_schema.OutboxColumns.ClaimedUntil,
+		),
+		models_schema.OutboxWhere.SentAt.IsNull(),
+		models_schema.OutboxWhere.FailedAt.IsNull(),
+		qm.OrderBy(models_schema.OutboxColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE"),
+	).Bind(ctx, tx, &claimed)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchPending.Error())
+	}
+
+	pending := make([]models.OutboxEvent, 0, len(claimed))
+	ids := make([]int, 0, len(claimed))
+	for _, event := range claimed {
+		if event.ClaimedUntil.Valid && event.ClaimedUntil.Time.After(now) {
+			return nil, nil
+		}
+		pending = append(pending, event.OutboxEvent)
+		ids = append(ids, event.Id)
+	}
+	if len(ids) == 0 {
+		return pending, nil
+	}
+
+	_, err = models_schema.Outboxes(
+		models_schema.OutboxWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.OutboxColumns.ClaimedUntil: null.TimeFrom(now.Add(lease)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errClaimPending.Error())
+	}
+	if err = loadDelivered(ctx, tx, pending); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// recordRelay records the failed attempts and marks the published events as sent,
+// then releases the claim on the events, so those left pending can be relayed again right away
+func recordRelay(ctx context.Context, tx *sql.Tx, pending []models.OutboxEvent, sentIds []int,
+	failures []failedPublish, maxAttempts int) (relayed, error) {
+	var result relayed
+	for _, failed := range failures {
+		if err := recordDelivered(ctx, tx, failed.event.Id, failed.accepted); err != nil {
+			return relayed{}, err
+		}
+		parked, err := recordAttempt(ctx, tx, failed.event, failed.err, maxAttempts)
+		if err != nil {
+			return relayed{}, err
+		}
+		if parked {
+			result.parked++
+		}
+	}
+	if err := markSent(ctx, tx, sentIds); err != nil {
+		return relayed{}, err
+	}
+	result.sent = len(sentIds)
+
+	ids := make([]int, 0, len(pending))
+	for _, event := range pending {
+		ids = append(ids, event.Id)
+	}
+	_, err := models_schema.Outboxes(
+		models_schema.OutboxWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.OutboxColumns.ClaimedUntil: null.Time{},
+	})
+	if err != nil {
+		return relayed{}, errors.Wrap(err, errReleaseClaim.Error())
+	}
+	return result, nil
+}
+
+// markSent marks the published events as sent
+func markSent(ctx context.Context, tx *sql.Tx, sentIds []int) error {
+	if len(sentIds) == 0 {
+		return nil
+	}
+	_, err := models_schema.Outboxes(
+		models_schema.OutboxWhere.ID.IN(sentIds),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.OutboxColumns.SentAt: time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errMarkSent.Error())
+	}
+	return nil
+}
+
+// loadDelivered sets the sinks that accepted each event on an earlier attempt.
+// Those are only recorded when an attempt fails, so events never attempted are skipped.
+func loadDelivered(ctx context.Context, tx *sql.Tx, events []models.OutboxEvent) error {
+	attempted := map[int]*models.OutboxEvent{}
+	ids := []int{}
+	for i := range events {
+		if events[i].Attempts > 0 {
+			attempted[events[i].Id] = &events[i]
+			ids = append(ids, events[i].Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	deliveries, err := models_schema.OutboxDeliveries(
+		models_schema.OutboxDeliveryWhere.OutboxID.IN(ids),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, errFetchDelivered.Error())
+	}
+	for _, delivery := range deliveries {
+		event := attempted[delivery.OutboxID]
+		event.Delivered = append(event.Delivered, delivery.Sink)
+	}
+	return nil
+}
+
+// recordDelivered records the sinks that accepted the event, so the next attempt skips them
+func recordDelivered(ctx context.Context, tx *sql.Tx, eventId int, sinks []string) error {
+	now := time.Now()
+	for _, sink := range sinks {
+		delivery := models_schema.OutboxDelivery{
+			OutboxID:    eventId,
+			Sink:        sink,
+			DeliveredAt: now,
+		}
+		if err := delivery.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrap(err, errRecordDelivered.Error())
+		}
+	}
+	return nil
+}
+
+// recordAttempt records the failed attempt, parking the event once it has failed maxAttempts times
+func recordAttempt(ctx context.Context, tx *sql.Tx, event *models.OutboxEvent, publishErr error,
+	maxAttempts int) (bool, error) {
+	lastError := publishErr.Error()
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+	attempts := event.Attempts + 1
+	changes := models_schema.M{
+		models_schema.OutboxColumns.Attempts:  attempts,
+		models_schema.OutboxColumns.LastError: null.StringFrom(lastError),
+	}
+	parked := attempts >= maxAttempts
+	if parked {
+		changes[models_schema.OutboxColumns.FailedAt] = null.TimeFrom(time.Now())
+	}
+	_, err := models_schema.Outboxes(
+		models_schema.OutboxWhere.ID.EQ(event.Id),
+	).UpdateAll(ctx, tx, changes)
+	if err != nil {
+		return false, errors.Wrap(err, errRecordAttempt.Error())
+	}
+	return parked, nil
+}
+
+// DeleteSent deletes up to limit events sent before the given time, oldest first
+func (p *PersistenceOutbox) DeleteSent(ctx context.Context, before time.Time, limit int) (int64, error) {
+	deleted, err := models_schema.Outboxes(
+		models_schema.OutboxWhere.SentAt.LT(null.TimeFrom(before)),
+		qm.OrderBy(models_schema.OutboxColumns.ID),
+		qm.Limit(limit),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return 0, errors.Wrap(err, errDeleteSent.Error())
+	}
+	return deleted, nil
+}
+
+// NewPersistenceOutbox returns a new *PersistenceOutbox instance
+func NewPersistenceOutbox(db *sql.DB) *PersistenceOutbox {
+	return &PersistenceOutbox{db: db}
+}
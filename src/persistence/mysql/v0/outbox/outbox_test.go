@@ -0,0 +1,230 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const pendingQuery = "SELECT `id`, `token_id`, `event_type`, `payload`, `attempts`, `claimed_until` FROM `outbox` " +
+	"WHERE (`outbox`.`sent_at` is null) AND (`outbox`.`failed_at` is null) ORDER BY id LIMIT 10 FOR UPDATE;"
+
+const deliveredQuery = "SELECT `outbox_delivery`.* FROM `outbox_delivery` WHERE (`outbox_delivery`.`outbox_id` IN (?));"
+
+const claimQuery = "UPDATE `outbox` SET `claimed_until` = ? WHERE (`outbox`.`id` IN (?,?,?))"
+
+func pendingRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "token_id", "event_type", "payload", "attempts", "claimed_until"}).
+		AddRow(11, 3, models.TokenLifecycleCreated, `{"type":"token.created"}`, 0, nil).
+		AddRow(12, 3, models.TokenLifecycleRevoked, `{"type":"token.revoked"}`, 2, time.Now().Add(-time.Minute)).
+		AddRow(13, 5, models.TokenLifecycleCreated, `{"type":"token.created"}`, 0, nil)
+}
+
+// expectClaim expects the pending events to be claimed, with the sinks that accepted event 12 on an earlier attempt
+func expectClaim(mock sqlmock.Sqlmock, delivered *sqlmock.Rows) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(pendingQuery)).WillReturnRows(pendingRows())
+	mock.ExpectExec(regexp.QuoteMeta(claimQuery)).
+		WithArgs(sqlmock.AnyArg(), 11, 12, 13).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(deliveredQuery)).WithArgs(12).WillReturnRows(delivered)
+	mock.ExpectCommit()
+}
+
+// expectRelease expects the claim on the pending events to be released
+func expectRelease(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(claimQuery)).
+		WithArgs(nil, 11, 12, 13).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+}
+
+func TestPersistenceOutbox_RelayPending_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectClaim(mock, sqlmock.NewRows([]string{"outbox_id", "sink", "delivered_at"}).AddRow(12, "webhooks", time.Now()))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET `sent_at` = ? WHERE (`outbox`.`id` IN (?,?,?))")).
+		WithArgs(sqlmock.AnyArg(), 11, 12, 13).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	expectRelease(mock)
+
+	published := []int{}
+	delivered := map[int][]string{}
+	persistenceOutbox := PersistenceOutbox{db: db}
+	sent, parked, err := persistenceOutbox.RelayPending(context.Background(), 10, 10, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			published = append(published, event.Id)
+			delivered[event.Id] = event.Delivered
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 3, sent)
+		assert.Equal(t, 0, parked)
+		assert.Equal(t, []int{11, 12, 13}, published)
+		assert.Equal(t, map[int][]string{12: {"webhooks"}, 11: nil, 13: nil}, delivered)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_RelayPending_HappyPath_NothingPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(pendingQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "token_id", "event_type", "payload", "attempts", "claimed_until"}))
+	mock.ExpectCommit()
+
+	persistenceOutbox := PersistenceOutbox{db: db}
+	sent, _, err := persistenceOutbox.RelayPending(context.Background(), 10, 10, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Happy Path Nothing Pending", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_RelayPending_HappyPath_ClaimedByAnotherRelay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(pendingQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "token_id", "event_type", "payload", "attempts", "claimed_until"}).
+			AddRow(11, 3, models.TokenLifecycleCreated, `{"type":"token.created"}`, 0, time.Now().Add(time.Minute)).
+			AddRow(12, 3, models.TokenLifecycleRevoked, `{"type":"token.revoked"}`, 0, nil))
+	mock.ExpectCommit()
+
+	published := 0
+	persistenceOutbox := PersistenceOutbox{db: db}
+	sent, _, err := persistenceOutbox.RelayPending(context.Background(), 10, 10, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			published++
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Happy Path Claimed By Another Relay", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, 0, published)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_RelayPending_FailPublish(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectClaim(mock, sqlmock.NewRows([]string{"outbox_id", "sink", "delivered_at"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox_delivery` (`outbox_id`,`sink`,`delivered_at`) VALUES (?,?,?)")).
+		WithArgs(12, "stream", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET `attempts` = ?, `last_error` = ? WHERE (`outbox`.`id` = ?)")).
+		WithArgs(3, sqlmock.AnyArg(), 12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET `sent_at` = ? WHERE (`outbox`.`id` IN (?))")).
+		WithArgs(sqlmock.AnyArg(), 11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRelease(mock)
+
+	published := []int{}
+	persistenceOutbox := PersistenceOutbox{db: db}
+	sent, parked, err := persistenceOutbox.RelayPending(context.Background(), 10, 10, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			published = append(published, event.Id)
+			if event.Id == 12 {
+				return []string{"stream"}, fmt.Errorf("sink unavailable")
+			}
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Fail Publish Stops In Order", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errPublishOutbox.Error())
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, 0, parked)
+		assert.Equal(t, []int{11, 12}, published)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_RelayPending_FailPublish_Parked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expectClaim(mock, sqlmock.NewRows([]string{"outbox_id", "sink", "delivered_at"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET `attempts` = ?, `failed_at` = ?, `last_error` = ? WHERE (`outbox`.`id` = ?)")).
+		WithArgs(3, sqlmock.AnyArg(), sqlmock.AnyArg(), 12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET `sent_at` = ? WHERE (`outbox`.`id` IN (?,?))")).
+		WithArgs(sqlmock.AnyArg(), 11, 13).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	expectRelease(mock)
+
+	published := []int{}
+	persistenceOutbox := PersistenceOutbox{db: db}
+	sent, parked, err := persistenceOutbox.RelayPending(context.Background(), 10, 3, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			published = append(published, event.Id)
+			if event.Id == 12 {
+				return nil, fmt.Errorf("sink unavailable")
+			}
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Fail Publish Parked Lets The Others Go On", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, 1, parked)
+		assert.Equal(t, []int{11, 12, 13}, published)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_RelayPending_FailFetch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(pendingQuery)).WillReturnError(fmt.Errorf("connection lost"))
+	mock.ExpectRollback()
+
+	persistenceOutbox := PersistenceOutbox{db: db}
+	_, _, err = persistenceOutbox.RelayPending(context.Background(), 10, 10, time.Minute,
+		func(event *models.OutboxEvent) ([]string, error) {
+			return nil, nil
+		})
+	t.Run("Test RelayPending - Fail Fetch", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchPending.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceOutbox_DeleteSent_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	before := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `outbox` WHERE (`outbox`.`sent_at` < ?) ORDER BY id LIMIT 500;")).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	persistenceOutbox := PersistenceOutbox{db: db}
+	deleted, err := persistenceOutbox.DeleteSent(context.Background(), before, 500)
+	t.Run("Test DeleteSent - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+	})
+}
@@ -0,0 +1,127 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"time"
+)
+
+var (
+	errCampaignNotFound     = errors.New("error, campaign not found")
+	errFetchCampaign        = errors.New("error fetching campaign")
+	errFetchCampaignTokens  = errors.New("error fetching campaign tokens")
+	errUpdateCampaignRevoke = errors.New("error updating campaign as revoked")
+)
+
+// RevokeCampaign marks the campaign revoked, unless it already is, so no more tokens can be minted into it.
+// It waits for the tokens being minted into the campaign, which keep it share locked, so they are revoked with the others.
+func (p *PersistenceToken) RevokeCampaign(ctx context.Context, campaignId int) error {
+	return p.inTx(ctx, "error_revoke_campaign", func(tx *sql.Tx) error {
+		return revokeCampaign(ctx, tx, campaignId, time.Now())
+	})
+}
+
+func revokeCampaign(ctx context.Context, tx *sql.Tx, campaignId int, now time.Time) error {
+	campaign, err := models_schema.Campaigns(
+		qm.Select(models_schema.CampaignColumns.ID, models_schema.CampaignColumns.RevokedAt),
+		models_schema.CampaignWhere.ID.EQ(campaignId),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrNotFound.Wrap(errCampaignNotFound)
+		}
+		return errors.Wrap(err, errFetchCampaign.Error())
+	}
+	if campaign.RevokedAt.Valid {
+		return nil
+	}
+	_, err = models_schema.Campaigns(
+		models_schema.CampaignWhere.ID.EQ(campaignId),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.CampaignColumns.RevokedAt: now,
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateCampaignRevoke.Error())
+	}
+	return nil
+}
+
+// RevokeCampaignTokens revokes up to limit tokens of the campaign that aren't revoked yet, with an id above afterId,
+// lowest id first, and returns references to them. The tokens are locked until they are revoked,
+// and their revoked events are written to the outbox along with them.
+func (p *PersistenceToken) RevokeCampaignTokens(ctx context.Context, campaignId int, afterId int,
+	limit int) ([]models.TokenRef, error) {
+	var refs []models.TokenRef
+	err := p.inTx(ctx, "error_revoke_campaign_tokens", func(tx *sql.Tx) error {
+		var err error
+		refs, err = revokeCampaignTokens(ctx, tx, campaignId, afterId, limit, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func revokeCampaignTokens(ctx context.Context, tx *sql.Tx, campaignId int, afterId int, limit int,
+	now time.Time) ([]models.TokenRef, error) {
+	refs := []models.TokenRef{}
+	err := models_schema.Tokens(
+		qm.Select(models_schema.TokenColumns.ID, models_schema.TokenColumns.KeyPrefix),
+		models_schema.TokenWhere.CampaignID.EQ(null.IntFrom(campaignId)),
+		models_schema.TokenWhere.Revoked.EQ(false),
+		models_schema.TokenWhere.ID.GT(afterId),
+		qm.OrderBy(models_schema.TokenColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE"),
+	).Bind(ctx, tx, &refs)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchCampaignTokens.Error())
+	}
+	if len(refs) == 0 {
+		return refs, nil
+	}
+
+	ids := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.Id)
+	}
+	_, err = models_schema.Tokens(
+		models_schema.TokenWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.TokenColumns.Revoked: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateTokenToRevoked.Error())
+	}
+	if err = insertOutbox(ctx, tx, models.TokenLifecycleRevoked, now, refs...); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// checkCampaign fails when the campaign was revoked. The campaign stays share locked until the transaction ends,
+// so revoking it waits for the tokens being minted into it, and revokes them too.
+func checkCampaign(ctx context.Context, tx *sql.Tx, campaignId int) error {
+	campaign, err := models_schema.Campaigns(
+		qm.Select(models_schema.CampaignColumns.ID, models_schema.CampaignColumns.RevokedAt),
+		models_schema.CampaignWhere.ID.EQ(campaignId),
+		qm.For("SHARE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrNotFound.Wrap(errCampaignNotFound)
+		}
+		return errors.Wrap(err, errFetchCampaign.Error())
+	}
+	if campaign.RevokedAt.Valid {
+		return models.ErrCampaignRevoked
+	}
+	return nil
+}
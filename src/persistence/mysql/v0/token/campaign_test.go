@@ -0,0 +1,175 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const lockCampaignQuery = "SELECT `id`, `revoked_at` FROM `campaign` WHERE (`campaign`.`id` = ?) LIMIT 1 FOR UPDATE;"
+
+const shareCampaignQuery = "SELECT `id`, `revoked_at` FROM `campaign` WHERE (`campaign`.`id` = ?) LIMIT 1 FOR SHARE;"
+
+func TestPersistenceToken_RevokeCampaign_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockCampaignQuery)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked_at"}).AddRow(4, nil))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `campaign` SET `revoked_at` = ? WHERE (`campaign`.`id` = ?)")).
+		WithArgs(sqlmock.AnyArg(), 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.RevokeCampaign(context.Background(), 4)
+	t.Run("Test RevokeCampaign - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_RevokeCampaign_HappyPath_AlreadyRevoked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockCampaignQuery)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked_at"}).AddRow(4, time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)))
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.RevokeCampaign(context.Background(), 4)
+	t.Run("Test RevokeCampaign - Happy Path Already Revoked", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_RevokeCampaign_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockCampaignQuery)).WithArgs(9).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.RevokeCampaign(context.Background(), 9)
+	t.Run("Test RevokeCampaign - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+const campaignTokensQuery = "SELECT `id`, `key_prefix` FROM `token` WHERE (`token`.`campaign_id` = ?) AND " +
+	"(`token`.`revoked` = ?) AND (`token`.`id` > ?) ORDER BY id LIMIT 2 FOR UPDATE;"
+
+func TestPersistenceToken_RevokeCampaignTokens_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(campaignTokensQuery)).
+		WithArgs(4, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(3, "inv_3k").AddRow(5, "inv_9x"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `revoked` = ? WHERE (`token`.`id` IN (?,?))")).
+		WithArgs(true, 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	expectOutboxInsert(mock, models.TokenLifecycleRevoked, 3, 5)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	refs, err := persistenceToken.RevokeCampaignTokens(context.Background(), 4, 1, 2)
+	t.Run("Test RevokeCampaignTokens - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []models.TokenRef{{Id: 3, KeyPrefix: "inv_3k"}, {Id: 5, KeyPrefix: "inv_9x"}}, refs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_RevokeCampaignTokens_HappyPath_NoneLeft(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(campaignTokensQuery)).
+		WithArgs(4, false, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}))
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	refs, err := persistenceToken.RevokeCampaignTokens(context.Background(), 4, 5, 2)
+	t.Run("Test RevokeCampaignTokens - Happy Path None Left", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_HappyPath_InCampaign(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(shareCampaignQuery)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked_at"}).AddRow(4, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 3, sqlmock.AnyArg(),
+			nil, nil, nil, nil, nil, 4).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(1, false, false, 0))
+	expectOutboxInsert(mock, models.TokenLifecycleCreated, 1)
+	mock.ExpectCommit()
+
+	campaignId := 4
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	keys, err := persistenceToken.GenerateBatch(context.Background(), &models.NewToken{
+		CreatedBy:  3,
+		CampaignId: &campaignId,
+		ExpiresAt:  time.Now().Add(72 * time.Hour),
+	}, 1, 6, 12)
+	t.Run("Test GenerateBatch - Happy Path In Campaign", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_Fail_CampaignRevoked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(shareCampaignQuery)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked_at"}).AddRow(4, time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)))
+	mock.ExpectRollback()
+
+	campaignId := 4
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.GenerateBatch(context.Background(), &models.NewToken{
+		CreatedBy:  3,
+		CampaignId: &campaignId,
+		ExpiresAt:  time.Now().Add(72 * time.Hour),
+	}, 2, 6, 12)
+	t.Run("Test GenerateBatch - Fail Campaign Revoked Rolls Back", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrCampaignRevoked)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,46 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+)
+
+var (
+	errFetchTokenEvents = errors.New("error fetching token events")
+	errInsertTokenEvent = errors.New("error inserting token event")
+)
+
+// CreateTokenEvent records a single use of a token
+func (p *PersistenceToken) CreateTokenEvent(ctx context.Context, event *models.NewTokenEvent) error {
+	eventEntry := models_schema.TokenEvent{
+		TokenID:   null.IntFromPtr(event.TokenId),
+		Action:    event.Action,
+		Outcome:   event.Outcome,
+		IP:        event.Ip,
+		UserAgent: event.UserAgent,
+		RequestID: event.RequestId,
+	}
+	err := eventEntry.Insert(ctx, p.db, boil.Infer())
+	if err != nil {
+		return errors.Wrap(err, errInsertTokenEvent.Error())
+	}
+	return nil
+}
+
+// GetTokenEvents returns the token's events, most recent first
+func (p *PersistenceToken) GetTokenEvents(ctx context.Context, tokenId int) ([]models.TokenEvent, error) {
+	container := []models.TokenEvent{}
+	err := models_schema.TokenEvents(
+		models_schema.TokenEventWhere.TokenID.EQ(null.IntFrom(tokenId)),
+		qm.OrderBy(models_schema.TokenEventColumns.ID+" DESC"),
+	).Bind(ctx, p.db, &container)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchTokenEvents.Error())
+	}
+	return container, nil
+}
@@ -0,0 +1,100 @@
+package token
+
+import (
+	"context"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestPersistenceToken_CreateTokenEvent_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	tokenId := 3
+	sqlInsert := "INSERT INTO `token_event` (`token_id`,`action`,`outcome`,`ip`,`user_agent`,`request_id`,`created_at`) VALUES (?,?,?,?,?,?,?)"
+	mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).WithArgs(
+		tokenId,
+		models.TokenEventActionValidate,
+		models.TokenEventOutcomeValid,
+		"127.0.0.1",
+		"curl/8.0",
+		"abc",
+		sqlmock.AnyArg(),
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.CreateTokenEvent(context.Background(), &models.NewTokenEvent{
+		TokenId:   &tokenId,
+		Action:    models.TokenEventActionValidate,
+		Outcome:   models.TokenEventOutcomeValid,
+		Ip:        "127.0.0.1",
+		UserAgent: "curl/8.0",
+		RequestId: "abc",
+	})
+	t.Run("Test CreateTokenEvent - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_CreateTokenEvent_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO `token_event`.*").WillReturnError(errInsertTokenEvent)
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.CreateTokenEvent(context.Background(), &models.NewTokenEvent{
+		Action:  models.TokenEventActionValidate,
+		Outcome: models.TokenEventOutcomeNotFound,
+	})
+	t.Run("Test CreateTokenEvent - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errInsertTokenEvent.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestPersistenceToken_GetTokenEvents_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	sqlFetchEvents := "SELECT `token_event`.* FROM `token_event` WHERE (`token_event`.`token_id` = ?) ORDER BY id DESC;"
+	rows := sqlmock.NewRows([]string{"id", "token_id", "action", "outcome", "ip", "user_agent", "request_id", "created_at"}).
+		AddRow(2, 3, "validate", "valid", "127.0.0.1", "curl/8.0", "abc", time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchEvents)).WithArgs(3).WillReturnRows(rows)
+
+	persistenceToken := PersistenceToken{db: db}
+	events, err := persistenceToken.GetTokenEvents(context.Background(), 3)
+	t.Run("Test GetTokenEvents - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "127.0.0.1", events[0].Ip)
+		assert.Equal(t, "curl/8.0", events[0].UserAgent)
+		assert.Equal(t, "abc", events[0].RequestId)
+	})
+}
+
+func TestPersistenceToken_GetTokenEvents_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT `token_event`.*").WillReturnError(errFetchTokenEvents)
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetTokenEvents(context.Background(), 3)
+	t.Run("Test GetTokenEvents - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errFetchTokenEvents.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
@@ -0,0 +1,121 @@
+package token
+
+import (
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"strings"
+	"time"
+)
+
+// likeEscaper escapes the LIKE wildcards, so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filterQueryMods converts the query into where clauses, ordering and limit for the token table
+func filterQueryMods(query *models.TokenQuery, now time.Time) []qm.QueryMod {
+	var queryMods []qm.QueryMod
+	if query == nil {
+		return queryMods
+	}
+
+	if query.Label != "" {
+		queryMods = append(queryMods, models_schema.TokenWhere.Label.EQ(null.StringFrom(query.Label)))
+	}
+	if query.RecipientEmail != "" {
+		queryMods = append(queryMods,
+			models_schema.TokenWhere.RecipientEmail.EQ(null.StringFrom(query.RecipientEmail)))
+	}
+	if query.Search != "" {
+		search := "%" + likeEscaper.Replace(query.Search) + "%"
+		queryMods = append(queryMods, qm.Expr(
+			qm.Where(models_schema.TokenTableColumns.Label+" LIKE ?", search),
+			qm.Or(models_schema.TokenTableColumns.Note+" LIKE ?", search),
+			qm.Or(models_schema.TokenTableColumns.RecipientEmail+" LIKE ?", search),
+		))
+	}
+
+	switch query.Status {
+	case models.TokenStatusActive:
+		queryMods = append(queryMods, activeQueryMods(now)...)
+	case models.TokenStatusRevoked:
+		queryMods = append(queryMods, models_schema.TokenWhere.Revoked.EQ(true))
+	case models.TokenStatusExpired:
+		queryMods = append(queryMods,
+			models_schema.TokenWhere.Revoked.EQ(false),
+			qm.Expr(
+				models_schema.TokenWhere.Expired.EQ(true),
+				qm.Or2(models_schema.TokenWhere.ExpiresAt.LTE(now)),
+			),
+		)
+	}
+
+	if query.CreatedBy != 0 {
+		queryMods = append(queryMods, models_schema.TokenWhere.CreatedBy.EQ(query.CreatedBy))
+	}
+	if query.CampaignId != 0 {
+		queryMods = append(queryMods, models_schema.TokenWhere.CampaignID.EQ(null.IntFrom(query.CampaignId)))
+	}
+	if query.CreatedAfter != nil {
+		queryMods = append(queryMods, models_schema.TokenWhere.CreatedAt.GTE(*query.CreatedAfter))
+	}
+	if query.CreatedBefore != nil {
+		queryMods = append(queryMods, models_schema.TokenWhere.CreatedAt.LT(*query.CreatedBefore))
+	}
+	if query.ExpiresAfter != nil {
+		queryMods = append(queryMods, models_schema.TokenWhere.ExpiresAt.GTE(*query.ExpiresAfter))
+	}
+	if query.ExpiresBefore != nil {
+		queryMods = append(queryMods, models_schema.TokenWhere.ExpiresAt.LT(*query.ExpiresBefore))
+	}
+
+	return append(queryMods, pageQueryMods(query)...)
+}
+
+// activeQueryMods matches the tokens that are neither revoked nor expired by now
+func activeQueryMods(now time.Time) []qm.QueryMod {
+	return []qm.QueryMod{
+		models_schema.TokenWhere.Revoked.EQ(false),
+		models_schema.TokenWhere.Expired.EQ(false),
+		models_schema.TokenWhere.ExpiresAt.GT(now),
+	}
+}
+
+// pageQueryMods orders the tokens by the sort column then id, and continues after the cursor
+func pageQueryMods(query *models.TokenQuery) []qm.QueryMod {
+	var queryMods []qm.QueryMod
+
+	sortWhere := models_schema.TokenWhere.CreatedAt
+	sortColumn := models_schema.TokenTableColumns.CreatedAt
+	if query.SortBy == models.TokenSortExpiresAt {
+		sortWhere = models_schema.TokenWhere.ExpiresAt
+		sortColumn = models_schema.TokenTableColumns.ExpiresAt
+	}
+
+	if query.After != nil {
+		value, id := query.After.Value, query.After.Id
+		if query.SortDesc {
+			queryMods = append(queryMods, qm.Expr(
+				sortWhere.LT(value),
+				qm.Or2(qm.Expr(sortWhere.EQ(value), models_schema.TokenWhere.ID.LT(id))),
+			))
+		} else {
+			queryMods = append(queryMods, qm.Expr(
+				sortWhere.GT(value),
+				qm.Or2(qm.Expr(sortWhere.EQ(value), models_schema.TokenWhere.ID.GT(id))),
+			))
+		}
+	}
+
+	direction := " ASC"
+	if query.SortDesc {
+		direction = " DESC"
+	}
+	queryMods = append(queryMods,
+		qm.OrderBy(sortColumn+direction+", "+models_schema.TokenTableColumns.ID+direction))
+
+	if query.Limit > 0 {
+		queryMods = append(queryMods, qm.Limit(query.Limit))
+	}
+	return queryMods
+}
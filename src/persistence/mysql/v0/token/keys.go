@@ -0,0 +1,69 @@
+package token
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+)
+
+var (
+	errFetchPlaintextKeys = errors.New("error fetching plaintext keys")
+	errHashPlaintextKey   = errors.New("error hashing plaintext key")
+)
+
+// tokenColumns are the columns bound to models.Token, leaving out the key hash
+var tokenColumns = []string{
+	models_schema.TokenTableColumns.ID,
+	models_schema.TokenTableColumns.KeyPrefix,
+	models_schema.TokenTableColumns.CreatedAt,
+	models_schema.TokenTableColumns.Revoked,
+	models_schema.TokenTableColumns.Expired,
+	models_schema.TokenTableColumns.CreatedBy,
+	models_schema.TokenTableColumns.ExpiresAt,
+	models_schema.TokenTableColumns.MaxUses,
+	models_schema.TokenTableColumns.UseCount,
+	models_schema.TokenTableColumns.Label,
+	models_schema.TokenTableColumns.Note,
+	models_schema.TokenTableColumns.RecipientEmail,
+	models_schema.TokenTableColumns.NotBefore,
+	models_schema.TokenTableColumns.CampaignID,
+}
+
+// hashKey returns the keyed hash a token is stored and looked up by
+func (p *PersistenceToken) hashKey(key string) string {
+	mac := hmac.New(sha256.New, p.keySecret)
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HashPlaintextKeys hashes up to limit tokens still stored with a plaintext key, and clears the plaintext.
+// It returns how many were hashed, and is only meant for migrating rows created before keys were hashed,
+// while the legacy "key" column still exists.
+func (p *PersistenceToken) HashPlaintextKeys(ctx context.Context, limit int) (int, error) {
+	var plaintextKeys []struct {
+		Id  int    `boil:"id"`
+		Key string `boil:"key"`
+	}
+	err := queries.Raw(
+		"SELECT `id`, `key` FROM `token` WHERE `key_hash` IS NULL AND `key` IS NOT NULL LIMIT ?",
+		limit,
+	).Bind(ctx, p.db, &plaintextKeys)
+	if err != nil {
+		return 0, errors.Wrap(err, errFetchPlaintextKeys.Error())
+	}
+
+	for i, plaintextKey := range plaintextKeys {
+		_, err = queries.Raw(
+			"UPDATE `token` SET `key_hash` = ?, `key_prefix` = ?, `key` = NULL WHERE `id` = ?",
+			p.hashKey(plaintextKey.Key), p.keyFormat.VisiblePrefix(plaintextKey.Key), plaintextKey.Id,
+		).ExecContext(ctx, p.db)
+		if err != nil {
+			return i, errors.Wrap(err, errHashPlaintextKey.Error())
+		}
+	}
+	return len(plaintextKeys), nil
+}
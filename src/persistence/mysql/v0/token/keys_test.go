@@ -0,0 +1,124 @@
+package token
+
+import (
+	"context"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+)
+
+func TestPersistenceToken_hashKey(t *testing.T) {
+	persistenceToken := PersistenceToken{keySecret: []byte("0123456789abcdef0123456789abcdef")}
+	otherSecret := PersistenceToken{keySecret: []byte("fedcba9876543210fedcba9876543210")}
+
+	t.Run("Test hashKey", func(t *testing.T) {
+		hash := persistenceToken.hashKey("abc123")
+		assert.Len(t, hash, 64)
+		assert.Equal(t, hash, persistenceToken.hashKey("abc123"))
+		assert.NotEqual(t, hash, persistenceToken.hashKey("abc124"))
+		assert.NotEqual(t, hash, otherSecret.hashKey("abc123"))
+	})
+}
+
+func TestPersistenceToken_GetToken_LooksUpByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	persistenceToken := PersistenceToken{db: db, keySecret: []byte("0123456789abcdef0123456789abcdef")}
+
+	rows := sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(4, "ab")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.`id`, `token`.`key_prefix`, ")).
+		WithArgs(persistenceToken.hashKey("abc123")).
+		WillReturnRows(rows)
+
+	token, err := persistenceToken.GetToken(context.Background(), "abc123")
+	t.Run("Test GetToken - Looks Up By Hash", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, token.Id)
+		assert.Equal(t, "ab", token.KeyPrefix)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_RevokeTokenById_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	rows := sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(4, "inv_4p")
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`id` = ?) AND (`token`.`revoked` = ?) LIMIT 1 FOR UPDATE")).
+		WithArgs(4, false).
+		WillReturnRows(rows)
+	mock.ExpectExec("UPDATE `token` .*").WillReturnResult(sqlmock.NewResult(1, 1))
+	expectOutboxInsert(mock, models.TokenLifecycleRevoked, 4)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	ref, err := persistenceToken.RevokeTokenById(context.Background(), 4)
+	t.Run("Test RevokeTokenById - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, ref.Id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_HashPlaintextKeys_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	persistenceToken := PersistenceToken{db: db, keySecret: []byte("0123456789abcdef0123456789abcdef")}
+
+	rows := sqlmock.NewRows([]string{"id", "key"}).AddRow(1, "abc123").AddRow(2, "def456")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key` FROM `token` WHERE `key_hash` IS NULL AND `key` IS NOT NULL LIMIT ?")).
+		WithArgs(100).
+		WillReturnRows(rows)
+	sqlUpdate := "UPDATE `token` SET `key_hash` = ?, `key_prefix` = ?, `key` = NULL WHERE `id` = ?"
+	mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).
+		WithArgs(persistenceToken.hashKey("abc123"), "ab", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).
+		WithArgs(persistenceToken.hashKey("def456"), "de", 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	hashed, err := persistenceToken.HashPlaintextKeys(context.Background(), 100)
+	t.Run("Test HashPlaintextKeys - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 2, hashed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_HashPlaintextKeys_FailUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	rows := sqlmock.NewRows([]string{"id", "key"}).AddRow(1, "abc123").AddRow(2, "def456")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key` FROM `token`")).WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `key_hash` = ?")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `key_hash` = ?")).WillReturnError(errHashPlaintextKey)
+
+	persistenceToken := PersistenceToken{db: db}
+	hashed, err := persistenceToken.HashPlaintextKeys(context.Background(), 100)
+	t.Run("Test HashPlaintextKeys - Fail Update", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errHashPlaintextKey.Error())
+		assert.Equal(t, 1, hashed)
+	})
+}
+
+func TestPersistenceToken_HashPlaintextKeys_FailFetch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key` FROM `token`")).WillReturnError(errFetchPlaintextKeys)
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.HashPlaintextKeys(context.Background(), 100)
+	t.Run("Test HashPlaintextKeys - Fail Fetch", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchPlaintextKeys.Error())
+	})
+}
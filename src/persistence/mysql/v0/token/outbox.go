@@ -0,0 +1,75 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/friendsofgo/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/common"
+	"strings"
+	"time"
+)
+
+var (
+	errEncodeOutboxEvent = errors.New("error encoding outbox event")
+	errInsertOutbox      = errors.New("error inserting outbox events")
+)
+
+// insertOutbox records an event for each token in the outbox. It is given the executor of the
+// change it records, so the events are written if, and only if, the change is.
+func insertOutbox(ctx context.Context, exec boil.ContextExecutor, eventType string, now time.Time,
+	refs ...models.TokenRef) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(refs))
+	args := make([]interface{}, 0, len(refs)*4)
+	for _, ref := range refs {
+		payload, err := json.Marshal(&models.TokenLifecycleEvent{
+			Type:       eventType,
+			Token:      ref,
+			OccurredAt: now,
+		})
+		if err != nil {
+			return errors.Wrap(err, errEncodeOutboxEvent.Error())
+		}
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, ref.Id, eventType, string(payload), now)
+	}
+
+	_, err := queries.Raw(
+		"INSERT INTO `outbox` (`token_id`, `event_type`, `payload`, `created_at`) VALUES "+
+			strings.Join(placeholders, ", "),
+		args...,
+	).ExecContext(ctx, exec)
+	if err != nil {
+		return errors.Wrap(err, errInsertOutbox.Error())
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction, which is committed when fn succeeds, and rolled back otherwise.
+// fn's error is returned as is, and failing to roll back is logged under logMsg.
+func (p *PersistenceToken) inTx(ctx context.Context, logMsg string, fn func(tx *sql.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errBeginTransaction.Error())
+	}
+	if err = fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			common.GetLogger(ctx).WithFields(logrus.Fields{
+				"err": errors.Wrap(rollbackErr, errRollbackTransaction.Error()),
+			}).Error(logMsg)
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errCommitTransaction.Error())
+	}
+	return nil
+}
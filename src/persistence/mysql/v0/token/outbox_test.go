@@ -0,0 +1,74 @@
+package token
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// expectOutboxInsert expects the event to be written to the outbox for each of the tokens
+func expectOutboxInsert(mock sqlmock.Sqlmock, eventType string, tokenIds ...int) {
+	args := make([]driver.Value, 0, len(tokenIds)*4)
+	for _, id := range tokenIds {
+		args = append(args, id, eventType, sqlmock.AnyArg(), sqlmock.AnyArg())
+	}
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox` (`token_id`, `event_type`, `payload`, `created_at`) VALUES " +
+		strings.Repeat("(?, ?, ?, ?), ", len(tokenIds)-1) + "(?, ?, ?, ?)")).
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(0, int64(len(tokenIds))))
+}
+
+// payloadArg captures the payload written to the outbox
+type payloadArg struct {
+	payload *string
+}
+
+func (a payloadArg) Match(v driver.Value) bool {
+	*a.payload, _ = v.(string)
+	return true
+}
+
+func TestInsertOutbox_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+	var payload string
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox` (`token_id`, `event_type`, `payload`, `created_at`) VALUES (?, ?, ?, ?), (?, ?, ?, ?)")).
+		WithArgs(3, models.TokenLifecycleExpired, sqlmock.AnyArg(), now, 5, models.TokenLifecycleExpired, payloadArg{&payload}, now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = insertOutbox(context.Background(), db, models.TokenLifecycleExpired, now,
+		models.TokenRef{Id: 3, KeyPrefix: "inv_3k"}, models.TokenRef{Id: 5, KeyPrefix: "inv_5m"})
+	t.Run("Test insertOutbox - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		var event models.TokenLifecycleEvent
+		require.NoError(t, json.Unmarshal([]byte(payload), &event))
+		assert.Equal(t, models.TokenLifecycleEvent{
+			Type:       models.TokenLifecycleExpired,
+			Token:      models.TokenRef{Id: 5, KeyPrefix: "inv_5m"},
+			OccurredAt: now,
+		}, event)
+	})
+}
+
+func TestInsertOutbox_NoTokens(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	err = insertOutbox(context.Background(), db, models.TokenLifecycleExpired, time.Now())
+	t.Run("Test insertOutbox - No Tokens", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
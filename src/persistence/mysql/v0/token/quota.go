@@ -0,0 +1,74 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"time"
+)
+
+var (
+	errCountActiveTokens = errors.New("error counting active tokens")
+	errFetchUserQuota    = errors.New("error fetching user token quota")
+	errUserNotFound      = errors.New("error, user not found")
+)
+
+// GetUserTokenQuota returns the user's own cap on active tokens, or nil when the user has none
+func (p *PersistenceToken) GetUserTokenQuota(ctx context.Context, userId int) (*int, error) {
+	user, err := models_schema.Users(
+		qm.Select(models_schema.UserColumns.ID, models_schema.UserColumns.ActiveTokenQuota),
+		models_schema.UserWhere.ID.EQ(userId),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound.Wrap(errUserNotFound)
+		}
+		return nil, errors.Wrap(err, errFetchUserQuota.Error())
+	}
+	return user.ActiveTokenQuota.Ptr(), nil
+}
+
+// CountActiveTokens returns how many of the user's tokens are neither revoked nor expired by now
+func (p *PersistenceToken) CountActiveTokens(ctx context.Context, userId int, now time.Time) (int, error) {
+	return countActiveTokens(ctx, p.db, userId, now)
+}
+
+func countActiveTokens(ctx context.Context, exec boil.ContextExecutor, userId int, now time.Time) (int, error) {
+	queryMods := append([]qm.QueryMod{models_schema.TokenWhere.CreatedBy.EQ(userId)}, activeQueryMods(now)...)
+	count, err := models_schema.Tokens(queryMods...).Count(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, errCountActiveTokens.Error())
+	}
+	return int(count), nil
+}
+
+// checkQuota fails when count more active tokens would take the creator past the quota.
+// The creator's row stays locked until the transaction ends, so concurrent generations
+// by the same creator are counted one after the other, and can't overshoot the quota together.
+func checkQuota(ctx context.Context, tx *sql.Tx, userId int, quota int, count int) error {
+	_, err := models_schema.Users(
+		qm.Select(models_schema.UserColumns.ID),
+		models_schema.UserWhere.ID.EQ(userId),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrNotFound.Wrap(errUserNotFound)
+		}
+		return errors.Wrap(err, errFetchUserQuota.Error())
+	}
+
+	active, err := countActiveTokens(ctx, tx, userId, time.Now())
+	if err != nil {
+		return err
+	}
+	if active+count > quota {
+		return models.ErrTokenQuotaExceeded.Detail(fmt.Sprintf("%v of %v active tokens in use", active, quota))
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const countActiveQuery = "SELECT COUNT(*) FROM `token` WHERE (`token`.`created_by` = ?) AND (`token`.`revoked` = ?) AND " +
+	"(`token`.`expired` = ?) AND (`token`.`expires_at` > ?);"
+
+// expectQuotaCheck expects the creator's row to be locked, and their active tokens counted
+func expectQuotaCheck(mock sqlmock.Sqlmock, userId int, active int) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `user` WHERE (`user`.`id` = ?) LIMIT 1 FOR UPDATE;")).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(userId))
+	mock.ExpectQuery(regexp.QuoteMeta(countActiveQuery)).
+		WithArgs(userId, false, false, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(active))
+}
+
+func TestPersistenceToken_GetUserTokenQuota_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `active_token_quota` FROM `user` WHERE (`user`.`id` = ?) LIMIT 1;")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "active_token_quota"}).AddRow(3, 25))
+
+	persistenceToken := PersistenceToken{db: db}
+	quota, err := persistenceToken.GetUserTokenQuota(context.Background(), 3)
+	t.Run("Test GetUserTokenQuota - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.NotNil(t, quota)
+		assert.Equal(t, 25, *quota)
+	})
+}
+
+func TestPersistenceToken_GetUserTokenQuota_HappyPath_NoOverride(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `active_token_quota` FROM `user` WHERE (`user`.`id` = ?) LIMIT 1;")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "active_token_quota"}).AddRow(3, nil))
+
+	persistenceToken := PersistenceToken{db: db}
+	quota, err := persistenceToken.GetUserTokenQuota(context.Background(), 3)
+	t.Run("Test GetUserTokenQuota - Happy Path No Override", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Nil(t, quota)
+	})
+}
+
+func TestPersistenceToken_CountActiveTokens_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(countActiveQuery)).
+		WithArgs(3, false, false, now).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+
+	persistenceToken := PersistenceToken{db: db}
+	active, err := persistenceToken.CountActiveTokens(context.Background(), 3, now)
+	t.Run("Test CountActiveTokens - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 42, active)
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_HappyPath_WithinQuota(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	expectQuotaCheck(mock, 3, 8)
+	for i := 1; i <= 2; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+			WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+			WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(i, false, false, 0))
+		expectOutboxInsert(mock, models.TokenLifecycleCreated, i)
+	}
+	mock.ExpectCommit()
+
+	quota := 10
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	keys, err := persistenceToken.GenerateBatch(context.Background(), &models.NewToken{
+		CreatedBy:   3,
+		ActiveQuota: &quota,
+		ExpiresAt:   time.Now().Add(72 * time.Hour),
+	}, 2, 6, 12)
+	t.Run("Test GenerateBatch - Happy Path Within Quota", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_Fail_QuotaExceeded(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	expectQuotaCheck(mock, 3, 9)
+	mock.ExpectRollback()
+
+	quota := 10
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.GenerateBatch(context.Background(), &models.NewToken{
+		CreatedBy:   3,
+		ActiveQuota: &quota,
+		ExpiresAt:   time.Now().Add(72 * time.Hour),
+	}, 2, 6, 12)
+	t.Run("Test GenerateBatch - Fail Quota Exceeded Rolls Back", func(t *testing.T) {
+		assert.ErrorIs(t, err, models.ErrTokenQuotaExceeded)
+		assert.Contains(t, err.Error(), "9 of 10 active tokens in use")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_Fail_LockCreator(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `user` WHERE (`user`.`id` = ?) LIMIT 1 FOR UPDATE;")).
+		WillReturnError(fmt.Errorf("lock wait timeout"))
+	mock.ExpectRollback()
+
+	quota := 10
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GenerateBatch(context.Background(), &models.NewToken{CreatedBy: 3, ActiveQuota: &quota}, 1, 6, 12)
+	t.Run("Test GenerateBatch - Fail Lock Creator", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchUserQuota.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
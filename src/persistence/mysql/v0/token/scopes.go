@@ -0,0 +1,54 @@
+package token
+
+import (
+	"context"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"strings"
+)
+
+var (
+	errFetchTokenScopes = errors.New("error fetching token scopes")
+	errInsertTokenScope = errors.New("error inserting token scopes")
+)
+
+// GetTokenScopes returns the token's scopes, in alphabetical order
+func (p *PersistenceToken) GetTokenScopes(ctx context.Context, tokenId int) ([]string, error) {
+	entries, err := models_schema.TokenScopes(
+		models_schema.TokenScopeWhere.TokenID.EQ(tokenId),
+		qm.OrderBy(models_schema.TokenScopeColumns.Scope),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchTokenScopes.Error())
+	}
+
+	scopes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		scopes = append(scopes, entry.Scope)
+	}
+	return scopes, nil
+}
+
+// insertScopes adds the token's scopes in a single statement
+func insertScopes(ctx context.Context, exec boil.ContextExecutor, tokenId int, scopes []string) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(scopes)*2)
+	for _, scope := range scopes {
+		args = append(args, tokenId, scope)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("(?,?),", len(scopes)), ",")
+	_, err := queries.Raw(
+		"INSERT INTO `token_scope` (`token_id`, `scope`) VALUES "+placeholders,
+		args...,
+	).ExecContext(ctx, exec)
+	if err != nil {
+		return errors.Wrap(err, errInsertTokenScope.Error())
+	}
+	return nil
+}
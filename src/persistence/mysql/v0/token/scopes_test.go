@@ -0,0 +1,101 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestPersistenceToken_GetTokenScopes_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token_scope`.* FROM `token_scope` WHERE (`token_scope`.`token_id` = ?) ORDER BY scope;")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"token_id", "scope"}).AddRow(4, "beta:analytics").AddRow(4, "org:42"))
+
+	persistenceToken := PersistenceToken{db: db}
+	scopes, err := persistenceToken.GetTokenScopes(context.Background(), 4)
+	t.Run("Test GetTokenScopes - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []string{"beta:analytics", "org:42"}, scopes)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GetTokenScopes_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token_scope`.*")).WillReturnError(fmt.Errorf("connection lost"))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetTokenScopes(context.Background(), 4)
+	t.Run("Test GetTokenScopes - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchTokenScopes.Error())
+	})
+}
+
+func TestPersistenceToken_Generate_HappyPath_Scopes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(7, false, false, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token_scope` (`token_id`, `scope`) VALUES (?,?),(?,?)")).
+		WithArgs(7, "beta:analytics", 7, "org:42").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	expectOutboxInsert(mock, models.TokenLifecycleCreated, 7)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.Generate(context.Background(), &models.NewToken{
+		CreatedBy: 3,
+		ExpiresAt: time.Now().Add(72 * time.Hour),
+		Scopes:    []string{"beta:analytics", "org:42"},
+	}, 8, 8)
+	t.Run("Test Generate Happy Path Scopes", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_Generate_FailInsertScopesRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(7, false, false, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token_scope`")).
+		WillReturnError(fmt.Errorf("duplicate entry"))
+	mock.ExpectRollback()
+
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.Generate(context.Background(), &models.NewToken{
+		CreatedBy: 3,
+		ExpiresAt: time.Now().Add(72 * time.Hour),
+		Scopes:    []string{"beta:analytics"},
+	}, 8, 8)
+	t.Run("Test Generate Fail Insert Scopes Rolls Back", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errInsertTokenScope.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -6,106 +6,483 @@ import (
 	"github.com/friendsofgo/errors"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
-	"math/rand"
 	"platform_engineer_clone/models"
 	"platform_engineer_clone/src/persistence/mysql"
 	"platform_engineer_clone/src/persistence/mysql/models_schema"
 	"platform_engineer_clone/src/utils/common"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
 	"time"
 )
 
 type PersistenceToken struct {
 	db               *sql.DB
+	keySecret        []byte
+	keyGenerator     *keygen.Generator
+	keyFormat        keygen.Format
+	keySigner        *signing.Signer
 	mockRandomString string
 	mockCreatedTime  time.Time
 }
 
 var (
+	errBeginTransaction        = errors.New("error beginning transaction")
 	errCheckUniqueToken        = errors.New("error checking for unique tokens")
+	errCommitTransaction       = errors.New("error committing transaction")
+	errArchiveTokens           = errors.New("error archiving tokens")
+	errDeleteTokens            = errors.New("error deleting tokens")
+	errExpireTokens            = errors.New("error flagging expired tokens")
 	errFetchToken              = errors.New("error fetching token")
 	errFetchTokenByKey         = errors.New("error fetching token by key")
+	errGenerateKey             = errors.New("error generating key")
 	errFetchTokenByKeyNoResult = errors.New("error, fetching token by key yields no results")
+	errFetchPurgeableTokens    = errors.New("error fetching purgeable tokens")
 	errFetchTokens             = errors.New("error fetching tokens")
+	errFetchRevokedTokenIds    = errors.New("error fetching revoked token ids")
 	errInsertNewToken          = errors.New("error inserting new token")
+	errRedeemToken             = errors.New("error redeeming token")
+	errRollbackTransaction     = errors.New("error rolling back transaction")
+	errScanToken               = errors.New("error scanning token")
+	errSignKey                 = errors.New("error signing key")
 	errTokenNotFound           = errors.New("error, token not found")
-	errUpdateTokenToExpired    = errors.New("error updating token as expired")
 	errUpdateTokenToRevoked    = errors.New("error updating token as revoked")
+	errUpdateSignedKey         = errors.New("error updating token with its signed key")
+	errUpdateToken             = errors.New("error updating token")
 )
 
-func (p *PersistenceToken) RevokeToken(ctx context.Context, key string) error {
-	token, err := models_schema.Tokens(
-		models_schema.TokenWhere.Key.EQ(key),
-		models_schema.TokenWhere.Revoked.EQ(false),
-	).One(ctx, p.db)
+// RevokeToken revokes the token, and returns a reference to it
+func (p *PersistenceToken) RevokeToken(ctx context.Context, key string) (*models.TokenRef, error) {
+	return p.revoke(ctx, models_schema.TokenWhere.KeyHash.EQ(p.hashKey(key)))
+}
+
+// RevokeTokenById revokes the token without needing its key, which is only known to whoever created it
+func (p *PersistenceToken) RevokeTokenById(ctx context.Context, id int) (*models.TokenRef, error) {
+	return p.revoke(ctx, models_schema.TokenWhere.ID.EQ(id))
+}
+
+// revoke revokes the token, unless it is already revoked, which is reported as not found.
+// The revoked event is written to the outbox along with it.
+func (p *PersistenceToken) revoke(ctx context.Context, where qm.QueryMod) (*models.TokenRef, error) {
+	var ref *models.TokenRef
+	err := p.inTx(ctx, "error_revoke_token", func(tx *sql.Tx) error {
+		token, err := models_schema.Tokens(
+			where,
+			models_schema.TokenWhere.Revoked.EQ(false),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrNotFound.Wrap(errTokenNotFound)
+			}
+			return errors.Wrap(err, errFetchToken.Error())
+		}
+		token.Revoked = true
+		_, err = token.Update(ctx, tx, boil.Infer())
+		if err != nil {
+			return errors.Wrap(err, errUpdateTokenToRevoked.Error())
+		}
+		ref = &models.TokenRef{Id: token.ID, KeyPrefix: token.KeyPrefix}
+		return insertOutbox(ctx, tx, models.TokenLifecycleRevoked, time.Now(), *ref)
+	})
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return errTokenNotFound
+		return nil, err
+	}
+	return ref, nil
+}
+
+// UpdateToken applies the changes to the token.
+// A new expiry also clears the expired flag, which the sweeper sets again once it passes.
+// Revoking a token that isn't revoked yet writes the revoked event to the outbox along with it.
+func (p *PersistenceToken) UpdateToken(ctx context.Context, id int, changes *models.TokenChanges) error {
+	columns := models_schema.M{}
+	if changes.ExpiresAt != nil {
+		columns[models_schema.TokenColumns.ExpiresAt] = *changes.ExpiresAt
+		columns[models_schema.TokenColumns.Expired] = false
+	}
+	if changes.Revoked != nil {
+		columns[models_schema.TokenColumns.Revoked] = *changes.Revoked
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	if changes.Revoked == nil || !*changes.Revoked {
+		_, err := models_schema.Tokens(
+			models_schema.TokenWhere.ID.EQ(id),
+		).UpdateAll(ctx, p.db, columns)
+		if err != nil {
+			return errors.Wrap(err, errUpdateToken.Error())
+		}
+		return nil
+	}
+
+	return p.inTx(ctx, "error_update_token", func(tx *sql.Tx) error {
+		revoking := []models.TokenRef{}
+		err := models_schema.Tokens(
+			qm.Select(models_schema.TokenColumns.ID, models_schema.TokenColumns.KeyPrefix),
+			models_schema.TokenWhere.ID.EQ(id),
+			models_schema.TokenWhere.Revoked.EQ(false),
+			qm.For("UPDATE"),
+		).Bind(ctx, tx, &revoking)
+		if err != nil {
+			return errors.Wrap(err, errFetchToken.Error())
+		}
+		_, err = models_schema.Tokens(
+			models_schema.TokenWhere.ID.EQ(id),
+		).UpdateAll(ctx, tx, columns)
+		if err != nil {
+			return errors.Wrap(err, errUpdateToken.Error())
 		}
-		return errors.Wrap(err, errFetchToken.Error())
+		return insertOutbox(ctx, tx, models.TokenLifecycleRevoked, time.Now(), revoking...)
+	})
+}
+
+// RedeemToken atomically increments the token's use count, as long as it is still usable
+// and has uses remaining. It returns false when no use could be consumed.
+func (p *PersistenceToken) RedeemToken(ctx context.Context, id int) (bool, error) {
+	res, err := queries.Raw(
+		"UPDATE `token` SET `use_count` = `use_count` + 1 "+
+			"WHERE `id` = ? AND `revoked` = 0 AND `expired` = 0 AND `expires_at` > ? "+
+			"AND (`max_uses` IS NULL OR `use_count` < `max_uses`)",
+		id, time.Now(),
+	).ExecContext(ctx, p.db)
+	if err != nil {
+		return false, errors.Wrap(err, errRedeemToken.Error())
 	}
-	token.Revoked = true
-	_, err = token.Update(ctx, p.db, boil.Infer())
+	rowsAff, err := res.RowsAffected()
 	if err != nil {
-		return errors.Wrap(err, errUpdateTokenToRevoked.Error())
+		return false, errors.Wrap(err, errRedeemToken.Error())
 	}
-	return nil
+	return rowsAff == 1, nil
 }
 
-func (p *PersistenceToken) UpdateTokenToExpired(ctx context.Context, token *models.Token) error {
-	tokenEntry, err := models_schema.FindToken(ctx, p.db, token.Id)
+// ExpireTokens flags up to limit tokens past their expiry as expired, lowest id first, and returns the tokens it flagged.
+// The tokens are locked until they are flagged, so concurrent sweeps never flag the same token twice,
+// and their expired events are written to the outbox along with them.
+func (p *PersistenceToken) ExpireTokens(ctx context.Context, now time.Time, limit int) ([]models.TokenRef, error) {
+	var refs []models.TokenRef
+	err := p.inTx(ctx, "error_expire_tokens", func(tx *sql.Tx) error {
+		var err error
+		refs, err = p.expireTokens(ctx, tx, now, limit)
+		return err
+	})
 	if err != nil {
-		return errors.Wrap(err, errFetchToken.Error())
+		return nil, errors.Wrap(err, errExpireTokens.Error())
 	}
+	return refs, nil
+}
 
-	tokenEntry.Expired = true
-	_, err = tokenEntry.Update(ctx, p.db, boil.Infer())
+func (p *PersistenceToken) expireTokens(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]models.TokenRef, error) {
+	refs := []models.TokenRef{}
+	err := models_schema.Tokens(
+		qm.Select(models_schema.TokenColumns.ID, models_schema.TokenColumns.KeyPrefix),
+		models_schema.TokenWhere.Expired.EQ(false),
+		models_schema.TokenWhere.ExpiresAt.LTE(now),
+		qm.OrderBy(models_schema.TokenColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE"),
+	).Bind(ctx, tx, &refs)
 	if err != nil {
-		return errors.Wrap(err, errUpdateTokenToExpired.Error())
+		return nil, err
+	}
+	if len(refs) == 0 {
+		return refs, nil
 	}
 
-	return nil
+	ids := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.Id)
+	}
+	_, err = models_schema.Tokens(
+		models_schema.TokenWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.TokenColumns.Expired: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = insertOutbox(ctx, tx, models.TokenLifecycleExpired, now, refs...); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// purgeable matches tokens past the retention cutoff, once both their creation and expiry are older than it.
+// Revoked tokens are only purged once expired too, since the revocation set of signed tokens is loaded
+// from the revoked rows, and a signed token stays valid until its embedded expiry once its row is gone.
+func purgeable(cutoff time.Time) qm.QueryMod {
+	return qm.Where("GREATEST("+models_schema.TokenTableColumns.CreatedAt+", "+
+		models_schema.TokenTableColumns.ExpiresAt+") < ?", cutoff)
+}
+
+// PurgeTokens deletes up to limit tokens past the retention cutoff with an id above afterId, oldest first.
+// It returns how many were deleted, and the last id looked at to resume from, which is 0 once there are none left.
+// A non nil archive is handed the tokens along with their events before they are deleted, and nothing is deleted
+// if it fails. Events are otherwise deleted with their token, like those recorded after it was archived.
+// Rows are only locked by the delete, by primary key, which checks they are still purgeable,
+// so a token reinstated in the meantime is archived but kept.
+func (p *PersistenceToken) PurgeTokens(ctx context.Context, cutoff time.Time, afterId int, limit int,
+	archive func(tokens []models.ArchivedToken) error) (int64, int, error) {
+	var container []models.Token
+	err := models_schema.Tokens(
+		qm.Select(tokenColumns...),
+		purgeable(cutoff),
+		models_schema.TokenWhere.ID.GT(afterId),
+		qm.OrderBy(models_schema.TokenColumns.ID),
+		qm.Limit(limit),
+	).Bind(ctx, p.db, &container)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, errFetchPurgeableTokens.Error())
+	}
+	if len(container) == 0 {
+		return 0, 0, nil
+	}
+	lastId := container[len(container)-1].Id
+
+	ids := make([]int, 0, len(container))
+	for _, token := range container {
+		ids = append(ids, token.Id)
+	}
+
+	if archive != nil {
+		archived, err := p.withEvents(ctx, container, ids)
+		if err != nil {
+			return 0, 0, err
+		}
+		if err = archive(archived); err != nil {
+			return 0, 0, errors.Wrap(err, errArchiveTokens.Error())
+		}
+	}
+
+	deleted, err := models_schema.Tokens(
+		models_schema.TokenWhere.ID.IN(ids),
+		purgeable(cutoff),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, errDeleteTokens.Error())
+	}
+	return deleted, lastId, nil
+}
+
+// withEvents pairs the tokens with their events, oldest first
+func (p *PersistenceToken) withEvents(ctx context.Context, tokens []models.Token, ids []int) ([]models.ArchivedToken, error) {
+	tokenIds := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		tokenIds = append(tokenIds, id)
+	}
+	events, err := models_schema.TokenEvents(
+		qm.WhereIn(models_schema.TokenEventTableColumns.TokenID+" IN ?", tokenIds...),
+		qm.OrderBy(models_schema.TokenEventColumns.ID),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchTokenEvents.Error())
+	}
+
+	byToken := make(map[int][]models.TokenEvent, len(tokens))
+	for _, event := range events {
+		byToken[event.TokenID.Int] = append(byToken[event.TokenID.Int], models.TokenEvent{
+			Id:        event.ID,
+			Action:    event.Action,
+			Outcome:   event.Outcome,
+			Ip:        event.IP,
+			UserAgent: event.UserAgent,
+			RequestId: event.RequestID,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	archived := make([]models.ArchivedToken, 0, len(tokens))
+	for _, token := range tokens {
+		archived = append(archived, models.ArchivedToken{Token: token, Events: byToken[token.Id]})
+	}
+	return archived, nil
+}
+
+// GetRevokedTokenIds returns the ids of revoked tokens that haven't expired yet.
+// Expired tokens fail validation regardless, so they are left out to keep the set small.
+func (p *PersistenceToken) GetRevokedTokenIds(ctx context.Context, now time.Time) ([]int, error) {
+	var container []struct {
+		Id int `boil:"id"`
+	}
+	err := models_schema.Tokens(
+		qm.Select(models_schema.TokenColumns.ID),
+		models_schema.TokenWhere.Revoked.EQ(true),
+		models_schema.TokenWhere.ExpiresAt.GT(now),
+	).Bind(ctx, p.db, &container)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchRevokedTokenIds.Error())
+	}
+
+	ids := make([]int, 0, len(container))
+	for _, revoked := range container {
+		ids = append(ids, revoked.Id)
+	}
+	return ids, nil
 }
 
 func (p *PersistenceToken) GetToken(ctx context.Context, key string) (*models.Token, error) {
 	var container []models.Token
 	err := models_schema.Tokens(
-		models_schema.TokenWhere.Key.EQ(key),
+		qm.Select(tokenColumns...),
+		models_schema.TokenWhere.KeyHash.EQ(p.hashKey(key)),
 	).Bind(ctx, p.db, &container)
 	if err != nil {
 		return nil, errors.Wrap(err, errFetchTokenByKey.Error())
 	}
 	if len(container) == 0 || container == nil {
-		return nil, errFetchTokenByKeyNoResult
+		return nil, models.ErrNotFound.Wrap(errFetchTokenByKeyNoResult)
 	}
 	return &container[0], nil
 }
 
-// GetAll returns all the tokens
-func (p *PersistenceToken) GetAll(ctx context.Context) ([]models.Token, error) {
-	container := []models.Token{}
+// GetTokenById returns the token by its id, as listed. Listings only show key prefixes, so the id is how admins
+// look up, update and revoke a token without its key.
+func (p *PersistenceToken) GetTokenById(ctx context.Context, id int) (*models.Token, error) {
+	var container []models.Token
 	err := models_schema.Tokens(
-		qm.InnerJoin("user u ON u.id = token.created_by"),
-		qm.Select([]string{
-			"token.id AS id",
-			"token.key AS `key`",
-			"token.created_at AS created_at",
-			"token.revoked AS revoked",
-			"token.expired AS expired",
-			"token.expires_at AS expires_at",
-			"u.name AS created_by",
-		}...),
-	).Bind(mysql.BoilCtx, p.db, &container)
+		qm.InnerJoin(creatorJoin),
+		qm.Select(listColumns...),
+		models_schema.TokenWhere.ID.EQ(id),
+	).Bind(ctx, p.db, &container)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchToken.Error())
+	}
+	if len(container) == 0 {
+		return nil, models.ErrNotFound.Wrap(errTokenNotFound)
+	}
+	return &container[0], nil
+}
+
+// GetAll returns the tokens matching the query
+func (p *PersistenceToken) GetAll(ctx context.Context, query *models.TokenQuery) ([]models.Token, error) {
+	container := []models.Token{}
+	err := models_schema.Tokens(listQueryMods(query)...).Bind(mysql.BoilCtx, p.db, &container)
 	if err != nil {
 		return nil, errors.Wrap(err, errFetchTokens.Error())
 	}
 	return container, nil
 }
 
-// Generate returns a unique string in the length range of 6-12 characters
-func (p *PersistenceToken) Generate(ctx context.Context, createdBy int, randomCharMinLength int,
+// IterateAll calls each for every token matching the query, one row at a time, so large
+// result sets never have to be held in memory. Iteration stops at the first error from each.
+func (p *PersistenceToken) IterateAll(ctx context.Context, query *models.TokenQuery, each func(token *models.Token) error) error {
+	rows, err := models_schema.Tokens(listQueryMods(query)...).QueryContext(ctx, p.db)
+	if err != nil {
+		return errors.Wrap(err, errFetchTokens.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token models.Token
+		err = rows.Scan(
+			&token.Id,
+			&token.KeyPrefix,
+			&token.CreatedAt,
+			&token.Revoked,
+			&token.Expired,
+			&token.ExpiresAt,
+			&token.MaxUses,
+			&token.UseCount,
+			&token.Label,
+			&token.Note,
+			&token.RecipientEmail,
+			&token.NotBefore,
+			&token.CampaignId,
+			&token.CreatedBy,
+		)
+		if err != nil {
+			return errors.Wrap(err, errScanToken.Error())
+		}
+		if err = each(&token); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, errFetchTokens.Error())
+	}
+	return nil
+}
+
+// listColumns are the columns bound to models.Token in responses, with the creator's name as created_by,
+// in the order IterateAll scans them. They select from the token joined with its creator by creatorJoin.
+var listColumns = []string{
+	"token.id AS id",
+	"token.key_prefix AS key_prefix",
+	"token.created_at AS created_at",
+	"token.revoked AS revoked",
+	"token.expired AS expired",
+	"token.expires_at AS expires_at",
+	"token.max_uses AS max_uses",
+	"token.use_count AS use_count",
+	"token.label AS label",
+	"token.note AS note",
+	"token.recipient_email AS recipient_email",
+	"token.not_before AS not_before",
+	"token.campaign_id AS campaign_id",
+	"u.name AS created_by",
+}
+
+const creatorJoin = "user u ON u.id = token.created_by"
+
+// listQueryMods selects the token listing columns
+func listQueryMods(query *models.TokenQuery) []qm.QueryMod {
+	queryMods := []qm.QueryMod{
+		qm.InnerJoin(creatorJoin),
+		qm.Select(listColumns...),
+	}
+	return append(queryMods, filterQueryMods(query, time.Now())...)
+}
+
+// Generate inserts a token, in a transaction, since the token takes more than one statement to store
+// when it has scopes, or a signed key, and its created event is written to the outbox along with it.
+func (p *PersistenceToken) Generate(ctx context.Context, newToken *models.NewToken, randomCharMinLength int,
 	randomCharMaxLength int) (string, error) {
+	keys, err := p.GenerateBatch(ctx, newToken, 1, randomCharMinLength, randomCharMaxLength)
+	if err != nil {
+		return "", err
+	}
+	return keys[0], nil
+}
+
+// GenerateBatch inserts count tokens sharing the same values in a single transaction.
+// Either every token is inserted, or none are, which is the case when they would exceed the creator's quota,
+// or belong to a campaign that was revoked.
+func (p *PersistenceToken) GenerateBatch(ctx context.Context, newToken *models.NewToken, count int,
+	randomCharMinLength int, randomCharMaxLength int) ([]string, error) {
+	keys := make([]string, 0, count)
+	err := p.inTx(ctx, "error_generate_batch", func(tx *sql.Tx) error {
+		if newToken.CampaignId != nil {
+			if err := checkCampaign(ctx, tx, *newToken.CampaignId); err != nil {
+				return err
+			}
+		}
+		if newToken.ActiveQuota != nil {
+			if err := checkQuota(ctx, tx, newToken.CreatedBy, *newToken.ActiveQuota, count); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < count; i++ {
+			key, err := p.generate(ctx, tx, newToken, randomCharMinLength, randomCharMaxLength)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// generate inserts a token under a key that is unique within the executor's view of the table,
+// so keys generated earlier in the same transaction are never reused
+func (p *PersistenceToken) generate(ctx context.Context, exec boil.ContextExecutor, newToken *models.NewToken,
+	randomCharMinLength int, randomCharMaxLength int) (string, error) {
 	logger := common.GetLogger(ctx)
 	var randomString string
 	tokenVerifiedUnique := false
@@ -119,16 +496,19 @@ func (p *PersistenceToken) Generate(ctx context.Context, createdBy int, randomCh
 		}
 		loops++
 
-		randomizedCharLength := rand.Intn(randomCharMaxLength-randomCharMinLength) + randomCharMinLength
-		randomString = generateRandomCharacters(randomizedCharLength)
-
 		if p.mockRandomString != "" {
 			randomString = p.mockRandomString
+		} else {
+			var err error
+			randomString, err = p.keyGenerator.Generate(randomCharMinLength, randomCharMaxLength)
+			if err != nil {
+				return "", errors.Wrap(err, errGenerateKey.Error())
+			}
 		}
 
 		token, err := models_schema.Tokens(
-			models_schema.TokenWhere.Key.EQ(randomString),
-		).All(mysql.BoilCtx, p.db)
+			models_schema.TokenWhere.KeyHash.EQ(p.hashKey(randomString)),
+		).All(mysql.BoilCtx, exec)
 		if err != nil {
 			return "", errors.Wrap(err, errCheckUniqueToken.Error())
 		}
@@ -142,22 +522,88 @@ func (p *PersistenceToken) Generate(ctx context.Context, createdBy int, randomCh
 		createdAt = p.mockCreatedTime
 	}
 
-	newToken := models_schema.Token{
-		Key:       randomString,
-		CreatedBy: createdBy,
-		CreatedAt: createdAt,
-		ExpiresAt: createdAt.Add(7 * time.Hour * 24),
+	tokenEntry := models_schema.Token{
+		KeyHash:        p.hashKey(randomString),
+		KeyPrefix:      p.keyFormat.VisiblePrefix(randomString),
+		CreatedBy:      newToken.CreatedBy,
+		CreatedAt:      createdAt,
+		ExpiresAt:      newToken.ExpiresAt,
+		MaxUses:        null.IntFromPtr(newToken.MaxUses),
+		Label:          null.NewString(newToken.Label, newToken.Label != ""),
+		Note:           null.NewString(newToken.Note, newToken.Note != ""),
+		RecipientEmail: null.NewString(newToken.RecipientEmail, newToken.RecipientEmail != ""),
+		NotBefore:      null.TimeFromPtr(newToken.NotBefore),
+		CampaignID:     null.IntFromPtr(newToken.CampaignId),
 	}
 
-	err := newToken.Insert(mysql.BoilCtx, p.db, boil.Infer())
+	err := tokenEntry.Insert(mysql.BoilCtx, exec, boil.Infer())
 	if err != nil {
 		return "", errors.Wrap(err, errInsertNewToken.Error())
 	}
+	err = insertScopes(ctx, exec, tokenEntry.ID, newToken.Scopes)
+	if err != nil {
+		return "", err
+	}
+	key := randomString
+	if p.keySigner != nil {
+		key, err = p.signKey(ctx, exec, &tokenEntry, newToken)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = insertOutbox(ctx, exec, models.TokenLifecycleCreated, createdAt,
+		models.TokenRef{Id: tokenEntry.ID, KeyPrefix: tokenEntry.KeyPrefix})
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// signKey replaces the token's random key with a signed key. Signed keys embed the token's id,
+// so they can only be signed once the token is inserted. They are still stored hashed,
+// so revoking, redeeming and events work as they do for random keys.
+func (p *PersistenceToken) signKey(ctx context.Context, exec boil.ContextExecutor, tokenEntry *models_schema.Token,
+	newToken *models.NewToken) (string, error) {
+	signedKey, err := p.keySigner.Sign(signing.Claims{
+		Id:        tokenEntry.ID,
+		ExpiresAt: newToken.ExpiresAt.Unix(),
+		NotBefore: notBeforeClaim(newToken.NotBefore),
+		Scopes:    newToken.Scopes,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errSignKey.Error())
+	}
+	tokenEntry.KeyHash = p.hashKey(signedKey)
+	tokenEntry.KeyPrefix = p.keyFormat.VisiblePrefix(signedKey)
+	_, err = tokenEntry.Update(mysql.BoilCtx, exec, boil.Whitelist(
+		models_schema.TokenColumns.KeyHash,
+		models_schema.TokenColumns.KeyPrefix,
+	))
+	if err != nil {
+		return "", errors.Wrap(err, errUpdateSignedKey.Error())
+	}
+	return signedKey, nil
+}
 
-	return randomString, nil
+// notBeforeClaim returns the not before time of a signed key, leaving it out when unscheduled
+func notBeforeClaim(notBefore *time.Time) int64 {
+	if notBefore == nil {
+		return 0
+	}
+	return notBefore.Unix()
 }
 
-// NewPersistenceToken returns a new *PersistenceToken instance
-func NewPersistenceToken(db *sql.DB) *PersistenceToken {
-	return &PersistenceToken{db: db}
+// NewPersistenceToken returns a new *PersistenceToken instance.
+// Token keys are stored as an HMAC-SHA256 of the key, under the key secret.
+// A nil keySigner issues random keys, otherwise keys are signed and embed the token's claims.
+func NewPersistenceToken(db *sql.DB, keySecret string, keyGenerator *keygen.Generator,
+	keySigner *signing.Signer) *PersistenceToken {
+	return &PersistenceToken{
+		db:           db,
+		keySecret:    []byte(keySecret),
+		keyGenerator: keyGenerator,
+		keyFormat:    keyGenerator.Format(),
+		keySigner:    keySigner,
+	}
 }
@@ -6,85 +6,117 @@ import (
 	"database/sql/driver"
 	"fmt"
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/friendsofgo/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/utils/keygen"
+	"platform_engineer_clone/src/utils/signing"
 	"regexp"
 	"testing"
 	"time"
 )
 
+func hexKeyGenerator(t *testing.T) *keygen.Generator {
+	keyGenerator, err := keygen.NewGenerator(keygen.AlphabetHex, nil, keygen.Format{Prefix: "inv_"})
+	require.NoError(t, err)
+	return keyGenerator
+}
+
 func configureMockGenerateFailFetchToken(mock sqlmock.Sqlmock) {
 	sqlToken := "SELECT `token`.*"
 	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WillReturnError(errFetchToken)
 }
 
-func configureMockGenerateFailInsertToken(mock sqlmock.Sqlmock, randomString string, createdAt time.Time) {
+func configureMockGenerateFailInsertToken(mock sqlmock.Sqlmock, randomString string, createdAt time.Time, expiresAt time.Time) {
 	var mockIdReturned int64 = 1
-	sqlInsert := "INSERT INTO `token` (`key`,`created_at`,`created_by`,`expires_at`) VALUES (?,?,?,?)"
+	sqlInsert := "INSERT INTO `token` (`key_hash`,`key_prefix`,`created_at`,`created_by`,`expires_at`,`max_uses`,`label`,`note`,`recipient_email`,`not_before`,`campaign_id`) VALUES (?,?,?,?,?,?,?,?,?,?,?)"
 	mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).WithArgs(
-		randomString,
+		(&PersistenceToken{}).hashKey(randomString),
+		randomString[:2],
 		createdAt,
 		3,
-		createdAt.Add(7*time.Hour*24),
+		expiresAt,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	).WillReturnResult(sqlmock.NewResult(mockIdReturned, 1)).WillReturnError(errInsertNewToken)
 }
 
-func configureMockGeneratePassInsertToken(mock sqlmock.Sqlmock, randomString string, createdBy int, createdAt time.Time) {
+func configureMockGeneratePassInsertToken(mock sqlmock.Sqlmock, randomString string, createdBy int, createdAt time.Time, expiresAt time.Time) {
 	var mockIdReturned int64 = 1
-	sqlInsert := "INSERT INTO `token` (`key`,`created_at`,`created_by`,`expires_at`) VALUES (?,?,?,?)"
+	sqlInsert := "INSERT INTO `token` (`key_hash`,`key_prefix`,`created_at`,`created_by`,`expires_at`,`max_uses`,`label`,`note`,`recipient_email`,`not_before`,`campaign_id`) VALUES (?,?,?,?,?,?,?,?,?,?,?)"
 	mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).WithArgs(
-		randomString,
+		(&PersistenceToken{}).hashKey(randomString),
+		randomString[:2],
 		createdAt,
 		3,
-		createdAt.Add(7*time.Hour*24),
+		expiresAt,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	).WillReturnResult(sqlmock.NewResult(mockIdReturned, 1))
 
-	sqlPostSelectAfterSQLBoilerInsert := "SELECT `id`,`revoked`,`expired` FROM `token` WHERE `id`=?"
-	rows := sqlmock.NewRows([]string{"id", "revoked", "expired"})
-	rows.AddRow(mockIdReturned, false, false)
+	sqlPostSelectAfterSQLBoilerInsert := "SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?"
+	rows := sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"})
+	rows.AddRow(mockIdReturned, false, false, 0)
 	mock.ExpectQuery(regexp.QuoteMeta(sqlPostSelectAfterSQLBoilerInsert)).WithArgs(
 		mockIdReturned,
 	).WillReturnRows(rows)
 }
 
 func configureMockGeneratePassFetchToken(mock sqlmock.Sqlmock, randomString string) {
-	sqlToken := "SELECT `token`.* FROM `token` WHERE (`token`.`key` = ?);"
+	sqlToken := "SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);"
 	rows := sqlmock.NewRows([]string{
 		"id",
-		"key",
+		"key_hash",
+		"key_prefix",
 		"created_at",
 		"revoked",
 		"expired",
 		"created_by",
 		"expires_at",
 	})
-	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs(randomString).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs((&PersistenceToken{}).hashKey(randomString)).WillReturnRows(rows)
 }
 
 func TestPersistenceToken_Generate_HappyPath(t *testing.T) {
 	db, mock, err := sqlmock.New()
-	randomString := generateRandomCharacters(12)
+	randomString := "a1b2c3d4e5f6"
 	createdAt := time.Now()
+	expiresAt := createdAt.Add(72 * time.Hour)
 	createdById := 3
 
-	sqlToken := "SELECT `token`.* FROM `token` WHERE (`token`.`key` = ?);"
+	mock.ExpectBegin()
+	sqlToken := "SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);"
 	rows := sqlmock.NewRows([]string{
 		"id",
 	})
 	rows.AddRow("123")
-	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs(randomString).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs((&PersistenceToken{}).hashKey(randomString)).WillReturnRows(rows)
 
-	sqlToken = "SELECT `token`.* FROM `token` WHERE (`token`.`key` = ?);"
+	sqlToken = "SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);"
 	rows = sqlmock.NewRows([]string{
 		"id",
 	})
-	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs(randomString).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(sqlToken)).WithArgs((&PersistenceToken{}).hashKey(randomString)).WillReturnRows(rows)
 
-	configureMockGeneratePassInsertToken(mock, randomString, createdById, createdAt)
+	configureMockGeneratePassInsertToken(mock, randomString, createdById, createdAt, expiresAt)
+	expectOutboxInsert(mock, models.TokenLifecycleCreated, 1)
+	mock.ExpectCommit()
 
 	persistenceToken := PersistenceToken{db: db, mockRandomString: randomString, mockCreatedTime: createdAt}
-	_, err = persistenceToken.Generate(context.Background(), createdById, 6, 12)
+	_, err = persistenceToken.Generate(context.Background(), &models.NewToken{
+		CreatedBy: createdById,
+		ExpiresAt: expiresAt,
+	}, 6, 12)
 	t.Run("Test Generate Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
 
@@ -93,14 +125,112 @@ func TestPersistenceToken_Generate_HappyPath(t *testing.T) {
 	})
 }
 
+func TestPersistenceToken_Generate_HappyPath_MinEqualsMax(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(1, false, false, 0))
+	expectOutboxInsert(mock, models.TokenLifecycleCreated, 1)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	key, err := persistenceToken.Generate(context.Background(), &models.NewToken{
+		CreatedBy: 3,
+		ExpiresAt: time.Now().Add(72 * time.Hour),
+	}, 8, 8)
+	t.Run("Test Generate Happy Path Min Equals Max", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, keygen.Format{Prefix: "inv_"}.Check(key))
+		assert.Len(t, key, len("inv_")+8+6)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_Generate_HappyPath_Signed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	signer, err := signing.NewSigner("inv_", signing.AlgorithmHMAC,
+		[]string{"k1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="})
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(7, false, false, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `key_hash`=?,`key_prefix`=? WHERE `id`=?")).
+		WithArgs(sqlmock.AnyArg(), "in", 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectOutboxInsert(mock, models.TokenLifecycleCreated, 7)
+	mock.ExpectCommit()
+
+	expiresAt := time.Now().Add(72 * time.Hour)
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t), keySigner: signer}
+	key, err := persistenceToken.Generate(context.Background(), &models.NewToken{
+		CreatedBy: 3,
+		ExpiresAt: expiresAt,
+	}, 8, 8)
+	t.Run("Test Generate Happy Path Signed", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		claims, err := signer.Verify(key)
+		require.NoError(t, err)
+		assert.Equal(t, 7, claims.Id)
+		assert.Equal(t, expiresAt.Unix(), claims.ExpiresAt)
+	})
+}
+
+func TestPersistenceToken_GetRevokedTokenIds_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `id` FROM `token` WHERE (`token`.`revoked` = ?) AND (`token`.`expires_at` > ?);",
+	)).WithArgs(true, now).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(9))
+
+	persistenceToken := PersistenceToken{db: db}
+	ids, err := persistenceToken.GetRevokedTokenIds(context.Background(), now)
+	t.Run("Test GetRevokedTokenIds Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 9}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GetRevokedTokenIds_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `token`")).WillReturnError(fmt.Errorf("connection lost"))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetRevokedTokenIds(context.Background(), time.Now())
+	t.Run("Test GetRevokedTokenIds Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchRevokedTokenIds.Error())
+	})
+}
+
 func TestPersistenceToken_Generate_FailCheckUniqueToken(t *testing.T) {
 	db, mock, err := sqlmock.New()
+	mock.ExpectBegin()
 	configureMockGenerateFailFetchToken(mock)
+	mock.ExpectRollback()
 
 	createdById := 3
 
-	persistenceToken := PersistenceToken{db: db}
-	_, err = persistenceToken.Generate(context.Background(), createdById, 6, 12)
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.Generate(context.Background(), &models.NewToken{CreatedBy: createdById}, 6, 12)
 	t.Run("Test Generate Fail Check Unique Token", func(t *testing.T) {
 		require.Error(t, err)
 
@@ -111,17 +241,23 @@ func TestPersistenceToken_Generate_FailCheckUniqueToken(t *testing.T) {
 }
 
 func TestPersistenceToken_Generate_FailInsertNewToken(t *testing.T) {
-	randomString := generateRandomCharacters(12)
+	randomString := "a1b2c3d4e5f6"
 	createdAt := time.Now()
+	expiresAt := createdAt.Add(7 * time.Hour * 24)
 	createdById := 3
 
 	db, mock, err := sqlmock.New()
+	mock.ExpectBegin()
 	configureMockGeneratePassFetchToken(mock, randomString)
-	configureMockGenerateFailInsertToken(mock, randomString, createdAt)
+	configureMockGenerateFailInsertToken(mock, randomString, createdAt, expiresAt)
+	mock.ExpectRollback()
 
 	persistenceToken := PersistenceToken{db: db, mockCreatedTime: createdAt, mockRandomString: randomString}
 	t.Run("Test Generate Fail Insert New Token", func(t *testing.T) {
-		_, err = persistenceToken.Generate(context.Background(), createdById, 6, 12)
+		_, err = persistenceToken.Generate(context.Background(), &models.NewToken{
+			CreatedBy: createdById,
+			ExpiresAt: expiresAt,
+		}, 6, 12)
 		require.Error(t, err)
 
 		errMsg := err.Error()
@@ -131,24 +267,38 @@ func TestPersistenceToken_Generate_FailInsertNewToken(t *testing.T) {
 }
 
 func configureMockGetAllFetchTokensSuccess(mock sqlmock.Sqlmock) {
-	sqlFetchTokens := "SELECT token.id AS id, token.key AS `key`, token.created_at AS created_at, token.revoked AS revoked, token.expired AS expired, token.expires_at AS expires_at, u.name AS created_by FROM `token` INNER JOIN user u ON u.id = token.created_by;"
+	sqlFetchTokens := "SELECT token.id AS id, token.key_prefix AS key_prefix, token.created_at AS created_at, token.revoked AS revoked, token.expired AS expired, token.expires_at AS expires_at, token.max_uses AS max_uses, token.use_count AS use_count, token.label AS label, token.note AS note, token.recipient_email AS recipient_email, token.not_before AS not_before, token.campaign_id AS campaign_id, u.name AS created_by FROM `token` INNER JOIN user u ON u.id = token.created_by;"
 
 	headers := []string{
 		"id",
-		"`key`",
+		"key_prefix",
 		"created_at",
 		"revoked",
 		"expired",
 		"expires_at",
+		"max_uses",
+		"use_count",
+		"label",
+		"note",
+		"recipient_email",
+		"not_before",
+		"campaign_id",
 		"created_by",
 	}
 	data := []driver.Value{
 		1,
-		"abc",
+		"ab",
 		time.Now(),
 		true,
 		true,
 		time.Now(),
+		1,
+		1,
+		"ACME onboarding",
+		nil,
+		"jane@acme.com",
+		time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+		nil,
 		"Demby",
 	}
 	rows := sqlmock.NewRows(headers).AddRow(data...)
@@ -162,116 +312,187 @@ func TestPersistenceToken_GetAll_HappyPath(t *testing.T) {
 	configureMockGetAllFetchTokensSuccess(mock)
 
 	persistenceToken := PersistenceToken{db: db}
-	res, err := persistenceToken.GetAll(context.Background())
+	res, err := persistenceToken.GetAll(context.Background(), nil)
 
 	t.Run("Test GetAll Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
 
 		resLength := len(res)
 		require.Equal(t, true, resLength > 0)
+		assert.Equal(t, null.StringFrom("ACME onboarding"), res[0].Label)
+		assert.False(t, res[0].Note.Valid)
+		assert.Equal(t, null.TimeFrom(time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)), res[0].NotBefore)
 	})
 }
 
-func configureMockGetAllFetchTokensFail(mock sqlmock.Sqlmock) {
-	sqlFetchTokens := "SELECT token.id AS id, token.key AS `key`, token.created_at AS created_at, token.revoked AS revoked, token.expired AS expired, token.expires_at AS expires_at, u.name AS created_by FROM `token` INNER JOIN user u ON u.id = token.created_by;"
+func TestPersistenceToken_GetAll_HappyPath_Filtered(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
 
-	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchTokens)).WillReturnError(errFetchToken)
+	sqlFetchTokens := "WHERE `token`.`label` = ? AND `token`.`recipient_email` = ? AND " +
+		"(token.label LIKE ? OR token.note LIKE ? OR token.recipient_email LIKE ?) " +
+		"ORDER BY token.created_at ASC, token.id ASC;"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchTokens)).
+		WithArgs("ACME onboarding", "jane@acme.com", `%100\%%`, `%100\%%`, `%100\%%`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetAll(context.Background(), &models.TokenQuery{
+		Label:          "ACME onboarding",
+		RecipientEmail: "jane@acme.com",
+		Search:         "100%",
+	})
+
+	t.Run("Test GetAll Happy Path Filtered", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func TestPersistenceToken_GetAll_FailPath(t *testing.T) {
+func TestPersistenceToken_GetAll_HappyPath_StatusAndPage(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	configureMockGetAllFetchTokensFail(mock)
+	createdAfter := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	cursorValue := time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)
 
-	persistenceToken := PersistenceToken{db: db}
-	_, err = persistenceToken.GetAll(context.Background())
+	sqlFetchTokens := "WHERE `token`.`revoked` = ? AND `token`.`expired` = ? AND `token`.`expires_at` > ? AND " +
+		"`token`.`created_by` = ? AND `token`.`created_at` >= ? AND " +
+		"(`token`.`expires_at` < ? OR (`token`.`expires_at` = ? AND `token`.`id` < ?)) " +
+		"ORDER BY token.expires_at DESC, token.id DESC LIMIT 11;"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchTokens)).
+		WithArgs(false, false, sqlmock.AnyArg(), 3, createdAfter, cursorValue, cursorValue, 42).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
-	t.Run("Test GetAll Fail Path", func(t *testing.T) {
-		require.Error(t, err)
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetAll(context.Background(), &models.TokenQuery{
+		Status:       models.TokenStatusActive,
+		CreatedBy:    3,
+		CreatedAfter: &createdAfter,
+		SortBy:       models.TokenSortExpiresAt,
+		SortDesc:     true,
+		After:        &models.TokenCursor{Value: cursorValue, Id: 42},
+		Limit:        11,
+	})
 
-		errMsg := err.Error()
-		wantErrMsg := errFetchTokens.Error()
-		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	t.Run("Test GetAll Happy Path Status And Page", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
-func TestNewPersistenceToken(t *testing.T) {
-	db, _, err := sqlmock.New()
+func TestPersistenceToken_GetAll_HappyPath_StatusExpired(t *testing.T) {
+	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	persistenceToken := NewPersistenceToken(db)
-	t.Run("Test NewPersistenceToken", func(t *testing.T) {
-		require.NotNil(t, persistenceToken)
+	sqlFetchTokens := "WHERE `token`.`revoked` = ? AND (`token`.`expired` = ? OR `token`.`expires_at` <= ?) " +
+		"ORDER BY token.created_at DESC, token.id DESC;"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchTokens)).
+		WithArgs(false, true, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetAll(context.Background(), &models.TokenQuery{
+		Status:   models.TokenStatusExpired,
+		SortBy:   models.TokenSortCreatedAt,
+		SortDesc: true,
+	})
+
+	t.Run("Test GetAll Happy Path Status Expired", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
-func configureMockUpdateTokenToExpiredFailFindToken(mock sqlmock.Sqlmock) {
-	mock.ExpectQuery("select (.+) FROM `token.*").WillReturnError(errFetchToken)
+func configureMockGetAllFetchTokensFail(mock sqlmock.Sqlmock) {
+	sqlFetchTokens := "SELECT token.id AS id, token.key_prefix AS key_prefix, token.created_at AS created_at, token.revoked AS revoked, token.expired AS expired, token.expires_at AS expires_at, token.max_uses AS max_uses, token.use_count AS use_count, token.label AS label, token.note AS note, token.recipient_email AS recipient_email, token.not_before AS not_before, token.campaign_id AS campaign_id, u.name AS created_by FROM `token` INNER JOIN user u ON u.id = token.created_by;"
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlFetchTokens)).WillReturnError(errFetchToken)
 }
 
-func TestPersistenceToken_UpdateTokenToExpired_FailFindToken(t *testing.T) {
+func TestPersistenceToken_GetAll_FailPath(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	configureMockUpdateTokenToExpiredFailFindToken(mock)
+	configureMockGetAllFetchTokensFail(mock)
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.UpdateTokenToExpired(context.Background(), &models.Token{Key: "123456"})
+	_, err = persistenceToken.GetAll(context.Background(), nil)
+
 	t.Run("Test GetAll Fail Path", func(t *testing.T) {
 		require.Error(t, err)
 
 		errMsg := err.Error()
-		wantErrMsg := errFetchToken.Error()
+		wantErrMsg := errFetchTokens.Error()
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
 
-func configureMockUpdateTokenToExpiredPassFindToken(mock sqlmock.Sqlmock) {
-	rows := sqlmock.NewRows([]string{"id"})
-	rows.AddRow(1)
-	mock.ExpectQuery("select (.+) .*").WillReturnRows(rows)
-}
+func TestPersistenceToken_IterateAll_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
 
-func configureMockUpdateTokenToExpiredFailUpdate(mock sqlmock.Sqlmock) {
-	mock.ExpectExec("UPDATE `token.*").WillReturnError(errUpdateTokenToExpired)
+	configureMockGetAllFetchTokensSuccess(mock)
+
+	var res []models.Token
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.IterateAll(context.Background(), nil, func(token *models.Token) error {
+		res = append(res, *token)
+		return nil
+	})
+
+	t.Run("Test IterateAll Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "ab", res[0].KeyPrefix)
+		assert.Equal(t, null.IntFrom(1), res[0].MaxUses)
+		assert.Equal(t, null.StringFrom("ACME onboarding"), res[0].Label)
+		assert.False(t, res[0].Note.Valid)
+		assert.Equal(t, "Demby", res[0].CreatedBy)
+	})
 }
 
-func TestPersistenceToken_UpdateTokenToExpired_FailUpdate(t *testing.T) {
+func TestPersistenceToken_IterateAll_StopsOnCallbackError(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	configureMockUpdateTokenToExpiredPassFindToken(mock)
-	configureMockUpdateTokenToExpiredFailUpdate(mock)
+	configureMockGetAllFetchTokensSuccess(mock)
 
+	errCallback := errors.New("client went away")
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.UpdateTokenToExpired(context.Background(), &models.Token{Key: "123456"})
-	t.Run("Test GetAll Fail Update", func(t *testing.T) {
-		require.Error(t, err)
-
-		errMsg := err.Error()
-		wantErrMsg := errUpdateTokenToExpired.Error()
-		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	err = persistenceToken.IterateAll(context.Background(), nil, func(token *models.Token) error {
+		return errCallback
 	})
-}
 
-func configureMockUpdateTokenToExpiredPassUpdate(mock sqlmock.Sqlmock) {
-	rows := sqlmock.NewRows([]string{"A"})
-	rows.AddRow("1")
-	mock.ExpectExec("UPDATE `token.*").WillReturnResult(sqlmock.NewResult(1, 1))
+	t.Run("Test IterateAll Stops On Callback Error", func(t *testing.T) {
+		assert.ErrorIs(t, err, errCallback)
+	})
 }
 
-func TestPersistenceToken_UpdateTokenToExpired_HappyPath(t *testing.T) {
+func TestPersistenceToken_IterateAll_FailPath(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	configureMockUpdateTokenToExpiredPassFindToken(mock)
-	configureMockUpdateTokenToExpiredPassUpdate(mock)
+	configureMockGetAllFetchTokensFail(mock)
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.UpdateTokenToExpired(context.Background(), &models.Token{Key: "123456"})
-	t.Run("Test UpdateTokenToExpired - Happy Path", func(t *testing.T) {
-		require.NoError(t, err)
+	err = persistenceToken.IterateAll(context.Background(), nil, func(token *models.Token) error {
+		return nil
+	})
+
+	t.Run("Test IterateAll Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errFetchTokens.Error())
+	})
+}
+
+func TestNewPersistenceToken(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+
+	persistenceToken := NewPersistenceToken(db, "0123456789abcdef0123456789abcdef", hexKeyGenerator(t), nil)
+	t.Run("Test NewPersistenceToken", func(t *testing.T) {
+		require.NotNil(t, persistenceToken)
 	})
 }
 
@@ -279,16 +500,19 @@ func TestPersistenceToken_RevokeToken_HappyPath(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	rows := sqlmock.NewRows([]string{"id"})
-	rows.AddRow("3")
-	mock.ExpectQuery("SELECT `token.*").WillReturnRows(rows)
+	rows := sqlmock.NewRows([]string{"id", "key_prefix"})
+	rows.AddRow("3", "inv_3k")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT `token.* FOR UPDATE").WillReturnRows(rows)
 	mock.ExpectExec("UPDATE `token` .*").WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectQuery("select * from `token`").WillReturnRows(rows)
+	expectOutboxInsert(mock, models.TokenLifecycleRevoked, 3)
+	mock.ExpectCommit()
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.RevokeToken(context.Background(), "123456")
+	ref, err := persistenceToken.RevokeToken(context.Background(), "123456")
 	t.Run("Test RevokeToken - Happy Path", func(t *testing.T) {
 		require.NoError(t, err)
+		assert.Equal(t, &models.TokenRef{Id: 3, KeyPrefix: "inv_3k"}, ref)
 	})
 }
 
@@ -296,10 +520,12 @@ func TestPersistenceToken_RevokeToken_Fail_FindToken(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
+	mock.ExpectBegin()
 	mock.ExpectQuery("select (.*) from `token.*").WillReturnError(errFetchToken)
+	mock.ExpectRollback()
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.RevokeToken(context.Background(), "123456")
+	_, err = persistenceToken.RevokeToken(context.Background(), "123456")
 	t.Run("Test RevokeToken - Happy Path", func(t *testing.T) {
 		require.Error(t, err)
 
@@ -313,13 +539,16 @@ func TestPersistenceToken_RevokeToken_Fail_FindTokenNoResults(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
+	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT `token.*").WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.RevokeToken(context.Background(), "123456")
+	_, err = persistenceToken.RevokeToken(context.Background(), "123456")
 	fmt.Println("err", err)
 	t.Run("Test RevokeToken - Fail Find Token No Results", func(t *testing.T) {
 		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
 
 		errMsg := err.Error()
 		wantErrMsg := errTokenNotFound.Error()
@@ -333,11 +562,13 @@ func TestPersistenceToken_RevokeToken_Fail_Update(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{"id"})
 	rows.AddRow(0)
+	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT `token.*").WillReturnRows(rows)
 	mock.ExpectExec("UPDATE `token` .*").WillReturnError(errUpdateTokenToRevoked)
+	mock.ExpectRollback()
 
 	persistenceToken := PersistenceToken{db: db}
-	err = persistenceToken.RevokeToken(context.Background(), "123456")
+	_, err = persistenceToken.RevokeToken(context.Background(), "123456")
 	t.Run("Test RevokeToken - Fail Path", func(t *testing.T) {
 		require.Error(t, err)
 
@@ -398,3 +629,407 @@ func TestPersistenceToken_GetToken_FailPath_NoResults(t *testing.T) {
 		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
 	})
 }
+
+func TestPersistenceToken_GetTokenById_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE (`token`.`id` = ?)")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(4, "inv_3k"))
+
+	persistenceToken := PersistenceToken{db: db}
+	token, err := persistenceToken.GetTokenById(context.Background(), 4)
+	t.Run("Test GetTokenById - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, token.Id)
+		assert.Equal(t, "inv_3k", token.KeyPrefix)
+	})
+}
+
+func TestPersistenceToken_GetTokenById_HappyPath_CreatedByAsListed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	configureMockGetAllFetchTokensSuccess(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT token.id AS id, token.key_prefix AS key_prefix, token.created_at AS created_at, " +
+		"token.revoked AS revoked, token.expired AS expired, token.expires_at AS expires_at, token.max_uses AS max_uses, " +
+		"token.use_count AS use_count, token.label AS label, token.note AS note, token.recipient_email AS recipient_email, " +
+		"token.not_before AS not_before, token.campaign_id AS campaign_id, u.name AS created_by FROM `token` " +
+		"INNER JOIN user u ON u.id = token.created_by WHERE (`token`.`id` = ?);")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix", "created_by"}).AddRow(1, "ab", "Demby"))
+
+	persistenceToken := PersistenceToken{db: db}
+	listed, err := persistenceToken.GetAll(context.Background(), nil)
+	require.NoError(t, err)
+	token, err := persistenceToken.GetTokenById(context.Background(), 1)
+	t.Run("Test GetTokenById - Happy Path Created By As Listed", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, listed[0].CreatedBy, token.CreatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GetTokenById_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INNER JOIN user u ON u.id = token.created_by WHERE (`token`.`id` = ?)")).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GetTokenById(context.Background(), 9)
+	t.Run("Test GetTokenById - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestPersistenceToken_RedeemToken_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `use_count` = `use_count` + 1")).
+		WithArgs(4, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	persistenceToken := PersistenceToken{db: db}
+	redeemed, err := persistenceToken.RedeemToken(context.Background(), 4)
+	t.Run("Test RedeemToken - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.True(t, redeemed)
+	})
+}
+
+func TestPersistenceToken_RedeemToken_NoUsesRemaining(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `use_count` = `use_count` + 1")).
+		WithArgs(4, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	persistenceToken := PersistenceToken{db: db}
+	redeemed, err := persistenceToken.RedeemToken(context.Background(), 4)
+	t.Run("Test RedeemToken - No Uses Remaining", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.False(t, redeemed)
+	})
+}
+
+func TestPersistenceToken_RedeemToken_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `use_count` = `use_count` + 1")).
+		WillReturnError(errRedeemToken)
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.RedeemToken(context.Background(), 4)
+	t.Run("Test RedeemToken - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errRedeemToken.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	for i := 1; i <= 2; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).
+			WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`revoked`,`expired`,`use_count` FROM `token` WHERE `id`=?")).
+			WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "revoked", "expired", "use_count"}).AddRow(i, false, false, 0))
+		expectOutboxInsert(mock, models.TokenLifecycleCreated, i)
+	}
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	keys, err := persistenceToken.GenerateBatch(context.Background(), &models.NewToken{
+		CreatedBy: 3,
+		ExpiresAt: time.Now().Add(72 * time.Hour),
+	}, 2, 6, 12)
+	t.Run("Test GenerateBatch Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Len(t, keys, 2)
+		for _, key := range keys {
+			assert.NoError(t, keygen.Format{Prefix: "inv_"}.Check(key))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_FailInsertRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.* FROM `token` WHERE (`token`.`key_hash` = ?);")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `token`")).WillReturnError(errInsertNewToken)
+	mock.ExpectRollback()
+
+	persistenceToken := PersistenceToken{db: db, keyGenerator: hexKeyGenerator(t)}
+	_, err = persistenceToken.GenerateBatch(context.Background(), &models.NewToken{CreatedBy: 3}, 2, 6, 12)
+	t.Run("Test GenerateBatch Fail Insert Rolls Back", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errInsertNewToken.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_GenerateBatch_FailBegin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin().WillReturnError(errBeginTransaction)
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.GenerateBatch(context.Background(), &models.NewToken{CreatedBy: 3}, 2, 6, 12)
+	t.Run("Test GenerateBatch Fail Begin", func(t *testing.T) {
+		require.Error(t, err)
+
+		errMsg := err.Error()
+		wantErrMsg := errBeginTransaction.Error()
+		assert.Containsf(t, errMsg, wantErrMsg, "expected error containing %q, got %s", wantErrMsg, err)
+	})
+}
+
+func TestPersistenceToken_ExpireTokens_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key_prefix` FROM `token` WHERE (`token`.`expired` = ?) AND (`token`.`expires_at` <= ?) ORDER BY id LIMIT 500 FOR UPDATE")).
+		WithArgs(false, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(3, "inv_3k").AddRow(5, "inv_5m"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `expired` = ? WHERE (`token`.`id` IN (?,?))")).
+		WithArgs(true, 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	expectOutboxInsert(mock, models.TokenLifecycleExpired, 3, 5)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	expired, err := persistenceToken.ExpireTokens(context.Background(), now, 500)
+	t.Run("Test ExpireTokens - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, []models.TokenRef{{Id: 3, KeyPrefix: "inv_3k"}, {Id: 5, KeyPrefix: "inv_5m"}}, expired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_ExpireTokens_HappyPath_NoneDue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key_prefix` FROM `token`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}))
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	expired, err := persistenceToken.ExpireTokens(context.Background(), time.Now(), 500)
+	t.Run("Test ExpireTokens - Happy Path None Due", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_ExpireTokens_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key_prefix` FROM `token`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(3, "inv_3k"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `expired` = ?")).WillReturnError(errExpireTokens)
+	mock.ExpectRollback()
+
+	persistenceToken := PersistenceToken{db: db}
+	_, err = persistenceToken.ExpireTokens(context.Background(), time.Now(), 500)
+	t.Run("Test ExpireTokens - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errExpireTokens.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_UpdateToken_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	expiresAt := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	revoked := false
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `expired` = ?, `expires_at` = ?, `revoked` = ? WHERE (`token`.`id` = ?)")).
+		WithArgs(false, expiresAt, false, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.UpdateToken(context.Background(), 7, &models.TokenChanges{ExpiresAt: &expiresAt, Revoked: &revoked})
+	t.Run("Test UpdateToken - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_UpdateToken_HappyPath_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	revoked := true
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key_prefix` FROM `token` WHERE (`token`.`id` = ?) AND (`token`.`revoked` = ?) FOR UPDATE")).
+		WithArgs(7, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(7, "inv_7q"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `revoked` = ? WHERE (`token`.`id` = ?)")).
+		WithArgs(true, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectOutboxInsert(mock, models.TokenLifecycleRevoked, 7)
+	mock.ExpectCommit()
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.UpdateToken(context.Background(), 7, &models.TokenChanges{Revoked: &revoked})
+	t.Run("Test UpdateToken - Happy Path Revoke", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_UpdateToken_HappyPath_NoChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.UpdateToken(context.Background(), 7, &models.TokenChanges{})
+	t.Run("Test UpdateToken - Happy Path No Changes", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_UpdateToken_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	revoked := true
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `key_prefix` FROM `token` WHERE (`token`.`id` = ?) AND (`token`.`revoked` = ?) FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).AddRow(7, "inv_7q"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `token` SET `revoked` = ?")).WillReturnError(fmt.Errorf("connection lost"))
+	mock.ExpectRollback()
+
+	persistenceToken := PersistenceToken{db: db}
+	err = persistenceToken.UpdateToken(context.Background(), 7, &models.TokenChanges{Revoked: &revoked})
+	t.Run("Test UpdateToken - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errUpdateToken.Error())
+	})
+}
+
+func TestPersistenceToken_PurgeTokens_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	cutoff := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `token`.`id`, `token`.`key_prefix`, `token`.`created_at`, `token`.`revoked`, `token`.`expired`, "+
+			"`token`.`created_by`, `token`.`expires_at`, `token`.`max_uses`, `token`.`use_count`, `token`.`label`, "+
+			"`token`.`note`, `token`.`recipient_email`, `token`.`not_before`, `token`.`campaign_id` FROM `token` "+
+			"WHERE (GREATEST(token.created_at, token.expires_at) < ?) AND (`token`.`id` > ?) ORDER BY id LIMIT 2;",
+	)).WithArgs(cutoff, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "key_prefix"}).
+		AddRow(3, "inv_3k").
+		AddRow(5, "inv_9x"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `token_event`.* FROM `token_event` WHERE (`token_event`.`token_id` IN (?,?)) ORDER BY id;",
+	)).WithArgs(3, 5).WillReturnRows(sqlmock.NewRows([]string{"id", "token_id", "action", "outcome"}).
+		AddRow(8, 5, models.TokenEventActionValidate, models.TokenEventOutcomeExpired))
+	mock.ExpectExec(regexp.QuoteMeta(
+		"DELETE FROM `token` WHERE (`token`.`id` IN (?,?)) AND (GREATEST(token.created_at, token.expires_at) < ?);",
+	)).WithArgs(3, 5, cutoff).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	var archived []models.ArchivedToken
+	persistenceToken := PersistenceToken{db: db}
+	purged, lastId, err := persistenceToken.PurgeTokens(context.Background(), cutoff, 1, 2, func(tokens []models.ArchivedToken) error {
+		archived = tokens
+		return nil
+	})
+	t.Run("Test PurgeTokens - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		assert.Equal(t, 5, lastId)
+		require.Len(t, archived, 2)
+		assert.Empty(t, archived[0].Events)
+		assert.Equal(t, "inv_9x", archived[1].KeyPrefix)
+		require.Len(t, archived[1].Events, 1)
+		assert.Equal(t, models.TokenEventOutcomeExpired, archived[1].Events[0].Outcome)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_PurgeTokens_HappyPath_NothingToPurge(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.`id`")).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	archiveCalled := false
+	persistenceToken := PersistenceToken{db: db}
+	purged, lastId, err := persistenceToken.PurgeTokens(context.Background(), time.Now(), 0, 2, func(tokens []models.ArchivedToken) error {
+		archiveCalled = true
+		return nil
+	})
+	t.Run("Test PurgeTokens - Happy Path Nothing To Purge", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), purged)
+		assert.Equal(t, 0, lastId)
+		assert.False(t, archiveCalled)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_PurgeTokens_FailArchiveKeepsTokens(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.`id`")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token_event`.*")).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, _, err = persistenceToken.PurgeTokens(context.Background(), time.Now(), 0, 2, func(tokens []models.ArchivedToken) error {
+		return fmt.Errorf("disk full")
+	})
+	t.Run("Test PurgeTokens - Fail Archive Keeps Tokens", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errArchiveTokens.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceToken_PurgeTokens_FailDelete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `token`.`id`")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `token`")).WillReturnError(fmt.Errorf("lock wait timeout"))
+
+	persistenceToken := PersistenceToken{db: db}
+	_, _, err = persistenceToken.PurgeTokens(context.Background(), time.Now(), 0, 2, nil)
+	t.Run("Test PurgeTokens - Fail Delete", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errDeleteTokens.Error())
+	})
+}
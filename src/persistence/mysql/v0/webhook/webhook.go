@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"github.com/friendsofgo/errors"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"platform_engineer_clone/models"
+	"platform_engineer_clone/src/persistence/mysql/models_schema"
+	"platform_engineer_clone/src/utils/common"
+	"strings"
+	"time"
+)
+
+type PersistenceWebhook struct {
+	db *sql.DB
+}
+
+var (
+	errBeginTransaction    = errors.New("error beginning transaction")
+	errClaimDeliveries     = errors.New("error claiming due webhook deliveries")
+	errCommitTransaction   = errors.New("error committing transaction")
+	errDeleteWebhook       = errors.New("error deleting webhook")
+	errEnqueueDeliveries   = errors.New("error enqueueing webhook deliveries")
+	errFetchDeliveries     = errors.New("error fetching webhook deliveries")
+	errFetchWebhook        = errors.New("error fetching webhook")
+	errFetchWebhooks       = errors.New("error fetching webhooks")
+	errInsertWebhook       = errors.New("error inserting webhook")
+	errRecordAttempt       = errors.New("error recording webhook delivery attempt")
+	errRollbackTransaction = errors.New("error rolling back transaction")
+	errUpdateWebhook       = errors.New("error updating webhook")
+	errWebhookNotFound     = errors.New("error, webhook not found")
+)
+
+// enqueueDeliveriesQuery queues the event for every active webhook subscribed to it.
+// Webhooks store their events comma separated, and an empty list subscribes to every event.
+const enqueueDeliveriesQuery = "INSERT INTO `webhook_delivery` " +
+	"(`webhook_id`, `event_type`, `payload`, `status`, `attempts`, `next_attempt_at`, `created_at`) " +
+	"SELECT `id`, ?, ?, ?, 0, ?, ? FROM `webhook` " +
+	"WHERE `active` = 1 AND (`events` = '' OR FIND_IN_SET(?, `events`) > 0)"
+
+// dueDeliveriesQuery selects pending deliveries of active webhooks that are due, oldest first.
+// Rows claimed by another instance are skipped rather than waited for.
+const dueDeliveriesQuery = "SELECT d.`id`, d.`event_type`, d.`payload`, d.`attempts`, w.`url`, w.`secret` " +
+	"FROM `webhook_delivery` d JOIN `webhook` w ON w.`id` = d.`webhook_id` " +
+	"WHERE d.`status` = ? AND d.`next_attempt_at` <= ? AND w.`active` = 1 " +
+	"ORDER BY d.`next_attempt_at`, d.`id` LIMIT ? FOR UPDATE SKIP LOCKED"
+
+// CreateWebhook inserts the webhook, active
+func (p *PersistenceWebhook) CreateWebhook(ctx context.Context, newWebhook *models.NewWebhook) (*models.Webhook, error) {
+	entry := models_schema.Webhook{
+		URL:       newWebhook.Url,
+		Secret:    newWebhook.Secret,
+		Events:    strings.Join(newWebhook.Events, ","),
+		Active:    true,
+		CreatedBy: newWebhook.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+	err := entry.Insert(ctx, p.db, boil.Infer())
+	if err != nil {
+		return nil, errors.Wrap(err, errInsertWebhook.Error())
+	}
+	return toWebhook(&entry), nil
+}
+
+// GetWebhooks returns every webhook, oldest first
+func (p *PersistenceWebhook) GetWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	entries, err := models_schema.Webhooks(
+		qm.OrderBy(models_schema.WebhookColumns.ID),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchWebhooks.Error())
+	}
+	webhooks := make([]models.Webhook, 0, len(entries))
+	for _, entry := range entries {
+		webhooks = append(webhooks, *toWebhook(entry))
+	}
+	return webhooks, nil
+}
+
+func (p *PersistenceWebhook) GetWebhook(ctx context.Context, id int) (*models.Webhook, error) {
+	entry, err := models_schema.FindWebhook(ctx, p.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound.Wrap(errWebhookNotFound)
+		}
+		return nil, errors.Wrap(err, errFetchWebhook.Error())
+	}
+	return toWebhook(entry), nil
+}
+
+// UpdateWebhook applies the changes to the webhook
+func (p *PersistenceWebhook) UpdateWebhook(ctx context.Context, id int, changes *models.WebhookChanges) error {
+	columns := models_schema.M{}
+	if changes.Url != nil {
+		columns[models_schema.WebhookColumns.URL] = *changes.Url
+	}
+	if changes.Events != nil {
+		columns[models_schema.WebhookColumns.Events] = strings.Join(*changes.Events, ",")
+	}
+	if changes.Active != nil {
+		columns[models_schema.WebhookColumns.Active] = *changes.Active
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	_, err := models_schema.Webhooks(
+		models_schema.WebhookWhere.ID.EQ(id),
+	).UpdateAll(ctx, p.db, columns)
+	if err != nil {
+		return errors.Wrap(err, errUpdateWebhook.Error())
+	}
+	return nil
+}
+
+// DeleteWebhook deletes the webhook, and its deliveries with it
+func (p *PersistenceWebhook) DeleteWebhook(ctx context.Context, id int) error {
+	deleted, err := models_schema.Webhooks(
+		models_schema.WebhookWhere.ID.EQ(id),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return errors.Wrap(err, errDeleteWebhook.Error())
+	}
+	if deleted == 0 {
+		return models.ErrNotFound.Wrap(errWebhookNotFound)
+	}
+	return nil
+}
+
+// EnqueueDeliveries queues the event for every webhook subscribed to it, due now, and returns how many were queued
+func (p *PersistenceWebhook) EnqueueDeliveries(ctx context.Context, eventType string, payload string,
+	now time.Time) (int64, error) {
+	res, err := queries.Raw(enqueueDeliveriesQuery,
+		eventType, payload, models.WebhookDeliveryPending, now, now, eventType,
+	).ExecContext(ctx, p.db)
+	if err != nil {
+		return 0, errors.Wrap(err, errEnqueueDeliveries.Error())
+	}
+	queued, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, errEnqueueDeliveries.Error())
+	}
+	return queued, nil
+}
+
+// ClaimDueDeliveries returns up to limit due deliveries, and pushes them back by the lease,
+// so other instances leave them alone while they are sent. Deliveries whose attempt is never
+// recorded, e.g. because the instance stopped, are due again once the lease runs out.
+func (p *PersistenceWebhook) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int,
+	lease time.Duration) ([]models.DueWebhookDelivery, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errBeginTransaction.Error())
+	}
+	due, err := claimDueDeliveries(ctx, tx, now, limit, lease)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			common.GetLogger(ctx).WithFields(logrus.Fields{
+				"err": errors.Wrap(rollbackErr, errRollbackTransaction.Error()),
+			}).Error("error_claim_deliveries")
+		}
+		return nil, errors.Wrap(err, errClaimDeliveries.Error())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, errCommitTransaction.Error())
+	}
+	return due, nil
+}
+
+func claimDueDeliveries(ctx context.Context, tx *sql.Tx, now time.Time, limit int,
+	lease time.Duration) ([]models.DueWebhookDelivery, error) {
+	due := []models.DueWebhookDelivery{}
+	err := queries.Raw(dueDeliveriesQuery, models.WebhookDeliveryPending, now, limit).Bind(ctx, tx, &due)
+	if err != nil {
+		return nil, err
+	}
+	if len(due) == 0 {
+		return due, nil
+	}
+
+	ids := make([]int, 0, len(due))
+	for _, delivery := range due {
+		ids = append(ids, delivery.Id)
+	}
+	_, err = models_schema.WebhookDeliveries(
+		models_schema.WebhookDeliveryWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, models_schema.M{
+		models_schema.WebhookDeliveryColumns.NextAttemptAt: now.Add(lease),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return due, nil
+}
+
+// RecordDeliveryAttempt stores the outcome of attempting the delivery
+func (p *PersistenceWebhook) RecordDeliveryAttempt(ctx context.Context, id int, attempt *models.WebhookDeliveryAttempt) error {
+	columns := models_schema.M{
+		models_schema.WebhookDeliveryColumns.Status:         attempt.Status,
+		models_schema.WebhookDeliveryColumns.Attempts:       attempt.Attempts,
+		models_schema.WebhookDeliveryColumns.LastAttemptAt:  attempt.AttemptedAt,
+		models_schema.WebhookDeliveryColumns.NextAttemptAt:  attempt.NextAttemptAt,
+		models_schema.WebhookDeliveryColumns.ResponseStatus: null.IntFromPtr(attempt.ResponseStatus),
+		models_schema.WebhookDeliveryColumns.LastError:      null.NewString(attempt.Error, attempt.Error != ""),
+	}
+	if attempt.Status == models.WebhookDeliveryDelivered {
+		columns[models_schema.WebhookDeliveryColumns.DeliveredAt] = attempt.AttemptedAt
+	}
+
+	_, err := models_schema.WebhookDeliveries(
+		models_schema.WebhookDeliveryWhere.ID.EQ(id),
+	).UpdateAll(ctx, p.db, columns)
+	if err != nil {
+		return errors.Wrap(err, errRecordAttempt.Error())
+	}
+	return nil
+}
+
+// GetDeliveries returns the webhook's most recent deliveries, newest first
+func (p *PersistenceWebhook) GetDeliveries(ctx context.Context, webhookId int, limit int) ([]models.WebhookDelivery, error) {
+	container := []models.WebhookDelivery{}
+	err := models_schema.WebhookDeliveries(
+		models_schema.WebhookDeliveryWhere.WebhookID.EQ(webhookId),
+		qm.OrderBy(models_schema.WebhookDeliveryColumns.ID+" DESC"),
+		qm.Limit(limit),
+	).Bind(ctx, p.db, &container)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchDeliveries.Error())
+	}
+	return container, nil
+}
+
+func toWebhook(entry *models_schema.Webhook) *models.Webhook {
+	events := []string{}
+	if entry.Events != "" {
+		events = strings.Split(entry.Events, ",")
+	}
+	return &models.Webhook{
+		Id:        entry.ID,
+		Url:       entry.URL,
+		Events:    events,
+		Active:    entry.Active,
+		CreatedBy: entry.CreatedBy,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// NewPersistenceWebhook returns a new *PersistenceWebhook instance
+func NewPersistenceWebhook(db *sql.DB) *PersistenceWebhook {
+	return &PersistenceWebhook{db: db}
+}
@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"platform_engineer_clone/models"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var webhookColumns = []string{"id", "url", "secret", "events", "active", "created_by", "created_at"}
+
+func TestPersistenceWebhook_CreateWebhook_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `webhook` (`url`,`secret`,`events`,`active`,`created_by`,`created_at`)")).
+		WithArgs("https://hooks.acme.com", "whsec_test", "token.created,token.revoked", true, 1, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	persistenceWebhook := NewPersistenceWebhook(db)
+	webhook, err := persistenceWebhook.CreateWebhook(context.Background(), &models.NewWebhook{
+		Url:       "https://hooks.acme.com",
+		Secret:    "whsec_test",
+		Events:    []string{models.TokenLifecycleCreated, models.TokenLifecycleRevoked},
+		CreatedBy: 1,
+	})
+	t.Run("Test CreateWebhook - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, 4, webhook.Id)
+		assert.Equal(t, []string{models.TokenLifecycleCreated, models.TokenLifecycleRevoked}, webhook.Events)
+		assert.True(t, webhook.Active)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_GetWebhooks_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `webhook`.* FROM `webhook` ORDER BY id")).
+		WillReturnRows(sqlmock.NewRows(webhookColumns).
+			AddRow(1, "https://a.acme.com", "whsec_a", "", true, 1, createdAt).
+			AddRow(2, "https://b.acme.com", "whsec_b", "token.expired", false, 1, createdAt))
+
+	webhooks, err := NewPersistenceWebhook(db).GetWebhooks(context.Background())
+	t.Run("Test GetWebhooks - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, webhooks, 2)
+		assert.Equal(t, []string{}, webhooks[0].Events)
+		assert.Equal(t, []string{models.TokenLifecycleExpired}, webhooks[1].Events)
+		assert.False(t, webhooks[1].Active)
+	})
+}
+
+func TestPersistenceWebhook_GetWebhook_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery("select \\* from `webhook`").WillReturnError(sql.ErrNoRows)
+
+	_, err = NewPersistenceWebhook(db).GetWebhook(context.Background(), 9)
+	t.Run("Test GetWebhook - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestPersistenceWebhook_UpdateWebhook_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	active := false
+	events := []string{models.TokenLifecycleRedeemed}
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `webhook` SET `active` = ?, `events` = ? WHERE (`webhook`.`id` = ?)")).
+		WithArgs(false, "token.redeemed", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewPersistenceWebhook(db).UpdateWebhook(context.Background(), 3, &models.WebhookChanges{Events: &events, Active: &active})
+	t.Run("Test UpdateWebhook - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_DeleteWebhook_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `webhook` WHERE (`webhook`.`id` = ?)")).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewPersistenceWebhook(db).DeleteWebhook(context.Background(), 9)
+	t.Run("Test DeleteWebhook - Not Found", func(t *testing.T) {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestPersistenceWebhook_EnqueueDeliveries_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta(enqueueDeliveriesQuery)).
+		WithArgs(models.TokenLifecycleCreated, `{"type":"token.created"}`, models.WebhookDeliveryPending, now, now,
+			models.TokenLifecycleCreated).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	queued, err := NewPersistenceWebhook(db).EnqueueDeliveries(context.Background(), models.TokenLifecycleCreated,
+		`{"type":"token.created"}`, now)
+	t.Run("Test EnqueueDeliveries - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), queued)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_EnqueueDeliveries_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(enqueueDeliveriesQuery)).WillReturnError(errEnqueueDeliveries)
+
+	_, err = NewPersistenceWebhook(db).EnqueueDeliveries(context.Background(), models.TokenLifecycleCreated, "{}", time.Now())
+	t.Run("Test EnqueueDeliveries - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errEnqueueDeliveries.Error())
+	})
+}
+
+func TestPersistenceWebhook_ClaimDueDeliveries_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(dueDeliveriesQuery)).
+		WithArgs(models.WebhookDeliveryPending, now, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_type", "payload", "attempts", "url", "secret"}).
+			AddRow(7, "token.created", "{}", 0, "https://hooks.acme.com", "whsec_test").
+			AddRow(8, "token.revoked", "{}", 2, "https://hooks.acme.com", "whsec_test"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `webhook_delivery` SET `next_attempt_at` = ? WHERE (`webhook_delivery`.`id` IN (?,?))")).
+		WithArgs(now.Add(time.Minute), 7, 8).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	due, err := NewPersistenceWebhook(db).ClaimDueDeliveries(context.Background(), now, 50, time.Minute)
+	t.Run("Test ClaimDueDeliveries - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, due, 2)
+		assert.Equal(t, models.DueWebhookDelivery{
+			Id: 8, EventType: "token.revoked", Payload: "{}", Attempts: 2, Url: "https://hooks.acme.com", Secret: "whsec_test",
+		}, due[1])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_ClaimDueDeliveries_FailPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(dueDeliveriesQuery)).WillReturnError(errClaimDeliveries)
+	mock.ExpectRollback()
+
+	_, err = NewPersistenceWebhook(db).ClaimDueDeliveries(context.Background(), time.Now(), 50, time.Minute)
+	t.Run("Test ClaimDueDeliveries - Fail Path", func(t *testing.T) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errClaimDeliveries.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_RecordDeliveryAttempt_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `webhook_delivery` SET `attempts` = ?, `delivered_at` = ?, `last_attempt_at` = ?, "+
+		"`last_error` = ?, `next_attempt_at` = ?, `response_status` = ?, `status` = ? WHERE (`webhook_delivery`.`id` = ?)")).
+		WithArgs(1, now, now, sqlmock.AnyArg(), now, sqlmock.AnyArg(), models.WebhookDeliveryDelivered, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	status := 204
+	err = NewPersistenceWebhook(db).RecordDeliveryAttempt(context.Background(), 7, &models.WebhookDeliveryAttempt{
+		Status:         models.WebhookDeliveryDelivered,
+		Attempts:       1,
+		AttemptedAt:    now,
+		NextAttemptAt:  now,
+		ResponseStatus: &status,
+	})
+	t.Run("Test RecordDeliveryAttempt - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersistenceWebhook_GetDeliveries_HappyPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `webhook_delivery`.* FROM `webhook_delivery` WHERE (`webhook_delivery`.`webhook_id` = ?) ORDER BY id DESC LIMIT 20")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "webhook_id", "event_type", "status", "attempts"}).
+			AddRow(9, 3, "token.redeemed", "failed", 8))
+
+	deliveries, err := NewPersistenceWebhook(db).GetDeliveries(context.Background(), 3, 20)
+	t.Run("Test GetDeliveries - Happy Path", func(t *testing.T) {
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.WebhookDeliveryFailed, deliveries[0].Status)
+		assert.Equal(t, 8, deliveries[0].Attempts)
+	})
+}
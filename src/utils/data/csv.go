@@ -0,0 +1,43 @@
+package data
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// CSVWriter streams rows as RFC 4180 CSV to the underlying writer.
+// Values a spreadsheet would read as a formula are neutralised, see NeutraliseCSVFormula.
+type CSVWriter struct {
+	w *csv.Writer
+}
+
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	csvWriter := csv.NewWriter(w)
+	csvWriter.UseCRLF = true
+	return &CSVWriter{w: csvWriter}
+}
+
+// Write quotes and writes a single row. Rows are buffered, call Flush to push them out.
+func (c *CSVWriter) Write(row []string) error {
+	fields := make([]string, len(row))
+	for i, v := range row {
+		fields[i] = NeutraliseCSVFormula(v)
+	}
+	return c.w.Write(fields)
+}
+
+// Flush writes any buffered rows, and returns the first error seen while writing
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// NeutraliseCSVFormula prefixes values starting with a formula character with a single quote,
+// so spreadsheets display them as text instead of evaluating them
+func NeutraliseCSVFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"math"
 	"reflect"
-	"regexp"
+	"strings"
 )
 
 // func MakeHash[T any](s []T, index int) ([]T, error) {
@@ -34,21 +34,23 @@ func RemoveIndexFromSlice[T any](s []T, index int) ([]T, error) {
 	return append(s[:index], s[index+1:]...), nil
 }
 
-// FormatCSVFriendlyStrArr wraps the multi arr string with double quotes.
-// Mostly used for CSV purposes
+// FormatCSVFriendlyStrArr wraps each non-empty value of the multi arr string with double quotes,
+// doubling any quotes inside it. Mostly used for CSV purposes.
+// Values a spreadsheet would read as a formula are neutralised, see NeutraliseCSVFormula.
+// The input is left untouched.
 func FormatCSVFriendlyStrArr(arr [][]string) [][]string {
+	formatted := make([][]string, len(arr))
 	for arrIdx, arrVal := range arr {
-		arrInside := arrVal
-		for arrInsideIdx, arrInsideVal := range arrInside {
+		formatted[arrIdx] = make([]string, len(arrVal))
+		for arrInsideIdx, arrInsideVal := range arrVal {
 			if arrInsideVal != "" {
-				reComma := regexp.MustCompile(`"`)
-				arrInsideVal = reComma.ReplaceAllString(arrInsideVal, `""`)
-				arrInsideVal = `"` + arrInsideVal + `"`
+				arrInsideVal = NeutraliseCSVFormula(arrInsideVal)
+				arrInsideVal = `"` + strings.ReplaceAll(arrInsideVal, `"`, `""`) + `"`
 			}
-			arr[arrIdx][arrInsideIdx] = arrInsideVal
+			formatted[arrIdx][arrInsideIdx] = arrInsideVal
 		}
 	}
-	return arr
+	return formatted
 }
 
 func GetSliceValuesAsSliceOfStrings(slice interface{}) ([]string, error) {
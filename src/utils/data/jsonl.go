@@ -0,0 +1,57 @@
+package data
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"os"
+)
+
+var (
+	errOpenJSONLFile  = errors.New("error opening jsonl file")
+	errWriteJSONLine  = errors.New("error writing json line")
+	errSyncJSONLFile  = errors.New("error syncing jsonl file")
+	errCloseJSONLFile = errors.New("error closing jsonl file")
+)
+
+// JSONLFile appends records to a file as JSON lines, one record per line
+type JSONLFile struct {
+	f   *os.File
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// OpenJSONLFile opens the file for appending, creating it readable only by its owner if missing
+func OpenJSONLFile(path string) (*JSONLFile, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, errOpenJSONLFile.Error())
+	}
+	w := bufio.NewWriter(f)
+	return &JSONLFile{f: f, w: w, enc: json.NewEncoder(w)}, nil
+}
+
+// Write encodes a single record. Records are buffered, call Close to push them out.
+func (j *JSONLFile) Write(record interface{}) error {
+	if err := j.enc.Encode(record); err != nil {
+		return errors.Wrap(err, errWriteJSONLine.Error())
+	}
+	return nil
+}
+
+// Close flushes the buffered records and syncs them to disk, so they survive a crash once it returns
+func (j *JSONLFile) Close() error {
+	err := j.w.Flush()
+	if err == nil {
+		err = j.f.Sync()
+		if err != nil {
+			err = errors.Wrap(err, errSyncJSONLFile.Error())
+		}
+	} else {
+		err = errors.Wrap(err, errWriteJSONLine.Error())
+	}
+	if closeErr := j.f.Close(); closeErr != nil && err == nil {
+		err = errors.Wrap(closeErr, errCloseJSONLFile.Error())
+	}
+	return err
+}
@@ -14,3 +14,12 @@ func ValidDate(s string) bool {
 	}
 	return true
 }
+
+// ParseDateOrTimestamp accepts either a YYYY-MM-DD date, or an RFC 3339 timestamp
+func ParseDateOrTimestamp(s string) (time.Time, error) {
+	t, err := time.Parse(layoutDate, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,95 @@
+package error_handling
+
+import (
+	"errors"
+	"net/http"
+)
+
+// CodeInternalError is the code of every error that isn't an *Error
+const CodeInternalError = "internal_error"
+
+// ErrInternal is shown in place of errors that aren't an *Error, since their messages may hold internals
+var ErrInternal = New(CodeInternalError, http.StatusInternalServerError, "error, internal server error")
+
+// Error is an error the API can show its client, as a stable code, an HTTP status and a safe message.
+// Its cause, which may hold internals such as SQL errors, is only logged.
+type Error struct {
+	Code    string
+	Status  int
+	Message string
+	detail  string
+	cause   error
+}
+
+// New returns an error to declare as a sentinel, and match with errors.Is
+func New(code string, status int, message string) *Error {
+	return &Error{Code: code, Status: status, Message: message}
+}
+
+func BadRequest(code string, message string) *Error {
+	return New(code, http.StatusBadRequest, message)
+}
+
+func Unauthorized(code string, message string) *Error {
+	return New(code, http.StatusUnauthorized, message)
+}
+
+func Forbidden(code string, message string) *Error {
+	return New(code, http.StatusForbidden, message)
+}
+
+func NotFound(code string, message string) *Error {
+	return New(code, http.StatusNotFound, message)
+}
+
+func Gone(code string, message string) *Error {
+	return New(code, http.StatusGone, message)
+}
+
+// Detail returns a copy of the error with a detail that is safe to show, e.g. which field is invalid
+func (e *Error) Detail(detail string) *Error {
+	c := *e
+	c.detail = detail
+	return &c
+}
+
+// Wrap returns a copy of the error caused by cause, which is logged but never shown
+func (e *Error) Wrap(cause error) *Error {
+	c := *e
+	c.cause = cause
+	return &c
+}
+
+// SafeMessage is the message and detail, leaving out the cause
+func (e *Error) SafeMessage() string {
+	if e.detail == "" {
+		return e.Message
+	}
+	return e.Message + ": " + e.detail
+}
+
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.SafeMessage()
+	}
+	return e.SafeMessage() + ": " + e.cause.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is matches errors by code, so the copies made by Detail and Wrap match the sentinel they came from
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// From returns the first *Error in err's chain, or ErrInternal caused by err when there is none
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return ErrInternal.Wrap(err)
+}
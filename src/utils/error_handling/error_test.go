@@ -0,0 +1,49 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+var errMockNotFound = NotFound("mock_not_found", "error, mock not found")
+
+func TestError_DetailAndWrap(t *testing.T) {
+	cause := errors.New("sql: no rows in result set")
+	err := errMockNotFound.Detail("id 7").Wrap(cause)
+
+	t.Run("Test Error - Matches Its Sentinel", func(t *testing.T) {
+		assert.ErrorIs(t, err, errMockNotFound)
+		assert.ErrorIs(t, fmt.Errorf("error fetching: %w", err), errMockNotFound)
+		assert.NotErrorIs(t, err, ErrInternal)
+	})
+	t.Run("Test Error - Keeps Its Cause", func(t *testing.T) {
+		assert.ErrorIs(t, err, cause)
+		assert.Equal(t, "error, mock not found: id 7: sql: no rows in result set", err.Error())
+	})
+	t.Run("Test Error - Safe Message Leaves Out The Cause", func(t *testing.T) {
+		assert.Equal(t, "error, mock not found: id 7", err.SafeMessage())
+	})
+	t.Run("Test Error - Copies Leave The Sentinel Untouched", func(t *testing.T) {
+		assert.Equal(t, "error, mock not found", errMockNotFound.Error())
+		assert.Nil(t, errMockNotFound.Unwrap())
+	})
+}
+
+func TestFrom(t *testing.T) {
+	t.Run("Test From - Finds The Error In The Chain", func(t *testing.T) {
+		err := From(fmt.Errorf("error fetching: %w", errMockNotFound))
+		assert.Equal(t, "mock_not_found", err.Code)
+		assert.Equal(t, http.StatusNotFound, err.Status)
+	})
+	t.Run("Test From - Hides Other Errors", func(t *testing.T) {
+		cause := errors.New("dial tcp: connection refused")
+		err := From(cause)
+		assert.Equal(t, CodeInternalError, err.Code)
+		assert.Equal(t, http.StatusInternalServerError, err.Status)
+		assert.Equal(t, ErrInternal.Message, err.SafeMessage())
+		assert.ErrorIs(t, err, cause)
+	})
+}
@@ -0,0 +1,89 @@
+package keygen
+
+import (
+	"github.com/pkg/errors"
+	"hash/crc32"
+	"strings"
+)
+
+const (
+	// checksumLength is the width of a base62 encoded CRC-32, which is at most 4294967295
+	checksumLength = 6
+	base62         = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// visibleRandomLength is how many random characters are kept in a key's visible prefix
+	visibleRandomLength = 2
+)
+
+var (
+	ErrMalformedKey = errors.New("error, malformed token key")
+	ErrLegacyKey    = errors.New("error, token key is in the legacy format")
+)
+
+// Format shapes keys as "<prefix><random><checksum>", e.g. "inv_3kf9x2ab0Q1zYc".
+// The checksum is a CRC-32 of the random part, so typos and garbage are caught without a lookup,
+// and the prefix lets secret scanners recognise leaked keys.
+type Format struct {
+	Prefix string
+}
+
+// Wrap adds the prefix and checksum to the random part of a key
+func (f Format) Wrap(random string) string {
+	return f.Prefix + random + checksum(random)
+}
+
+// Check returns ErrLegacyKey for keys without the prefix, which predate the format,
+// and ErrMalformedKey for prefixed keys whose checksum does not match
+func (f Format) Check(key string) error {
+	if !strings.HasPrefix(key, f.Prefix) {
+		if isAlphanumeric(key) {
+			return ErrLegacyKey
+		}
+		return ErrMalformedKey
+	}
+
+	body := strings.TrimPrefix(key, f.Prefix)
+	if len(body) <= checksumLength || !isAlphanumeric(body) {
+		return ErrMalformedKey
+	}
+	random, sum := body[:len(body)-checksumLength], body[len(body)-checksumLength:]
+	if checksum(random) != sum {
+		return ErrMalformedKey
+	}
+	return nil
+}
+
+// VisiblePrefix returns the non-secret start of a key, shown in listings in place of the key.
+// It keeps the format prefix, plus the first few random characters.
+func (f Format) VisiblePrefix(key string) string {
+	prefix := ""
+	if f.Prefix != "" && strings.HasPrefix(key, f.Prefix) {
+		prefix, key = f.Prefix, strings.TrimPrefix(key, f.Prefix)
+	}
+	if len(key) > visibleRandomLength {
+		key = key[:visibleRandomLength]
+	}
+	return prefix + key
+}
+
+// checksum returns the CRC-32 of s, as a fixed width base62 string
+func checksum(s string) string {
+	sum := crc32.ChecksumIEEE([]byte(s))
+	encoded := make([]byte, checksumLength)
+	for i := checksumLength - 1; i >= 0; i-- {
+		encoded[i] = base62[sum%62]
+		sum /= 62
+	}
+	return string(encoded)
+}
+
+func isAlphanumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !strings.ContainsRune(base62, rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package keygen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"github.com/pkg/errors"
+	"math/big"
+	"strings"
+)
+
+// The alphabets keys can be generated from
+const (
+	AlphabetHex       = "hex"
+	AlphabetCrockford = "crockford"
+	AlphabetNumeric   = "numeric"
+	AlphabetFriendly  = "friendly"
+)
+
+// maxBlockedAttempts bounds how many blocked keys are discarded before giving up,
+// so a blocklist matching nearly everything fails loudly instead of looping forever
+const maxBlockedAttempts = 100
+
+var alphabets = map[string]string{
+	AlphabetHex:       "0123456789abcdef",
+	AlphabetCrockford: "0123456789ABCDEFGHJKMNPQRSTVWXYZ",
+	AlphabetNumeric:   "0123456789",
+	// Leaves out characters easily mistaken for each other, such as 0/O/o and 1/l/I
+	AlphabetFriendly: "23456789abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ",
+}
+
+var (
+	errUnknownAlphabet = errors.New("error, unknown alphabet")
+	errInvalidLengths  = errors.New("error, key lengths must be at least 1, with the min no greater than the max")
+	errReadRandom      = errors.New("error reading random bytes")
+	errAllKeysBlocked  = errors.New("error, every generated key matched the blocklist")
+)
+
+// Generator creates random keys from an alphabet, using crypto/rand, shaped by the format.
+// Keys containing a blocklisted word, in any case, are discarded and generated again.
+type Generator struct {
+	alphabet  string
+	blocklist []string
+	format    Format
+}
+
+func NewGenerator(alphabet string, blocklist []string, format Format) (*Generator, error) {
+	chars, ok := alphabets[alphabet]
+	if !ok {
+		return nil, errors.Wrap(errUnknownAlphabet, fmt.Sprintf("%q", alphabet))
+	}
+
+	lowerBlocklist := make([]string, 0, len(blocklist))
+	for _, word := range blocklist {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			lowerBlocklist = append(lowerBlocklist, word)
+		}
+	}
+	return &Generator{alphabet: chars, blocklist: lowerBlocklist, format: format}, nil
+}
+
+// Generate returns a formatted key, whose random part has a length picked uniformly
+// between minLength and maxLength, inclusive
+func (g *Generator) Generate(minLength int, maxLength int) (string, error) {
+	if minLength < 1 || minLength > maxLength {
+		return "", errInvalidLengths
+	}
+
+	for attempt := 0; attempt < maxBlockedAttempts; attempt++ {
+		length, err := randomInt(maxLength - minLength + 1)
+		if err != nil {
+			return "", err
+		}
+		random, err := g.randomString(minLength + length)
+		if err != nil {
+			return "", err
+		}
+		key := g.format.Wrap(random)
+		// The checksum is checked too, as it can spell words just like the random part
+		if !g.isBlocked(strings.TrimPrefix(key, g.format.Prefix)) {
+			return key, nil
+		}
+	}
+	return "", errAllKeysBlocked
+}
+
+// Format returns the format keys are generated in
+func (g *Generator) Format() Format {
+	return g.format
+}
+
+func (g *Generator) randomString(length int) (string, error) {
+	var sb strings.Builder
+	sb.Grow(length)
+	for i := 0; i < length; i++ {
+		idx, err := randomInt(len(g.alphabet))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(g.alphabet[idx])
+	}
+	return sb.String(), nil
+}
+
+func (g *Generator) isBlocked(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range g.blocklist {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// randomInt returns a uniformly distributed int in [0, n)
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errors.Wrap(err, errReadRandom.Error())
+	}
+	return int(v.Int64()), nil
+}
+
+// IsAlphabet reports if the name is one of the supported alphabets
+func IsAlphabet(name string) bool {
+	_, ok := alphabets[name]
+	return ok
+}
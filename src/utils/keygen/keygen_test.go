@@ -0,0 +1,125 @@
+package keygen
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+var testFormat = Format{Prefix: "inv_"}
+
+// randomPart strips the prefix and checksum from a generated key
+func randomPart(t *testing.T, key string) string {
+	require.NoError(t, testFormat.Check(key))
+	return strings.TrimPrefix(key, testFormat.Prefix)[:len(key)-len(testFormat.Prefix)-checksumLength]
+}
+
+func TestGenerator_Generate_Alphabets(t *testing.T) {
+	for name, chars := range alphabets {
+		t.Run(name, func(t *testing.T) {
+			generator, err := NewGenerator(name, nil, testFormat)
+			require.NoError(t, err)
+
+			for i := 0; i < 50; i++ {
+				key, err := generator.Generate(6, 12)
+				require.NoError(t, err)
+
+				random := randomPart(t, key)
+				assert.GreaterOrEqual(t, len(random), 6)
+				assert.LessOrEqual(t, len(random), 12)
+				for _, c := range random {
+					assert.Truef(t, strings.ContainsRune(chars, c), "unexpected %q in %q", c, key)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Generate_MinEqualsMax(t *testing.T) {
+	generator, err := NewGenerator(AlphabetHex, nil, testFormat)
+	require.NoError(t, err)
+
+	key, err := generator.Generate(8, 8)
+	require.NoError(t, err)
+	assert.Len(t, randomPart(t, key), 8)
+}
+
+func TestGenerator_Generate_InvalidLengths(t *testing.T) {
+	generator, err := NewGenerator(AlphabetHex, nil, testFormat)
+	require.NoError(t, err)
+
+	_, err = generator.Generate(0, 4)
+	assert.ErrorIs(t, err, errInvalidLengths)
+
+	_, err = generator.Generate(8, 6)
+	assert.ErrorIs(t, err, errInvalidLengths)
+}
+
+func TestGenerator_Generate_Blocklist(t *testing.T) {
+	generator, err := NewGenerator(AlphabetNumeric, []string{" 1 ", "2", "3", "4", "5", "6", "7", "8", ""}, Format{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8"}, generator.blocklist)
+	assert.True(t, generator.isBlocked("0910"))
+	assert.False(t, generator.isBlocked("0990"))
+}
+
+func TestGenerator_Generate_BlocklistCaseInsensitive(t *testing.T) {
+	generator, err := NewGenerator(AlphabetCrockford, []string{"a"}, testFormat)
+	require.NoError(t, err)
+	assert.True(t, generator.isBlocked("XXAXX"))
+	assert.False(t, generator.isBlocked("XXBXX"))
+}
+
+func TestGenerator_Generate_AllKeysBlocked(t *testing.T) {
+	generator, err := NewGenerator(AlphabetHex, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "a", "b", "c", "d", "e", "f"}, testFormat)
+	require.NoError(t, err)
+
+	_, err = generator.Generate(4, 4)
+	assert.ErrorIs(t, err, errAllKeysBlocked)
+}
+
+func TestNewGenerator_UnknownAlphabet(t *testing.T) {
+	_, err := NewGenerator("base64", nil, testFormat)
+	assert.ErrorIs(t, err, errUnknownAlphabet)
+	assert.False(t, IsAlphabet("base64"))
+	assert.True(t, IsAlphabet(AlphabetFriendly))
+}
+
+func TestFormat_Check(t *testing.T) {
+	key := testFormat.Wrap("3kf9x2ab")
+	require.NoError(t, testFormat.Check(key))
+
+	tests := []struct {
+		name string
+		key  string
+		want error
+	}{
+		{"legacy", "a1b2c3", ErrLegacyKey},
+		{"garbage", "<script>", ErrMalformedKey},
+		{"empty", "", ErrMalformedKey},
+		{"prefix only", "inv_", ErrMalformedKey},
+		{"no random part", "inv_" + checksum(""), ErrMalformedKey},
+		{"typo", strings.Replace(key, "3kf", "3kg", 1), ErrMalformedKey},
+		{"truncated", key[:len(key)-1], ErrMalformedKey},
+		{"not alphanumeric", "inv_3kf-x2ab" + checksum("3kf-x2ab"), ErrMalformedKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, testFormat.Check(tt.key), tt.want)
+		})
+	}
+}
+
+func TestFormat_VisiblePrefix(t *testing.T) {
+	assert.Equal(t, "inv_3k", testFormat.VisiblePrefix(testFormat.Wrap("3kf9x2ab")))
+	assert.Equal(t, "a1", testFormat.VisiblePrefix("a1b2c3"))
+	assert.Equal(t, "a", testFormat.VisiblePrefix("a"))
+}
+
+func Test_checksum(t *testing.T) {
+	assert.Len(t, checksum(""), checksumLength)
+	assert.Len(t, checksum("3kf9x2ab"), checksumLength)
+	assert.Equal(t, checksum("3kf9x2ab"), checksum("3kf9x2ab"))
+	assert.NotEqual(t, checksum("3kf9x2ab"), checksum("3kf9x2ac"))
+}
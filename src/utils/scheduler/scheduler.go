@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Every runs the job once per interval until the context is cancelled.
+// The first run happens one interval after starting, and runs never overlap.
+// It blocks, so callers run it in its own goroutine.
+func Every(ctx context.Context, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job(ctx)
+		}
+	}
+}
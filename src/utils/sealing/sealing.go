@@ -0,0 +1,60 @@
+package sealing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"github.com/pkg/errors"
+	"io"
+)
+
+var (
+	ErrMalformedSealed = errors.New("error, sealed data is malformed")
+	ErrOpenSealed      = errors.New("error, sealed data can't be opened, it was tampered with or sealed with another secret")
+)
+
+var errRandomNonce = errors.New("error generating nonce")
+
+// Sealer encrypts data at rest with AES-256-GCM, so it can only be read, and can't be altered, without the secret
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// Seal encrypts the data, prefixed with the random nonce it was sealed with
+func (s *Sealer) Seal(data []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(data)+s.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, errRandomNonce.Error())
+	}
+	return s.aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// Open decrypts data returned by Seal
+func (s *Sealer) Open(sealed []byte) ([]byte, error) {
+	if len(sealed) < s.aead.NonceSize()+s.aead.Overhead() {
+		return nil, ErrMalformedSealed
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	data, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrOpenSealed
+	}
+	return data, nil
+}
+
+// NewSealer returns a sealer keyed with the secret, for the purpose, so the same secret
+// seals data for different purposes under different keys
+func NewSealer(secret string, purpose string) *Sealer {
+	key := sha256.Sum256([]byte(purpose + ":" + secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		// A 32 byte key is always valid
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return &Sealer{aead: aead}
+}
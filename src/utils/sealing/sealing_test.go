@@ -0,0 +1,52 @@
+package sealing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestSealer_SealOpen(t *testing.T) {
+	sealer := NewSealer(testSecret, "idempotency")
+	data := []byte(`{"key":"inv_3kQ9x"}`)
+
+	sealed, err := sealer.Seal(data)
+	require.NoError(t, err)
+	again, err := sealer.Seal(data)
+	require.NoError(t, err)
+	opened, err := sealer.Open(sealed)
+	t.Run("Test Seal - Open", func(t *testing.T) {
+		require.NoError(t, err)
+		assert.Equal(t, data, opened)
+		assert.NotContains(t, string(sealed), "inv_3kQ9x")
+		assert.NotEqual(t, sealed, again, "every seal uses a new nonce")
+	})
+}
+
+func TestSealer_Open_Fail(t *testing.T) {
+	sealer := NewSealer(testSecret, "idempotency")
+	sealed, err := sealer.Seal([]byte("data"))
+	require.NoError(t, err)
+	tampered := append([]byte{}, sealed...)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		sealer  *Sealer
+		sealed  []byte
+		wantErr error
+	}{
+		{"tampered", sealer, tampered, ErrOpenSealed},
+		{"other secret", NewSealer("fedcba9876543210fedcba9876543210", "idempotency"), sealed, ErrOpenSealed},
+		{"other purpose", NewSealer(testSecret, "export"), sealed, ErrOpenSealed},
+		{"truncated", sealer, sealed[:10], ErrMalformedSealed},
+	}
+	for _, tt := range tests {
+		_, err := tt.sealer.Open(tt.sealed)
+		t.Run("Test Open - Fail "+tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
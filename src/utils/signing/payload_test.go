@@ -0,0 +1,28 @@
+package signing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPayload(t *testing.T) {
+	timestamp := time.Unix(1717234200, 0)
+	body := []byte(`{"type":"token.created"}`)
+
+	t.Run("Test SignPayload - signs the timestamp and body", func(t *testing.T) {
+		signature := SignPayload("whsec_test", timestamp, body)
+		assert.Equal(t, "v1=", signature[:3])
+		assert.Len(t, signature, 3+64)
+		assert.Equal(t, signature, SignPayload("whsec_test", timestamp, body))
+		assert.True(t, VerifyPayload("whsec_test", timestamp, body, signature))
+	})
+
+	t.Run("Test VerifyPayload - rejects changes", func(t *testing.T) {
+		signature := SignPayload("whsec_test", timestamp, body)
+		assert.False(t, VerifyPayload("whsec_other", timestamp, body, signature))
+		assert.False(t, VerifyPayload("whsec_test", timestamp.Add(time.Second), body, signature))
+		assert.False(t, VerifyPayload("whsec_test", timestamp, []byte(`{"type":"token.revoked"}`), signature))
+		assert.False(t, VerifyPayload("whsec_test", timestamp, body, signature[3:]))
+	})
+}
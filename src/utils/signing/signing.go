@@ -0,0 +1,191 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// The algorithms tokens can be signed with
+const (
+	AlgorithmHMAC    = "hmac"
+	AlgorithmEd25519 = "ed25519"
+)
+
+// minHMACSecretLength matches the length of the SHA-256 output the secret keys
+const minHMACSecretLength = 32
+
+var keyIdPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,16}$`)
+
+var (
+	ErrMalformedToken   = errors.New("error, malformed signed token")
+	ErrUnknownKeyId     = errors.New("error, signed token key id is unknown or retired")
+	ErrInvalidSignature = errors.New("error, signed token signature is invalid")
+)
+
+var (
+	errUnknownAlgorithm = errors.New("error, unknown signing algorithm")
+	errNoKeys           = errors.New("error, at least one signing key is required")
+	errMalformedKey     = errors.New("error, signing keys must look like <key id>:<base64 secret>")
+	errInvalidKeyId     = errors.New("error, signing key ids must be up to 16 letters and digits")
+	errDuplicateKeyId   = errors.New("error, duplicate signing key id")
+	errInvalidSecret    = errors.New("error, invalid signing key secret")
+	errEncodeClaims     = errors.New("error encoding claims")
+)
+
+// Claims are embedded in a signed token, so it can be validated without a lookup
+type Claims struct {
+	Id        int      `json:"id"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	Scopes    []string `json:"scp,omitempty"`
+}
+
+// Expiry returns when the token expires
+func (c *Claims) Expiry() time.Time {
+	return time.Unix(c.ExpiresAt, 0).UTC()
+}
+
+// NotYetActive reports whether the token is scheduled to become usable after now
+func (c *Claims) NotYetActive(now time.Time) bool {
+	return c.NotBefore != 0 && now.Before(time.Unix(c.NotBefore, 0))
+}
+
+type key struct {
+	secret     []byte
+	privateKey ed25519.PrivateKey
+}
+
+// Signer signs tokens as "<prefix><key id>.<claims>.<signature>", both parts base64url encoded.
+// Tokens are signed with the first key, and verified with any key still configured,
+// so a key is rotated by putting a new key first, and retired by removing it.
+type Signer struct {
+	prefix      string
+	algorithm   string
+	activeKeyId string
+	keys        map[string]key
+}
+
+// NewSigner parses keys given as "<key id>:<base64 secret>", the first being the active one.
+// HMAC secrets must be at least 32 bytes, and Ed25519 secrets are 32 byte seeds.
+func NewSigner(prefix string, algorithm string, keys []string) (*Signer, error) {
+	if algorithm != AlgorithmHMAC && algorithm != AlgorithmEd25519 {
+		return nil, errors.Wrap(errUnknownAlgorithm, fmt.Sprintf("%q", algorithm))
+	}
+	if len(keys) == 0 {
+		return nil, errNoKeys
+	}
+
+	signer := &Signer{prefix: prefix, algorithm: algorithm, keys: make(map[string]key, len(keys))}
+	for _, entry := range keys {
+		keyId, encodedSecret, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return nil, errMalformedKey
+		}
+		if !keyIdPattern.MatchString(keyId) {
+			return nil, errors.Wrap(errInvalidKeyId, fmt.Sprintf("%q", keyId))
+		}
+		if _, ok = signer.keys[keyId]; ok {
+			return nil, errors.Wrap(errDuplicateKeyId, keyId)
+		}
+		secret, err := base64.StdEncoding.DecodeString(encodedSecret)
+		if err != nil {
+			return nil, errors.Wrap(errInvalidSecret, fmt.Sprintf("key id %v is not valid base64", keyId))
+		}
+
+		var parsed key
+		switch algorithm {
+		case AlgorithmHMAC:
+			if len(secret) < minHMACSecretLength {
+				return nil, errors.Wrap(errInvalidSecret,
+					fmt.Sprintf("key id %v must be at least %v bytes", keyId, minHMACSecretLength))
+			}
+			parsed.secret = secret
+		case AlgorithmEd25519:
+			if len(secret) != ed25519.SeedSize {
+				return nil, errors.Wrap(errInvalidSecret,
+					fmt.Sprintf("key id %v must be a %v byte seed", keyId, ed25519.SeedSize))
+			}
+			parsed.privateKey = ed25519.NewKeyFromSeed(secret)
+		}
+
+		signer.keys[keyId] = parsed
+		if signer.activeKeyId == "" {
+			signer.activeKeyId = keyId
+		}
+	}
+	return signer, nil
+}
+
+// Sign returns a token embedding the claims, signed with the active key
+func (s *Signer) Sign(claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", errors.Wrap(err, errEncodeClaims.Error())
+	}
+	signed := s.prefix + s.activeKeyId + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := s.sign(s.keys[s.activeKeyId], []byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// IsSigned reports whether the token is shaped like a signed token, rather than a stored key.
+// Stored keys never contain dots.
+func (s *Signer) IsSigned(token string) bool {
+	return strings.HasPrefix(token, s.prefix) && strings.Count(token, ".") == 2
+}
+
+// Verify checks the token's signature, and returns its claims.
+// Expiry is left to the caller, which may want the claims of an expired token.
+func (s *Signer) Verify(token string) (*Claims, error) {
+	if !s.IsSigned(token) {
+		return nil, ErrMalformedToken
+	}
+	lastDot := strings.LastIndex(token, ".")
+	signed, encodedSignature := token[:lastDot], token[lastDot+1:]
+	keyId, encodedPayload, _ := strings.Cut(strings.TrimPrefix(signed, s.prefix), ".")
+
+	verifyKey, ok := s.keys[keyId]
+	if !ok {
+		return nil, ErrUnknownKeyId
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	if !s.verify(verifyKey, []byte(signed), signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+	return &claims, nil
+}
+
+func (s *Signer) sign(k key, message []byte) []byte {
+	if s.algorithm == AlgorithmEd25519 {
+		return ed25519.Sign(k.privateKey, message)
+	}
+	mac := hmac.New(sha256.New, k.secret)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+func (s *Signer) verify(k key, message []byte, signature []byte) bool {
+	if s.algorithm == AlgorithmEd25519 {
+		return ed25519.Verify(k.privateKey.Public().(ed25519.PublicKey), message, signature)
+	}
+	return hmac.Equal(s.sign(k, message), signature)
+}
@@ -0,0 +1,113 @@
+package signing
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func testKey(keyId string, fill byte) string {
+	return keyId + ":" + base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(fill), 32)))
+}
+
+func TestSigner_SignAndVerify(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmHMAC, AlgorithmEd25519} {
+		signer, err := NewSigner("inv_", algorithm, []string{testKey("k1", 'a')})
+		require.NoError(t, err)
+
+		claims := Claims{Id: 42, ExpiresAt: 1717200000, Scopes: []string{"beta"}}
+		token, err := signer.Sign(claims)
+		require.NoError(t, err)
+
+		t.Run("Test Sign And Verify - "+algorithm, func(t *testing.T) {
+			assert.True(t, strings.HasPrefix(token, "inv_k1."))
+			assert.True(t, signer.IsSigned(token))
+
+			got, err := signer.Verify(token)
+			require.NoError(t, err)
+			assert.Equal(t, claims, *got)
+		})
+	}
+}
+
+func TestSigner_Verify_FailPaths(t *testing.T) {
+	signer, err := NewSigner("inv_", AlgorithmHMAC, []string{testKey("k1", 'a')})
+	require.NoError(t, err)
+	token, err := signer.Sign(Claims{Id: 42, ExpiresAt: 1717200000})
+	require.NoError(t, err)
+	parts := strings.Split(token, ".")
+
+	otherSigner, err := NewSigner("inv_", AlgorithmHMAC, []string{testKey("k1", 'b')})
+	require.NoError(t, err)
+	forged, err := otherSigner.Sign(Claims{Id: 42, ExpiresAt: 1717200000})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{name: "stored key", token: "inv_3kf9x2ab0Q1zYc", err: ErrMalformedToken},
+		{name: "tampered claims", token: parts[0] + "." + parts[1] + "x." + parts[2], err: ErrInvalidSignature},
+		{name: "forged signature", token: forged, err: ErrInvalidSignature},
+		{name: "unknown key id", token: strings.Replace(token, "inv_k1.", "inv_k9.", 1), err: ErrUnknownKeyId},
+		{name: "bad signature encoding", token: parts[0] + "." + parts[1] + ".***", err: ErrMalformedToken},
+	}
+	for _, test := range tests {
+		_, err := signer.Verify(test.token)
+		t.Run("Test Verify - Fail Path "+test.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestSigner_Rotation(t *testing.T) {
+	oldSigner, err := NewSigner("inv_", AlgorithmEd25519, []string{testKey("k1", 'a')})
+	require.NoError(t, err)
+	oldToken, err := oldSigner.Sign(Claims{Id: 1, ExpiresAt: 1717200000})
+	require.NoError(t, err)
+
+	rotated, err := NewSigner("inv_", AlgorithmEd25519, []string{testKey("k2", 'b'), testKey("k1", 'a')})
+	require.NoError(t, err)
+	newToken, err := rotated.Sign(Claims{Id: 2, ExpiresAt: 1717200000})
+	require.NoError(t, err)
+
+	retired, err := NewSigner("inv_", AlgorithmEd25519, []string{testKey("k2", 'b')})
+	require.NoError(t, err)
+
+	t.Run("Test Rotation", func(t *testing.T) {
+		assert.True(t, strings.HasPrefix(newToken, "inv_k2."))
+
+		_, err = rotated.Verify(oldToken)
+		assert.NoError(t, err)
+		_, err = retired.Verify(newToken)
+		assert.NoError(t, err)
+		_, err = retired.Verify(oldToken)
+		assert.ErrorIs(t, err, ErrUnknownKeyId)
+	})
+}
+
+func TestNewSigner_FailPaths(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		keys      []string
+		err       error
+	}{
+		{name: "unknown algorithm", algorithm: "rsa", keys: []string{testKey("k1", 'a')}, err: errUnknownAlgorithm},
+		{name: "no keys", algorithm: AlgorithmHMAC, err: errNoKeys},
+		{name: "missing key id", algorithm: AlgorithmHMAC, keys: []string{"c2VjcmV0"}, err: errMalformedKey},
+		{name: "invalid key id", algorithm: AlgorithmHMAC, keys: []string{testKey("k.1", 'a')}, err: errInvalidKeyId},
+		{name: "duplicate key id", algorithm: AlgorithmHMAC, keys: []string{testKey("k1", 'a'), testKey("k1", 'b')}, err: errDuplicateKeyId},
+		{name: "short hmac secret", algorithm: AlgorithmHMAC, keys: []string{"k1:c2VjcmV0"}, err: errInvalidSecret},
+		{name: "ed25519 seed size", algorithm: AlgorithmEd25519, keys: []string{"k1:c2VjcmV0"}, err: errInvalidSecret},
+	}
+	for _, test := range tests {
+		_, err := NewSigner("inv_", test.algorithm, test.keys)
+		t.Run("Test NewSigner - Fail Path "+test.name, func(t *testing.T) {
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
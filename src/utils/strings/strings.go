@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/atotto/clipboard"
 	"golang.org/x/crypto/bcrypt"
+	"platform_engineer_clone/src/utils/data"
 	"strings"
 )
 
@@ -85,16 +86,17 @@ func GetJSON(i ...interface{}) string {
 	}
 }
 
+// MultiDimensionalStringArrayToCSVText returns the raw values as CSV text, one row per line.
+// Values are quoted only when needed, see data.CSVWriter.
 func MultiDimensionalStringArrayToCSVText(arr [][]string) string {
-	csvTxt := ""
+	var csvTxt strings.Builder
+	csvWriter := data.NewCSVWriter(&csvTxt)
 	for _, v := range arr {
-		row := `"` + strings.Join(v[:], `","`)
-		row = row[:len(row)-2]
-		row = strings.Join(v[:], ",")
-		row = TrimSuffix(row, ",")
-		csvTxt = csvTxt + row + "\n"
+		// Writing to a strings.Builder never fails
+		_ = csvWriter.Write(v)
 	}
-	return csvTxt
+	_ = csvWriter.Flush()
+	return csvTxt.String()
 }
 
 func TrimSuffix(s, suffix string) string {
@@ -1,6 +1,13 @@
 package validation
 
-import "platform_engineer_clone/src/utils/date_handling"
+import (
+	"net/url"
+	"platform_engineer_clone/src/utils/date_handling"
+	"regexp"
+)
+
+// tokenScopePattern allows namespaced scopes such as "beta:analytics" or "org:42"
+var tokenScopePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9:._-]{0,63}$`)
 
 type CustomValidation struct {
 	Name     string
@@ -20,4 +27,30 @@ var customValidations = []CustomValidation{
 		},
 		Response: "must be a valid date format of YYYY-MM-DD",
 	},
+	{
+		Name: "token_scope",
+		Logic: func(i interface{}) bool {
+			val, ok := i.(string)
+			if !ok {
+				return false
+			}
+			return tokenScopePattern.MatchString(val)
+		},
+		Response: "must be up to 64 lowercase letters, digits, or : . _ - characters, e.g. beta:analytics",
+	},
+	{
+		Name: "http_url",
+		Logic: func(i interface{}) bool {
+			val, ok := i.(string)
+			if !ok {
+				return false
+			}
+			parsed, err := url.Parse(val)
+			if err != nil {
+				return false
+			}
+			return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+		},
+		Response: "must be an absolute http or https URL",
+	},
 }